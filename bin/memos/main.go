@@ -7,13 +7,18 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/usememos/memos/plugin/importer"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	"github.com/usememos/memos/server"
 	"github.com/usememos/memos/server/profile"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
 	"github.com/usememos/memos/server/version"
 	"github.com/usememos/memos/store"
 	"github.com/usememos/memos/store/db"
@@ -35,19 +40,7 @@ var (
 		Use:   "memos",
 		Short: `An open source, lightweight note-taking service. Easily capture and share your great thoughts.`,
 		Run: func(_ *cobra.Command, _ []string) {
-			instanceProfile := &profile.Profile{
-				Mode:        viper.GetString("mode"),
-				Addr:        viper.GetString("addr"),
-				Port:        viper.GetInt("port"),
-				Data:        viper.GetString("data"),
-				Driver:      viper.GetString("driver"),
-				DSN:         viper.GetString("dsn"),
-				InstanceURL: viper.GetString("instance-url"),
-				Version:     version.GetCurrentVersion(viper.GetString("mode")),
-			}
-			if err := instanceProfile.Validate(); err != nil {
-				panic(err)
-			}
+			instanceProfile := getInstanceProfile()
 
 			ctx, cancel := context.WithCancel(context.Background())
 			dbDriver, err := db.NewDBDriver(instanceProfile)
@@ -96,6 +89,60 @@ var (
 			<-ctx.Done()
 		},
 	}
+
+	importCmd = &cobra.Command{
+		Use:   "import <file>",
+		Short: "Import the notes of a Markdown archive or another note app as memos of a user",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			source, err := cmd.Flags().GetString("source")
+			if err != nil {
+				return err
+			}
+			username, err := cmd.Flags().GetString("user")
+			if err != nil {
+				return err
+			}
+			dryRun, err := cmd.Flags().GetBool("dry-run")
+			if err != nil {
+				return err
+			}
+			data, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			notes, err := importer.Parse(importer.Source(strings.ToUpper(source)), data)
+			if err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			instanceProfile := getInstanceProfile()
+			dbDriver, err := db.NewDBDriver(instanceProfile)
+			if err != nil {
+				return err
+			}
+			storeInstance := store.New(dbDriver, instanceProfile)
+			defer storeInstance.Close()
+			if err := storeInstance.Migrate(ctx); err != nil {
+				return err
+			}
+			user, err := storeInstance.GetUser(ctx, &store.FindUser{Username: &username})
+			if err != nil {
+				return err
+			}
+			if user == nil {
+				return errors.Errorf("user %s not found", username)
+			}
+
+			results, err := apiv1.ImportNotes(ctx, storeInstance, user.ID, notes, dryRun)
+			if err != nil {
+				return err
+			}
+			printImportResults(results, dryRun)
+			return nil
+		},
+	}
 )
 
 func init() {
@@ -133,6 +180,14 @@ func init() {
 		panic(err)
 	}
 
+	importCmd.Flags().String("source", "markdown", `source of the file, can be "markdown", "obsidian", "google_keep" or "evernote"`)
+	importCmd.Flags().String("user", "", "username of the user to import the memos for")
+	importCmd.Flags().Bool("dry-run", false, "report what would be imported without creating anything")
+	if err := importCmd.MarkFlagRequired("user"); err != nil {
+		panic(err)
+	}
+	rootCmd.AddCommand(importCmd)
+
 	viper.SetEnvPrefix("memos")
 	viper.AutomaticEnv()
 	if err := viper.BindEnv("instance-url", "MEMOS_INSTANCE_URL"); err != nil {
@@ -140,6 +195,43 @@ func init() {
 	}
 }
 
+func getInstanceProfile() *profile.Profile {
+	instanceProfile := &profile.Profile{
+		Mode:        viper.GetString("mode"),
+		Addr:        viper.GetString("addr"),
+		Port:        viper.GetInt("port"),
+		Data:        viper.GetString("data"),
+		Driver:      viper.GetString("driver"),
+		DSN:         viper.GetString("dsn"),
+		InstanceURL: viper.GetString("instance-url"),
+		Version:     version.GetCurrentVersion(viper.GetString("mode")),
+	}
+	if err := instanceProfile.Validate(); err != nil {
+		panic(err)
+	}
+	return instanceProfile
+}
+
+func printImportResults(results []*v1pb.ImportMemosResult, dryRun bool) {
+	imported := 0
+	for _, result := range results {
+		if result.Error != "" {
+			fmt.Printf("✗ %s: %s\n", result.Source, result.Error)
+		} else {
+			imported++
+			fmt.Printf("✓ %s %s (%d resources, %d relations)\n", result.Source, result.Memo, result.ResourceCount, result.RelationCount)
+		}
+		for _, warning := range result.Warnings {
+			fmt.Printf("  ! %s\n", warning)
+		}
+	}
+	if dryRun {
+		fmt.Printf("%d of %d notes would be imported (dry run)\n", imported, len(results))
+	} else {
+		fmt.Printf("%d of %d notes imported\n", imported, len(results))
+	}
+}
+
 func printGreetings(profile *profile.Profile) {
 	fmt.Printf(`---
 Server profile
@@ -0,0 +1,196 @@
+package importer
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/html"
+)
+
+// evernoteTimeLayout is the layout of the times of an ENEX file.
+const evernoteTimeLayout = "20060102T150405Z"
+
+var blankLinesRegexp = regexp.MustCompile(`\n{3,}`)
+
+type evernoteExport struct {
+	Notes []*evernoteNote `xml:"note"`
+}
+
+type evernoteNote struct {
+	Title      string   `xml:"title"`
+	Content    string   `xml:"content"`
+	Created    string   `xml:"created"`
+	Updated    string   `xml:"updated"`
+	Tags       []string `xml:"tag"`
+	Attributes struct {
+		Latitude  float64 `xml:"latitude"`
+		Longitude float64 `xml:"longitude"`
+	} `xml:"note-attributes"`
+	Resources []*evernoteResource `xml:"resource"`
+}
+
+type evernoteResource struct {
+	Data struct {
+		Encoding string `xml:"encoding,attr"`
+		Value    string `xml:",chardata"`
+	} `xml:"data"`
+	Mime       string `xml:"mime"`
+	Attributes struct {
+		FileName string `xml:"file-name"`
+	} `xml:"resource-attributes"`
+}
+
+func parseEvernoteExport(data []byte) ([]*Note, error) {
+	export := &evernoteExport{}
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	// ENEX files declare the DTD of Evernote, which is not needed to read them.
+	decoder.Strict = false
+	if err := decoder.Decode(export); err != nil {
+		return nil, errors.Wrap(err, "failed to read ENEX")
+	}
+
+	notes := []*Note{}
+	for i, evernoteNote := range export.Notes {
+		note := &Note{
+			Source: evernoteNote.Title,
+			Names:  []string{normalizeName(evernoteNote.Title)},
+		}
+		if note.Source == "" {
+			note.Source = fmt.Sprintf("note %d", i+1)
+		}
+		if createTime, err := time.Parse(evernoteTimeLayout, evernoteNote.Created); err == nil {
+			note.CreateTime, note.UpdateTime = createTime, createTime
+		}
+		if updateTime, err := time.Parse(evernoteTimeLayout, evernoteNote.Updated); err == nil {
+			note.UpdateTime = updateTime
+		}
+		if evernoteNote.Attributes.Latitude != 0 || evernoteNote.Attributes.Longitude != 0 {
+			note.Location = &Location{
+				Latitude:  evernoteNote.Attributes.Latitude,
+				Longitude: evernoteNote.Attributes.Longitude,
+			}
+		}
+		for _, tag := range evernoteNote.Tags {
+			if tag = formatTag(tag); tag != "" {
+				note.Tags = append(note.Tags, tag)
+			}
+		}
+
+		content, err := convertENMLToMarkdown(evernoteNote.Content)
+		if err != nil {
+			note.Warnings = append(note.Warnings, fmt.Sprintf("failed to convert content: %v", err))
+		}
+		if evernoteNote.Title != "" {
+			content = strings.TrimSpace("# " + evernoteNote.Title + "\n\n" + content)
+		}
+		note.Content = content
+
+		for j, resource := range evernoteNote.Resources {
+			if resource.Data.Encoding != "base64" {
+				note.Warnings = append(note.Warnings, fmt.Sprintf("unsupported encoding %q of attachment %d", resource.Data.Encoding, j+1))
+				continue
+			}
+			blob, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(resource.Data.Value), ""))
+			if err != nil {
+				note.Warnings = append(note.Warnings, fmt.Sprintf("invalid data of attachment %d: %v", j+1, err))
+				continue
+			}
+			filename := resource.Attributes.FileName
+			if filename == "" {
+				filename = fmt.Sprintf("attachment-%d", j+1)
+			}
+			note.Attachments = append(note.Attachments, &Attachment{
+				Filename: filename,
+				Type:     resource.Mime,
+				Blob:     blob,
+			})
+		}
+		notes = append(notes, note)
+	}
+	return notes, nil
+}
+
+// convertENMLToMarkdown converts the ENML content of a note into Markdown.
+// The embedded media are left out, since the resources of the note are attached to it.
+func convertENMLToMarkdown(content string) (string, error) {
+	document, err := html.Parse(strings.NewReader(content))
+	if err != nil {
+		return "", err
+	}
+	var builder strings.Builder
+	writeENMLNode(&builder, document)
+	markdown := blankLinesRegexp.ReplaceAllString(builder.String(), "\n\n")
+	lines := strings.Split(markdown, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n")), nil
+}
+
+func writeENMLNode(builder *strings.Builder, node *html.Node) {
+	if node.Type == html.TextNode {
+		builder.WriteString(strings.ReplaceAll(node.Data, "\n", " "))
+		return
+	}
+	if node.Type != html.ElementNode && node.Type != html.DocumentNode {
+		return
+	}
+
+	suffix := ""
+	switch node.Data {
+	case "en-media", "head", "script", "style":
+		return
+	case "br":
+		builder.WriteString("\n")
+		return
+	case "hr":
+		builder.WriteString("\n\n---\n\n")
+		return
+	case "en-todo":
+		// The HTML parser keeps the text after a self-closing en-todo as its children.
+		if getHTMLAttribute(node, "checked") == "true" {
+			builder.WriteString("- [x] ")
+		} else {
+			builder.WriteString("- [ ] ")
+		}
+	case "div", "p", "ul", "ol", "table", "tr", "blockquote":
+		builder.WriteString("\n")
+		suffix = "\n"
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		builder.WriteString("\n\n" + strings.Repeat("#", int(node.Data[1]-'0')) + " ")
+		suffix = "\n\n"
+	case "li":
+		builder.WriteString("\n- ")
+		suffix = "\n"
+	case "b", "strong":
+		builder.WriteString("**")
+		suffix = "**"
+	case "i", "em":
+		builder.WriteString("*")
+		suffix = "*"
+	case "a":
+		if href := getHTMLAttribute(node, "href"); href != "" {
+			builder.WriteString("[")
+			suffix = "](" + href + ")"
+		}
+	}
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		writeENMLNode(builder, child)
+	}
+	builder.WriteString(suffix)
+}
+
+func getHTMLAttribute(node *html.Node, key string) string {
+	for _, attribute := range node.Attr {
+		if attribute.Key == key {
+			return attribute.Val
+		}
+	}
+	return ""
+}
@@ -0,0 +1,232 @@
+// Package importer parses the exports of Markdown archives and other note apps into notes
+// that can be created as memos.
+package importer
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type Source string
+
+const (
+	// SourceMarkdown is a zip of Markdown files with optional YAML front matter.
+	SourceMarkdown Source = "MARKDOWN"
+	// SourceObsidian is a zip of an Obsidian vault.
+	SourceObsidian Source = "OBSIDIAN"
+	// SourceGoogleKeep is a zip of the Keep folder of Google Takeout.
+	SourceGoogleKeep Source = "GOOGLE_KEEP"
+	// SourceEvernote is an Evernote ENEX file.
+	SourceEvernote Source = "EVERNOTE"
+)
+
+// Note is a note parsed from an imported file.
+type Note struct {
+	// Source is the path or the title of the note in the imported file.
+	Source string
+	// UID is the uid of the memo the note was exported from, if any.
+	UID        string
+	Content    string
+	Visibility string
+	Tags       []string
+	Pinned     bool
+	Archived   bool
+	CreateTime time.Time
+	UpdateTime time.Time
+	Location   *Location
+
+	Attachments []*Attachment
+	// Relations are the relations to the memos with the uids.
+	Relations []*Relation
+	// Names are the names other notes can link to the note by, in lower case.
+	Names []string
+	// Links are the names of the notes linked by the note, in lower case.
+	Links []string
+
+	// Warnings are the problems met while parsing the note.
+	Warnings []string
+}
+
+type Location struct {
+	Placeholder string
+	Latitude    float64
+	Longitude   float64
+}
+
+// Attachment is a file attached to a note.
+// Link is set instead of Blob for a file hosted elsewhere.
+type Attachment struct {
+	Filename string
+	Type     string
+	Blob     []byte
+	Link     string
+}
+
+type Relation struct {
+	Type string
+	UID  string
+}
+
+// Parse parses the notes of the imported file.
+func Parse(source Source, data []byte) ([]*Note, error) {
+	switch source {
+	case SourceMarkdown, SourceObsidian:
+		archive, err := newZipArchive(data)
+		if err != nil {
+			return nil, err
+		}
+		return parseMarkdownArchive(archive)
+	case SourceGoogleKeep:
+		archive, err := newZipArchive(data)
+		if err != nil {
+			return nil, err
+		}
+		return parseGoogleKeepArchive(archive)
+	case SourceEvernote:
+		return parseEvernoteExport(data)
+	default:
+		return nil, errors.Errorf("unsupported source %q", source)
+	}
+}
+
+const (
+	// maxZipEntrySize is the maximum decompressed size of a file of a zip.
+	maxZipEntrySize = 64 << 20
+	// maxZipTotalSize is the maximum decompressed size of all the files of a zip.
+	maxZipTotalSize = 512 << 20
+)
+
+// zipArchive indexes the regular files of a zip.
+type zipArchive struct {
+	files map[string]*zip.File
+	paths []string
+	// remaining is how many more bytes can be decompressed from the zip.
+	remaining int64
+}
+
+func newZipArchive(data []byte) (*zipArchive, error) {
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read zip")
+	}
+	archive := &zipArchive{files: map[string]*zip.File{}, remaining: maxZipTotalSize}
+	var totalSize uint64
+	for _, file := range reader.File {
+		name := path.Clean(strings.TrimPrefix(strings.ReplaceAll(file.Name, "\\", "/"), "/"))
+		if file.FileInfo().IsDir() || isHiddenPath(name) {
+			continue
+		}
+		if file.UncompressedSize64 > maxZipEntrySize {
+			return nil, errors.Errorf("file %s exceeds the size limit of %d bytes", name, maxZipEntrySize)
+		}
+		if totalSize += file.UncompressedSize64; totalSize > maxZipTotalSize {
+			return nil, errors.Errorf("zip exceeds the size limit of %d bytes", maxZipTotalSize)
+		}
+		archive.files[name] = file
+		archive.paths = append(archive.paths, name)
+	}
+	sort.Strings(archive.paths)
+	return archive, nil
+}
+
+// isHiddenPath reports whether the path is in a hidden or a system directory,
+// such as the .obsidian settings of a vault or the __MACOSX metadata of a zip.
+func isHiddenPath(name string) bool {
+	for _, part := range strings.Split(name, "/") {
+		if strings.HasPrefix(part, ".") || part == "__MACOSX" {
+			return true
+		}
+	}
+	return false
+}
+
+// lookup finds the file referenced by the name from the directory dir.
+// Like Obsidian, it falls back to the only file the name is a suffix of.
+func (a *zipArchive) lookup(dir, name string) (string, bool) {
+	name = strings.TrimPrefix(name, "/")
+	for _, candidate := range []string{path.Join(dir, name), path.Clean(name)} {
+		if _, ok := a.files[candidate]; ok {
+			return candidate, true
+		}
+	}
+	found := ""
+	for _, p := range a.paths {
+		if strings.HasSuffix(p, "/"+name) {
+			if found != "" {
+				return "", false
+			}
+			found = p
+		}
+	}
+	return found, found != ""
+}
+
+// read decompresses the file, up to the size limits of the zip whatever sizes its headers declare.
+func (a *zipArchive) read(name string) ([]byte, error) {
+	file, ok := a.files[name]
+	if !ok {
+		return nil, errors.Errorf("file %s not found", name)
+	}
+	reader, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	limit := min(int64(maxZipEntrySize), a.remaining)
+	var buffer bytes.Buffer
+	if _, err := buffer.ReadFrom(io.LimitReader(reader, limit+1)); err != nil {
+		return nil, err
+	}
+	if int64(buffer.Len()) > limit {
+		return nil, errors.Errorf("file %s exceeds the size limit of the zip", name)
+	}
+	a.remaining -= int64(buffer.Len())
+	return buffer.Bytes(), nil
+}
+
+// readAttachment reads the file referenced by the name from the directory dir as an attachment.
+func (a *zipArchive) readAttachment(dir, name, mimeType string) (*Attachment, error) {
+	p, ok := a.lookup(dir, name)
+	if !ok {
+		return nil, errors.Errorf("attachment %s not found", name)
+	}
+	blob, err := a.read(p)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read attachment %s", name)
+	}
+	if mimeType == "" {
+		mimeType = detectContentType(p, blob)
+	}
+	return &Attachment{
+		Filename: path.Base(p),
+		Type:     mimeType,
+		Blob:     blob,
+	}, nil
+}
+
+// normalizeName returns the name of a note used to resolve the links to it.
+func normalizeName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// formatTag returns the tag as a hashtag of the memo content.
+func formatTag(tag string) string {
+	tag = strings.TrimPrefix(strings.TrimSpace(tag), "#")
+	return strings.Join(strings.Fields(tag), "-")
+}
+
+func detectContentType(name string, blob []byte) string {
+	if mimeType := mime.TypeByExtension(path.Ext(name)); mimeType != "" {
+		return mimeType
+	}
+	return http.DetectContentType(blob)
+}
@@ -0,0 +1,146 @@
+package importer
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestZip(t *testing.T, files map[string]string) []byte {
+	var buffer bytes.Buffer
+	writer := zip.NewWriter(&buffer)
+	for name, content := range files {
+		file, err := writer.Create(name)
+		require.NoError(t, err)
+		_, err = file.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+	return buffer.Bytes()
+}
+
+func TestParseMarkdownArchive(t *testing.T) {
+	data := newTestZip(t, map[string]string{
+		"memos/abc.md": `---
+uid: abc
+visibility: PUBLIC
+row_status: ARCHIVED
+tags:
+  - work
+create_time: 2023-11-14T22:13:20Z
+relations:
+  - type: REFERENCE
+    memo: def
+resources:
+  - filename: photo.png
+    path: resources/abc/photo.png
+---
+#work hello
+`,
+		"resources/abc/photo.png":  "png",
+		"Vault/Daily/Today.md":     "---\ntags: journal, daily\naliases: [today]\ncreated: 2024-01-02\n---\nSee [[Ideas#Top|my ideas]] and [[Missing]].\n![[cat.jpg]]\n```\n[[Code]]\n```",
+		"Vault/Ideas.md":           "# Ideas\n\n[back](Daily/Today.md)",
+		"Vault/assets/cat.jpg":     "jpg",
+		"Vault/.obsidian/app.json": "{}",
+	})
+	notes, err := Parse(SourceObsidian, data)
+	require.NoError(t, err)
+	require.Len(t, notes, 3)
+
+	today, ideas, memo := notes[0], notes[1], notes[2]
+	require.Equal(t, "Vault/Ideas.md", ideas.Source)
+	require.Equal(t, "# Ideas\n\nback", ideas.Content)
+	require.Equal(t, []string{"vault/daily/today"}, ideas.Links)
+
+	require.Equal(t, "# Today\n\nSee my ideas and Missing.\n\n```\n[[Code]]\n```", today.Content)
+	require.Equal(t, []string{"journal", "daily"}, today.Tags)
+	require.Equal(t, []string{"vault/daily/today", "today", "today"}, today.Names)
+	require.Equal(t, []string{"ideas", "missing"}, today.Links)
+	require.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), today.CreateTime)
+	require.Len(t, today.Attachments, 1)
+	require.Equal(t, "cat.jpg", today.Attachments[0].Filename)
+	require.Equal(t, "image/jpeg", today.Attachments[0].Type)
+
+	require.Equal(t, "abc", memo.UID)
+	require.Equal(t, "#work hello", memo.Content)
+	require.Equal(t, "PUBLIC", memo.Visibility)
+	require.True(t, memo.Archived)
+	require.Equal(t, time.Unix(1700000000, 0).UTC(), memo.CreateTime)
+	require.Equal(t, []*Relation{{Type: "REFERENCE", UID: "def"}}, memo.Relations)
+	require.Len(t, memo.Attachments, 1)
+	require.Equal(t, []byte("png"), memo.Attachments[0].Blob)
+	require.Empty(t, memo.Warnings)
+}
+
+func TestParseGoogleKeepArchive(t *testing.T) {
+	data := newTestZip(t, map[string]string{
+		"Takeout/Keep/Shopping.json": `{"title":"Shopping","isPinned":true,"isTrashed":false,"userEditedTimestampUsec":1700000000000000,"createdTimestampUsec":1600000000000000,
+			"listContent":[{"text":"Milk","isChecked":true},{"text":"Eggs","isChecked":false}],
+			"labels":[{"name":"Home Stuff"}],"attachments":[{"filePath":"photo.jpg","mimetype":"image/jpeg"}]}`,
+		"Takeout/Keep/Old.json":    `{"title":"Old","isTrashed":true,"userEditedTimestampUsec":1700000000000000}`,
+		"Takeout/Keep/Labels.json": `{"labels":[]}`,
+		"Takeout/Keep/photo.jpg":   "jpg",
+	})
+	notes, err := Parse(SourceGoogleKeep, data)
+	require.NoError(t, err)
+	require.Len(t, notes, 1)
+	note := notes[0]
+	require.Equal(t, "# Shopping\n\n- [x] Milk\n- [ ] Eggs", note.Content)
+	require.Equal(t, []string{"Home-Stuff"}, note.Tags)
+	require.True(t, note.Pinned)
+	require.Equal(t, time.UnixMicro(1600000000000000), note.CreateTime)
+	require.Len(t, note.Attachments, 1)
+}
+
+func TestParseEvernoteExport(t *testing.T) {
+	data := []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE en-export SYSTEM "http://xml.evernote.com/pub/evernote-export4.dtd">
+<en-export>
+  <note>
+    <title>Trip</title>
+    <content><![CDATA[<?xml version="1.0" encoding="UTF-8"?><!DOCTYPE en-note SYSTEM "http://xml.evernote.com/pub/enml2.dtd"><en-note><div>Pack <b>light</b></div><div><en-todo checked="true"/>Passport</div><ul><li>Tent</li></ul><en-media hash="abc" type="image/png"/></en-note>]]></content>
+    <created>20230101T100000Z</created>
+    <updated>20230102T100000Z</updated>
+    <tag>travel plans</tag>
+    <resource>
+      <data encoding="base64">cG5n</data>
+      <mime>image/png</mime>
+      <resource-attributes><file-name>map.png</file-name></resource-attributes>
+    </resource>
+  </note>
+</en-export>`)
+	notes, err := Parse(SourceEvernote, data)
+	require.NoError(t, err)
+	require.Len(t, notes, 1)
+	note := notes[0]
+	require.Equal(t, "# Trip\n\nPack **light**\n\n- [x] Passport\n\n- Tent", note.Content)
+	require.Equal(t, []string{"travel-plans"}, note.Tags)
+	require.Equal(t, time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC), note.CreateTime)
+	require.Equal(t, time.Date(2023, 1, 2, 10, 0, 0, 0, time.UTC), note.UpdateTime)
+	require.Len(t, note.Attachments, 1)
+	require.Equal(t, "map.png", note.Attachments[0].Filename)
+	require.Equal(t, []byte("png"), note.Attachments[0].Blob)
+}
+
+func TestZipArchiveSizeLimits(t *testing.T) {
+	// A file that decompresses beyond the limit rejects the whole zip.
+	data := newTestZip(t, map[string]string{
+		"notes/big.md": strings.Repeat("a", maxZipEntrySize+1),
+	})
+	_, err := Parse(SourceMarkdown, data)
+	require.ErrorContains(t, err, "exceeds the size limit")
+
+	// The files are read up to the size left in the zip, whatever their headers declare.
+	archive, err := newZipArchive(newTestZip(t, map[string]string{"a.md": "hello", "b.md": "world"}))
+	require.NoError(t, err)
+	archive.remaining = 8
+	blob, err := archive.read("a.md")
+	require.NoError(t, err)
+	require.Equal(t, []byte("hello"), blob)
+	_, err = archive.read("b.md")
+	require.ErrorContains(t, err, "exceeds the size limit")
+}
@@ -0,0 +1,107 @@
+package importer
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+)
+
+// keepNote is a note of the Keep folder of Google Takeout.
+type keepNote struct {
+	Title                   string            `json:"title"`
+	TextContent             string            `json:"textContent"`
+	ListContent             []*keepListItem   `json:"listContent"`
+	Labels                  []*keepLabel      `json:"labels"`
+	Attachments             []*keepAttachment `json:"attachments"`
+	IsPinned                bool              `json:"isPinned"`
+	IsArchived              bool              `json:"isArchived"`
+	IsTrashed               bool              `json:"isTrashed"`
+	CreatedTimestampUsec    int64             `json:"createdTimestampUsec"`
+	UserEditedTimestampUsec int64             `json:"userEditedTimestampUsec"`
+}
+
+type keepListItem struct {
+	Text      string `json:"text"`
+	IsChecked bool   `json:"isChecked"`
+}
+
+type keepLabel struct {
+	Name string `json:"name"`
+}
+
+type keepAttachment struct {
+	FilePath string `json:"filePath"`
+	Mimetype string `json:"mimetype"`
+}
+
+func parseGoogleKeepArchive(archive *zipArchive) ([]*Note, error) {
+	notes := []*Note{}
+	for _, p := range archive.paths {
+		if !strings.EqualFold(path.Ext(p), ".json") {
+			continue
+		}
+		data, err := archive.read(p)
+		if err != nil {
+			return nil, err
+		}
+		keepNote := &keepNote{}
+		// Takeout holds other JSON files, such as Labels.json, that are not notes.
+		if err := json.Unmarshal(data, keepNote); err != nil || keepNote.UserEditedTimestampUsec == 0 {
+			continue
+		}
+		if keepNote.IsTrashed {
+			continue
+		}
+		notes = append(notes, convertKeepNote(archive, p, keepNote))
+	}
+	return notes, nil
+}
+
+func convertKeepNote(archive *zipArchive, p string, keepNote *keepNote) *Note {
+	note := &Note{
+		Source:     p,
+		Pinned:     keepNote.IsPinned,
+		Archived:   keepNote.IsArchived,
+		UpdateTime: time.UnixMicro(keepNote.UserEditedTimestampUsec),
+	}
+	note.CreateTime = note.UpdateTime
+	if keepNote.CreatedTimestampUsec != 0 {
+		note.CreateTime = time.UnixMicro(keepNote.CreatedTimestampUsec)
+	}
+	if keepNote.Title != "" {
+		note.Names = []string{normalizeName(keepNote.Title)}
+	}
+
+	lines := []string{}
+	if keepNote.Title != "" {
+		lines = append(lines, "# "+keepNote.Title, "")
+	}
+	if text := strings.TrimSpace(keepNote.TextContent); text != "" {
+		lines = append(lines, text)
+	}
+	for _, item := range keepNote.ListContent {
+		checkbox := "[ ]"
+		if item.IsChecked {
+			checkbox = "[x]"
+		}
+		lines = append(lines, fmt.Sprintf("- %s %s", checkbox, item.Text))
+	}
+	note.Content = strings.TrimSpace(strings.Join(lines, "\n"))
+
+	for _, label := range keepNote.Labels {
+		if tag := formatTag(label.Name); tag != "" {
+			note.Tags = append(note.Tags, tag)
+		}
+	}
+	for _, keepAttachment := range keepNote.Attachments {
+		attachment, err := archive.readAttachment(path.Dir(p), keepAttachment.FilePath, keepAttachment.Mimetype)
+		if err != nil {
+			note.Warnings = append(note.Warnings, err.Error())
+			continue
+		}
+		note.Attachments = append(note.Attachments, attachment)
+	}
+	return note
+}
@@ -0,0 +1,291 @@
+package importer
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	// wikiLinkRegexp matches the wiki-links and the embeds of Obsidian, such as [[Note#Heading|Alias]] and ![[image.png]].
+	wikiLinkRegexp = regexp.MustCompile(`(!?)\[\[([^\[\]]+?)\]\]`)
+	// markdownLinkRegexp matches the Markdown links and images with a target without spaces.
+	markdownLinkRegexp = regexp.MustCompile(`(!?)\[([^\[\]]*)\]\(([^()\s]+)(?:\s+"[^"]*")?\)`)
+)
+
+// The time layouts accepted in the front matter.
+var frontMatterTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// markdownFrontMatter is the YAML front matter of a Markdown file.
+// It reads the front matter of the archive of ExportUserData and the common properties of Obsidian.
+type markdownFrontMatter struct {
+	UID        string                 `yaml:"uid"`
+	Visibility string                 `yaml:"visibility"`
+	RowStatus  string                 `yaml:"row_status"`
+	Pinned     bool                   `yaml:"pinned"`
+	Tags       frontMatterStrings     `yaml:"tags"`
+	Aliases    frontMatterStrings     `yaml:"aliases"`
+	CreateTime string                 `yaml:"create_time"`
+	Created    string                 `yaml:"created"`
+	Date       string                 `yaml:"date"`
+	UpdateTime string                 `yaml:"update_time"`
+	Updated    string                 `yaml:"updated"`
+	Location   *frontMatterLocation   `yaml:"location"`
+	Relations  []*frontMatterRelation `yaml:"relations"`
+	Resources  []*frontMatterResource `yaml:"resources"`
+}
+
+type frontMatterLocation struct {
+	Placeholder string  `yaml:"placeholder"`
+	Latitude    float64 `yaml:"latitude"`
+	Longitude   float64 `yaml:"longitude"`
+}
+
+type frontMatterRelation struct {
+	Type string `yaml:"type"`
+	Memo string `yaml:"memo"`
+}
+
+type frontMatterResource struct {
+	Filename string `yaml:"filename"`
+	Type     string `yaml:"type"`
+	Path     string `yaml:"path"`
+	Link     string `yaml:"link"`
+}
+
+// frontMatterStrings is a list of strings written either as a YAML sequence or as a comma separated string.
+type frontMatterStrings []string
+
+func (s *frontMatterStrings) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		*s = nil
+		for _, item := range strings.Split(value.Value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				*s = append(*s, item)
+			}
+		}
+		return nil
+	}
+	var items []string
+	if err := value.Decode(&items); err != nil {
+		return err
+	}
+	*s = items
+	return nil
+}
+
+func parseMarkdownArchive(archive *zipArchive) ([]*Note, error) {
+	notes := []*Note{}
+	for _, p := range archive.paths {
+		if !strings.EqualFold(path.Ext(p), ".md") {
+			continue
+		}
+		data, err := archive.read(p)
+		if err != nil {
+			return nil, err
+		}
+		notes = append(notes, parseMarkdownNote(archive, p, string(data)))
+	}
+	return notes, nil
+}
+
+func parseMarkdownNote(archive *zipArchive, p, data string) *Note {
+	name := strings.TrimSuffix(p, path.Ext(p))
+	note := &Note{
+		Source:     p,
+		CreateTime: archive.files[p].Modified,
+		UpdateTime: archive.files[p].Modified,
+		Names:      []string{normalizeName(name), normalizeName(path.Base(name))},
+	}
+
+	frontMatter, body, ok := splitFrontMatter(data)
+	if ok {
+		if err := applyMarkdownFrontMatter(archive, note, frontMatter); err != nil {
+			note.Warnings = append(note.Warnings, fmt.Sprintf("invalid front matter: %v", err))
+		}
+	}
+	body = convertMarkdownLinks(archive, note, path.Dir(p), body)
+	// The notes of other apps keep their title in the filename.
+	if note.UID == "" && !strings.HasPrefix(body, "# ") {
+		body = strings.TrimSpace("# " + path.Base(name) + "\n\n" + body)
+	}
+	note.Content = body
+	return note
+}
+
+// splitFrontMatter splits the data into its YAML front matter and its body.
+func splitFrontMatter(data string) (string, string, bool) {
+	data = strings.TrimPrefix(data, "\ufeff")
+	data = strings.ReplaceAll(data, "\r\n", "\n")
+	if !strings.HasPrefix(data, "---\n") {
+		return "", strings.TrimSpace(data), false
+	}
+	rest := data[len("---\n"):]
+	if strings.HasPrefix(rest, "---\n") {
+		return "", strings.TrimSpace(rest[len("---\n"):]), true
+	}
+	end := strings.Index(rest, "\n---\n")
+	if end < 0 {
+		if !strings.HasSuffix(rest, "\n---") {
+			return "", strings.TrimSpace(data), false
+		}
+		return rest[:len(rest)-len("\n---")], "", true
+	}
+	return rest[:end], strings.TrimSpace(rest[end+len("\n---\n"):]), true
+}
+
+func applyMarkdownFrontMatter(archive *zipArchive, note *Note, data string) error {
+	frontMatter := &markdownFrontMatter{}
+	if err := yaml.Unmarshal([]byte(data), frontMatter); err != nil {
+		return err
+	}
+
+	note.UID = frontMatter.UID
+	if note.UID != "" {
+		note.Names = append(note.Names, normalizeName(note.UID))
+	}
+	note.Visibility = strings.ToUpper(frontMatter.Visibility)
+	note.Archived = strings.EqualFold(frontMatter.RowStatus, "ARCHIVED")
+	note.Pinned = frontMatter.Pinned
+	for _, tag := range frontMatter.Tags {
+		if tag = formatTag(tag); tag != "" {
+			note.Tags = append(note.Tags, tag)
+		}
+	}
+	for _, alias := range frontMatter.Aliases {
+		note.Names = append(note.Names, normalizeName(alias))
+	}
+	if createTime, ok := parseFrontMatterTime(note, frontMatter.CreateTime, frontMatter.Created, frontMatter.Date); ok {
+		note.CreateTime, note.UpdateTime = createTime, createTime
+	}
+	if updateTime, ok := parseFrontMatterTime(note, frontMatter.UpdateTime, frontMatter.Updated); ok {
+		note.UpdateTime = updateTime
+	}
+	if location := frontMatter.Location; location != nil {
+		note.Location = &Location{
+			Placeholder: location.Placeholder,
+			Latitude:    location.Latitude,
+			Longitude:   location.Longitude,
+		}
+	}
+	for _, relation := range frontMatter.Relations {
+		if relation.Memo == "" {
+			continue
+		}
+		note.Relations = append(note.Relations, &Relation{
+			Type: strings.ToUpper(relation.Type),
+			UID:  relation.Memo,
+		})
+	}
+	for _, resource := range frontMatter.Resources {
+		if resource.Link != "" {
+			note.Attachments = append(note.Attachments, &Attachment{
+				Filename: resource.Filename,
+				Type:     resource.Type,
+				Link:     resource.Link,
+			})
+			continue
+		}
+		// The paths of the resources are relative to the root of the archive.
+		attachment, err := archive.readAttachment("", resource.Path, resource.Type)
+		if err != nil {
+			note.Warnings = append(note.Warnings, err.Error())
+			continue
+		}
+		if resource.Filename != "" {
+			attachment.Filename = resource.Filename
+		}
+		note.Attachments = append(note.Attachments, attachment)
+	}
+	return nil
+}
+
+// parseFrontMatterTime parses the first of the values that is set.
+func parseFrontMatterTime(note *Note, values ...string) (time.Time, bool) {
+	for _, value := range values {
+		if value == "" {
+			continue
+		}
+		for _, layout := range frontMatterTimeLayouts {
+			if t, err := time.Parse(layout, value); err == nil {
+				return t, true
+			}
+		}
+		note.Warnings = append(note.Warnings, fmt.Sprintf("invalid time %q", value))
+		return time.Time{}, false
+	}
+	return time.Time{}, false
+}
+
+// convertMarkdownLinks turns the embedded files of the body into attachments, and the links to
+// other notes into plain text recorded in the links of the note. Fenced code blocks are kept as is.
+func convertMarkdownLinks(archive *zipArchive, note *Note, dir, body string) string {
+	lines := strings.Split(body, "\n")
+	inCodeBlock := false
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inCodeBlock = !inCodeBlock
+			continue
+		}
+		if inCodeBlock {
+			continue
+		}
+		line = wikiLinkRegexp.ReplaceAllStringFunc(line, func(match string) string {
+			groups := wikiLinkRegexp.FindStringSubmatch(match)
+			target, alias, _ := strings.Cut(groups[2], "|")
+			target, _, _ = strings.Cut(target, "#")
+			target = strings.TrimSpace(target)
+			if target == "" {
+				return alias
+			}
+			if groups[1] == "!" && !strings.EqualFold(path.Ext(target), ".md") && path.Ext(target) != "" {
+				return embedAttachment(archive, note, dir, target, match)
+			}
+			note.Links = append(note.Links, normalizeName(strings.TrimSuffix(target, ".md")))
+			if alias != "" {
+				return alias
+			}
+			return path.Base(target)
+		})
+		line = markdownLinkRegexp.ReplaceAllStringFunc(line, func(match string) string {
+			groups := markdownLinkRegexp.FindStringSubmatch(match)
+			target, err := url.PathUnescape(groups[3])
+			if err != nil || strings.Contains(target, ":") || strings.HasPrefix(target, "#") {
+				return match
+			}
+			if strings.EqualFold(path.Ext(target), ".md") {
+				note.Links = append(note.Links, normalizeName(strings.TrimSuffix(path.Clean(path.Join(dir, target)), path.Ext(target))))
+				return groups[2]
+			}
+			if groups[1] == "!" {
+				return embedAttachment(archive, note, dir, target, match)
+			}
+			return match
+		})
+		lines[i] = line
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// embedAttachment attaches the embedded file to the note and removes the embed,
+// or keeps the embed if the file is not in the archive.
+func embedAttachment(archive *zipArchive, note *Note, dir, target, embed string) string {
+	attachment, err := archive.readAttachment(dir, target, "")
+	if err != nil {
+		note.Warnings = append(note.Warnings, err.Error())
+		return embed
+	}
+	note.Attachments = append(note.Attachments, attachment)
+	return ""
+}
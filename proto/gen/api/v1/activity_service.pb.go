@@ -115,11 +115,13 @@ func (x *Activity) GetPayload() *ActivityPayload {
 }
 
 type ActivityPayload struct {
-	state         protoimpl.MessageState        `protogen:"open.v1"`
-	MemoComment   *ActivityMemoCommentPayload   `protobuf:"bytes,1,opt,name=memo_comment,json=memoComment,proto3" json:"memo_comment,omitempty"`
-	VersionUpdate *ActivityVersionUpdatePayload `protobuf:"bytes,2,opt,name=version_update,json=versionUpdate,proto3" json:"version_update,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState           `protogen:"open.v1"`
+	MemoComment      *ActivityMemoCommentPayload      `protobuf:"bytes,1,opt,name=memo_comment,json=memoComment,proto3" json:"memo_comment,omitempty"`
+	VersionUpdate    *ActivityVersionUpdatePayload    `protobuf:"bytes,2,opt,name=version_update,json=versionUpdate,proto3" json:"version_update,omitempty"`
+	MemoCollaborator *ActivityMemoCollaboratorPayload `protobuf:"bytes,3,opt,name=memo_collaborator,json=memoCollaborator,proto3" json:"memo_collaborator,omitempty"`
+	MemoReminder     *ActivityMemoReminderPayload     `protobuf:"bytes,4,opt,name=memo_reminder,json=memoReminder,proto3" json:"memo_reminder,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *ActivityPayload) Reset() {
@@ -166,6 +168,20 @@ func (x *ActivityPayload) GetVersionUpdate() *ActivityVersionUpdatePayload {
 	return nil
 }
 
+func (x *ActivityPayload) GetMemoCollaborator() *ActivityMemoCollaboratorPayload {
+	if x != nil {
+		return x.MemoCollaborator
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetMemoReminder() *ActivityMemoReminderPayload {
+	if x != nil {
+		return x.MemoReminder
+	}
+	return nil
+}
+
 // ActivityMemoCommentPayload represents the payload of a memo comment activity.
 type ActivityMemoCommentPayload struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -266,6 +282,107 @@ func (x *ActivityVersionUpdatePayload) GetVersion() string {
 	return ""
 }
 
+// ActivityMemoCollaboratorPayload represents the payload of an activity adding a collaborator to a memo.
+type ActivityMemoCollaboratorPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The id of the memo.
+	MemoId int32 `protobuf:"varint,1,opt,name=memo_id,json=memoId,proto3" json:"memo_id,omitempty"`
+	// The role of the collaborator, VIEWER or EDITOR.
+	Role          string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityMemoCollaboratorPayload) Reset() {
+	*x = ActivityMemoCollaboratorPayload{}
+	mi := &file_api_v1_activity_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityMemoCollaboratorPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityMemoCollaboratorPayload) ProtoMessage() {}
+
+func (x *ActivityMemoCollaboratorPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_activity_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityMemoCollaboratorPayload.ProtoReflect.Descriptor instead.
+func (*ActivityMemoCollaboratorPayload) Descriptor() ([]byte, []int) {
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ActivityMemoCollaboratorPayload) GetMemoId() int32 {
+	if x != nil {
+		return x.MemoId
+	}
+	return 0
+}
+
+func (x *ActivityMemoCollaboratorPayload) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+// ActivityMemoReminderPayload represents the payload of an activity reminding the creator of a memo.
+type ActivityMemoReminderPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The id of the memo.
+	MemoId        int32 `protobuf:"varint,1,opt,name=memo_id,json=memoId,proto3" json:"memo_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityMemoReminderPayload) Reset() {
+	*x = ActivityMemoReminderPayload{}
+	mi := &file_api_v1_activity_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityMemoReminderPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityMemoReminderPayload) ProtoMessage() {}
+
+func (x *ActivityMemoReminderPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_activity_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityMemoReminderPayload.ProtoReflect.Descriptor instead.
+func (*ActivityMemoReminderPayload) Descriptor() ([]byte, []int) {
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ActivityMemoReminderPayload) GetMemoId() int32 {
+	if x != nil {
+		return x.MemoId
+	}
+	return 0
+}
+
 type GetActivityRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The name of the activity.
@@ -277,7 +394,7 @@ type GetActivityRequest struct {
 
 func (x *GetActivityRequest) Reset() {
 	*x = GetActivityRequest{}
-	mi := &file_api_v1_activity_service_proto_msgTypes[4]
+	mi := &file_api_v1_activity_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -289,7 +406,7 @@ func (x *GetActivityRequest) String() string {
 func (*GetActivityRequest) ProtoMessage() {}
 
 func (x *GetActivityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_activity_service_proto_msgTypes[4]
+	mi := &file_api_v1_activity_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -302,7 +419,7 @@ func (x *GetActivityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetActivityRequest.ProtoReflect.Descriptor instead.
 func (*GetActivityRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{4}
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetActivityRequest) GetName() string {
@@ -325,15 +442,22 @@ const file_api_v1_activity_service_proto_rawDesc = "" +
 	"\x05level\x18\x04 \x01(\tR\x05level\x12A\n" +
 	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x04\xe2A\x01\x03R\n" +
 	"createTime\x127\n" +
-	"\apayload\x18\x06 \x01(\v2\x1d.memos.api.v1.ActivityPayloadR\apayload\"\xb1\x01\n" +
+	"\apayload\x18\x06 \x01(\v2\x1d.memos.api.v1.ActivityPayloadR\apayload\"\xdd\x02\n" +
 	"\x0fActivityPayload\x12K\n" +
 	"\fmemo_comment\x18\x01 \x01(\v2(.memos.api.v1.ActivityMemoCommentPayloadR\vmemoComment\x12Q\n" +
-	"\x0eversion_update\x18\x02 \x01(\v2*.memos.api.v1.ActivityVersionUpdatePayloadR\rversionUpdate\"]\n" +
+	"\x0eversion_update\x18\x02 \x01(\v2*.memos.api.v1.ActivityVersionUpdatePayloadR\rversionUpdate\x12Z\n" +
+	"\x11memo_collaborator\x18\x03 \x01(\v2-.memos.api.v1.ActivityMemoCollaboratorPayloadR\x10memoCollaborator\x12N\n" +
+	"\rmemo_reminder\x18\x04 \x01(\v2).memos.api.v1.ActivityMemoReminderPayloadR\fmemoReminder\"]\n" +
 	"\x1aActivityMemoCommentPayload\x12\x17\n" +
 	"\amemo_id\x18\x01 \x01(\x05R\x06memoId\x12&\n" +
 	"\x0frelated_memo_id\x18\x02 \x01(\x05R\rrelatedMemoId\"8\n" +
 	"\x1cActivityVersionUpdatePayload\x12\x18\n" +
-	"\aversion\x18\x01 \x01(\tR\aversion\"(\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\"N\n" +
+	"\x1fActivityMemoCollaboratorPayload\x12\x17\n" +
+	"\amemo_id\x18\x01 \x01(\x05R\x06memoId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\"6\n" +
+	"\x1bActivityMemoReminderPayload\x12\x17\n" +
+	"\amemo_id\x18\x01 \x01(\x05R\x06memoId\"(\n" +
 	"\x12GetActivityRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name2\x86\x01\n" +
 	"\x0fActivityService\x12s\n" +
@@ -352,27 +476,31 @@ func file_api_v1_activity_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_activity_service_proto_rawDescData
 }
 
-var file_api_v1_activity_service_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_api_v1_activity_service_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_api_v1_activity_service_proto_goTypes = []any{
-	(*Activity)(nil),                     // 0: memos.api.v1.Activity
-	(*ActivityPayload)(nil),              // 1: memos.api.v1.ActivityPayload
-	(*ActivityMemoCommentPayload)(nil),   // 2: memos.api.v1.ActivityMemoCommentPayload
-	(*ActivityVersionUpdatePayload)(nil), // 3: memos.api.v1.ActivityVersionUpdatePayload
-	(*GetActivityRequest)(nil),           // 4: memos.api.v1.GetActivityRequest
-	(*timestamppb.Timestamp)(nil),        // 5: google.protobuf.Timestamp
+	(*Activity)(nil),                        // 0: memos.api.v1.Activity
+	(*ActivityPayload)(nil),                 // 1: memos.api.v1.ActivityPayload
+	(*ActivityMemoCommentPayload)(nil),      // 2: memos.api.v1.ActivityMemoCommentPayload
+	(*ActivityVersionUpdatePayload)(nil),    // 3: memos.api.v1.ActivityVersionUpdatePayload
+	(*ActivityMemoCollaboratorPayload)(nil), // 4: memos.api.v1.ActivityMemoCollaboratorPayload
+	(*ActivityMemoReminderPayload)(nil),     // 5: memos.api.v1.ActivityMemoReminderPayload
+	(*GetActivityRequest)(nil),              // 6: memos.api.v1.GetActivityRequest
+	(*timestamppb.Timestamp)(nil),           // 7: google.protobuf.Timestamp
 }
 var file_api_v1_activity_service_proto_depIdxs = []int32{
-	5, // 0: memos.api.v1.Activity.create_time:type_name -> google.protobuf.Timestamp
+	7, // 0: memos.api.v1.Activity.create_time:type_name -> google.protobuf.Timestamp
 	1, // 1: memos.api.v1.Activity.payload:type_name -> memos.api.v1.ActivityPayload
 	2, // 2: memos.api.v1.ActivityPayload.memo_comment:type_name -> memos.api.v1.ActivityMemoCommentPayload
 	3, // 3: memos.api.v1.ActivityPayload.version_update:type_name -> memos.api.v1.ActivityVersionUpdatePayload
-	4, // 4: memos.api.v1.ActivityService.GetActivity:input_type -> memos.api.v1.GetActivityRequest
-	0, // 5: memos.api.v1.ActivityService.GetActivity:output_type -> memos.api.v1.Activity
-	5, // [5:6] is the sub-list for method output_type
-	4, // [4:5] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	4, // 4: memos.api.v1.ActivityPayload.memo_collaborator:type_name -> memos.api.v1.ActivityMemoCollaboratorPayload
+	5, // 5: memos.api.v1.ActivityPayload.memo_reminder:type_name -> memos.api.v1.ActivityMemoReminderPayload
+	6, // 6: memos.api.v1.ActivityService.GetActivity:input_type -> memos.api.v1.GetActivityRequest
+	0, // 7: memos.api.v1.ActivityService.GetActivity:output_type -> memos.api.v1.Activity
+	7, // [7:8] is the sub-list for method output_type
+	6, // [6:7] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_activity_service_proto_init() }
@@ -386,7 +514,7 @@ func file_api_v1_activity_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_activity_service_proto_rawDesc), len(file_api_v1_activity_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
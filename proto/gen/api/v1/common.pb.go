@@ -72,9 +72,11 @@ func (RowStatus) EnumDescriptor() ([]byte, []int) {
 
 // Used internally for obfuscating the page token.
 type PageToken struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Limit  int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// The sort key of the last item of the previous page, for keyset pagination.
+	Cursor        *PageCursor `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -123,14 +125,88 @@ func (x *PageToken) GetOffset() int32 {
 	return 0
 }
 
+func (x *PageToken) GetCursor() *PageCursor {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+// Used internally for the sort key in the page token.
+type PageCursor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pinned        bool                   `protobuf:"varint,1,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	Ts            int64                  `protobuf:"varint,2,opt,name=ts,proto3" json:"ts,omitempty"`
+	Id            int32                  `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PageCursor) Reset() {
+	*x = PageCursor{}
+	mi := &file_api_v1_common_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PageCursor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageCursor) ProtoMessage() {}
+
+func (x *PageCursor) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_common_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageCursor.ProtoReflect.Descriptor instead.
+func (*PageCursor) Descriptor() ([]byte, []int) {
+	return file_api_v1_common_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PageCursor) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *PageCursor) GetTs() int64 {
+	if x != nil {
+		return x.Ts
+	}
+	return 0
+}
+
+func (x *PageCursor) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
 var File_api_v1_common_proto protoreflect.FileDescriptor
 
 const file_api_v1_common_proto_rawDesc = "" +
 	"\n" +
-	"\x13api/v1/common.proto\x12\fmemos.api.v1\"9\n" +
+	"\x13api/v1/common.proto\x12\fmemos.api.v1\"k\n" +
 	"\tPageToken\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x02 \x01(\x05R\x06offset*A\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x120\n" +
+	"\x06cursor\x18\x03 \x01(\v2\x18.memos.api.v1.PageCursorR\x06cursor\"D\n" +
+	"\n" +
+	"PageCursor\x12\x16\n" +
+	"\x06pinned\x18\x01 \x01(\bR\x06pinned\x12\x0e\n" +
+	"\x02ts\x18\x02 \x01(\x03R\x02ts\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\x05R\x02id*A\n" +
 	"\tRowStatus\x12\x1a\n" +
 	"\x16ROW_STATUS_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
@@ -151,17 +227,19 @@ func file_api_v1_common_proto_rawDescGZIP() []byte {
 }
 
 var file_api_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_api_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_api_v1_common_proto_goTypes = []any{
-	(RowStatus)(0),    // 0: memos.api.v1.RowStatus
-	(*PageToken)(nil), // 1: memos.api.v1.PageToken
+	(RowStatus)(0),     // 0: memos.api.v1.RowStatus
+	(*PageToken)(nil),  // 1: memos.api.v1.PageToken
+	(*PageCursor)(nil), // 2: memos.api.v1.PageCursor
 }
 var file_api_v1_common_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	2, // 0: memos.api.v1.PageToken.cursor:type_name -> memos.api.v1.PageCursor
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_common_proto_init() }
@@ -175,7 +253,7 @@ func file_api_v1_common_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_common_proto_rawDesc), len(file_api_v1_common_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
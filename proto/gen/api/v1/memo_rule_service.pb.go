@@ -0,0 +1,865 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: api/v1/memo_rule_service.proto
+
+package apiv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MemoRule applies its actions to the memos of a user that meet its condition
+// when they are created, or when their content is updated.
+// An update only applies the actions other than add_tag if the memo did not meet
+// the condition before, so that they are not applied again on every edit.
+// Scheduled memos are sent to the webhooks once they are published.
+type MemoRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo rule.
+	// Format: users/{id}/memoRules/{rule}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The title of the memo rule.
+	Title     string             `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Condition *MemoRuleCondition `protobuf:"bytes,3,opt,name=condition,proto3" json:"condition,omitempty"`
+	// The actions applied to the memos that meet the condition.
+	Actions       []*MemoRuleAction `protobuf:"bytes,4,rep,name=actions,proto3" json:"actions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoRule) Reset() {
+	*x = MemoRule{}
+	mi := &file_api_v1_memo_rule_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoRule) ProtoMessage() {}
+
+func (x *MemoRule) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_rule_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoRule.ProtoReflect.Descriptor instead.
+func (*MemoRule) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_rule_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MemoRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MemoRule) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *MemoRule) GetCondition() *MemoRuleCondition {
+	if x != nil {
+		return x.Condition
+	}
+	return nil
+}
+
+func (x *MemoRule) GetActions() []*MemoRuleAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+// MemoRuleCondition is met when all its set fields match the memo.
+// An empty condition matches every memo.
+type MemoRuleCondition struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The RE2 regular expression the content must match.
+	// e.g. "(?i)^todo"
+	ContentPattern string `protobuf:"bytes,1,opt,name=content_pattern,json=contentPattern,proto3" json:"content_pattern,omitempty"`
+	// Whether the memo must have a link.
+	HasLink bool `protobuf:"varint,2,opt,name=has_link,json=hasLink,proto3" json:"has_link,omitempty"`
+	// Whether the memo must have code.
+	HasCode bool `protobuf:"varint,3,opt,name=has_code,json=hasCode,proto3" json:"has_code,omitempty"`
+	// The client the memo must be sent from, as in the source of CreateMemoRequest.
+	// e.g. "telegram"
+	Source        string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoRuleCondition) Reset() {
+	*x = MemoRuleCondition{}
+	mi := &file_api_v1_memo_rule_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoRuleCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoRuleCondition) ProtoMessage() {}
+
+func (x *MemoRuleCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_rule_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoRuleCondition.ProtoReflect.Descriptor instead.
+func (*MemoRuleCondition) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_rule_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MemoRuleCondition) GetContentPattern() string {
+	if x != nil {
+		return x.ContentPattern
+	}
+	return ""
+}
+
+func (x *MemoRuleCondition) GetHasLink() bool {
+	if x != nil {
+		return x.HasLink
+	}
+	return false
+}
+
+func (x *MemoRuleCondition) GetHasCode() bool {
+	if x != nil {
+		return x.HasCode
+	}
+	return false
+}
+
+func (x *MemoRuleCondition) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type MemoRuleAction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Action:
+	//
+	//	*MemoRuleAction_AddTag
+	//	*MemoRuleAction_SetVisibility
+	//	*MemoRuleAction_Pin
+	//	*MemoRuleAction_AddRelation
+	//	*MemoRuleAction_DispatchWebhook
+	Action        isMemoRuleAction_Action `protobuf_oneof:"action"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoRuleAction) Reset() {
+	*x = MemoRuleAction{}
+	mi := &file_api_v1_memo_rule_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoRuleAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoRuleAction) ProtoMessage() {}
+
+func (x *MemoRuleAction) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_rule_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoRuleAction.ProtoReflect.Descriptor instead.
+func (*MemoRuleAction) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_rule_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MemoRuleAction) GetAction() isMemoRuleAction_Action {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+func (x *MemoRuleAction) GetAddTag() string {
+	if x != nil {
+		if x, ok := x.Action.(*MemoRuleAction_AddTag); ok {
+			return x.AddTag
+		}
+	}
+	return ""
+}
+
+func (x *MemoRuleAction) GetSetVisibility() Visibility {
+	if x != nil {
+		if x, ok := x.Action.(*MemoRuleAction_SetVisibility); ok {
+			return x.SetVisibility
+		}
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+func (x *MemoRuleAction) GetPin() bool {
+	if x != nil {
+		if x, ok := x.Action.(*MemoRuleAction_Pin); ok {
+			return x.Pin
+		}
+	}
+	return false
+}
+
+func (x *MemoRuleAction) GetAddRelation() string {
+	if x != nil {
+		if x, ok := x.Action.(*MemoRuleAction_AddRelation); ok {
+			return x.AddRelation
+		}
+	}
+	return ""
+}
+
+func (x *MemoRuleAction) GetDispatchWebhook() int32 {
+	if x != nil {
+		if x, ok := x.Action.(*MemoRuleAction_DispatchWebhook); ok {
+			return x.DispatchWebhook
+		}
+	}
+	return 0
+}
+
+type isMemoRuleAction_Action interface {
+	isMemoRuleAction_Action()
+}
+
+type MemoRuleAction_AddTag struct {
+	// The tag added to the content, without the leading #.
+	AddTag string `protobuf:"bytes,1,opt,name=add_tag,json=addTag,proto3,oneof"`
+}
+
+type MemoRuleAction_SetVisibility struct {
+	// The visibility of the memo. It does not override the visibility set by an update of the memo.
+	SetVisibility Visibility `protobuf:"varint,2,opt,name=set_visibility,json=setVisibility,proto3,enum=memos.api.v1.Visibility,oneof"`
+}
+
+type MemoRuleAction_Pin struct {
+	// Pins the memo when true.
+	Pin bool `protobuf:"varint,3,opt,name=pin,proto3,oneof"`
+}
+
+type MemoRuleAction_AddRelation struct {
+	// The name of the memo the memo references.
+	// Format: memos/{id}
+	AddRelation string `protobuf:"bytes,4,opt,name=add_relation,json=addRelation,proto3,oneof"`
+}
+
+type MemoRuleAction_DispatchWebhook struct {
+	// The id of the webhook of the user the memo is sent to.
+	DispatchWebhook int32 `protobuf:"varint,5,opt,name=dispatch_webhook,json=dispatchWebhook,proto3,oneof"`
+}
+
+func (*MemoRuleAction_AddTag) isMemoRuleAction_Action() {}
+
+func (*MemoRuleAction_SetVisibility) isMemoRuleAction_Action() {}
+
+func (*MemoRuleAction_Pin) isMemoRuleAction_Action() {}
+
+func (*MemoRuleAction_AddRelation) isMemoRuleAction_Action() {}
+
+func (*MemoRuleAction_DispatchWebhook) isMemoRuleAction_Action() {}
+
+type ListMemoRulesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the user.
+	// Format: users/{id}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoRulesRequest) Reset() {
+	*x = ListMemoRulesRequest{}
+	mi := &file_api_v1_memo_rule_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoRulesRequest) ProtoMessage() {}
+
+func (x *ListMemoRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_rule_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoRulesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_rule_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListMemoRulesRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type ListMemoRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*MemoRule            `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoRulesResponse) Reset() {
+	*x = ListMemoRulesResponse{}
+	mi := &file_api_v1_memo_rule_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoRulesResponse) ProtoMessage() {}
+
+func (x *ListMemoRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_rule_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoRulesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_rule_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListMemoRulesResponse) GetRules() []*MemoRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type CreateMemoRuleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the user.
+	// Format: users/{id}
+	Parent        string    `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	Rule          *MemoRule `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMemoRuleRequest) Reset() {
+	*x = CreateMemoRuleRequest{}
+	mi := &file_api_v1_memo_rule_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMemoRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMemoRuleRequest) ProtoMessage() {}
+
+func (x *CreateMemoRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_rule_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMemoRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateMemoRuleRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_rule_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateMemoRuleRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateMemoRuleRequest) GetRule() *MemoRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type UpdateMemoRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *MemoRule              `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMemoRuleRequest) Reset() {
+	*x = UpdateMemoRuleRequest{}
+	mi := &file_api_v1_memo_rule_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMemoRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMemoRuleRequest) ProtoMessage() {}
+
+func (x *UpdateMemoRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_rule_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMemoRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMemoRuleRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_rule_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateMemoRuleRequest) GetRule() *MemoRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *UpdateMemoRuleRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteMemoRuleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo rule.
+	// Format: users/{id}/memoRules/{rule}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMemoRuleRequest) Reset() {
+	*x = DeleteMemoRuleRequest{}
+	mi := &file_api_v1_memo_rule_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMemoRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMemoRuleRequest) ProtoMessage() {}
+
+func (x *DeleteMemoRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_rule_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMemoRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMemoRuleRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_rule_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteMemoRuleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type TestMemoRulesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the user.
+	// Format: users/{id}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The content of the memo.
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// The client the memo is sent from.
+	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	// Optional. The rules tested instead of the memo rules of the user, in the order they are applied.
+	Rules         []*MemoRule `protobuf:"bytes,4,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestMemoRulesRequest) Reset() {
+	*x = TestMemoRulesRequest{}
+	mi := &file_api_v1_memo_rule_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestMemoRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestMemoRulesRequest) ProtoMessage() {}
+
+func (x *TestMemoRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_rule_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestMemoRulesRequest.ProtoReflect.Descriptor instead.
+func (*TestMemoRulesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_rule_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TestMemoRulesRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *TestMemoRulesRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *TestMemoRulesRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *TestMemoRulesRequest) GetRules() []*MemoRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type TestMemoRulesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The rules the memo meets the condition of, in the order they are applied.
+	MatchedRules []*MemoRule `protobuf:"bytes,1,rep,name=matched_rules,json=matchedRules,proto3" json:"matched_rules,omitempty"`
+	// The content of the memo with the added tags.
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// The visibility the rules set, or VISIBILITY_UNSPECIFIED if they do not set one.
+	Visibility Visibility `protobuf:"varint,3,opt,name=visibility,proto3,enum=memos.api.v1.Visibility" json:"visibility,omitempty"`
+	// Whether the rules pin the memo.
+	Pinned bool `protobuf:"varint,4,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	// The names of the memos the memo references.
+	// Format: memos/{id}
+	Relations []string `protobuf:"bytes,5,rep,name=relations,proto3" json:"relations,omitempty"`
+	// The ids of the webhooks the memo is sent to.
+	Webhooks      []int32 `protobuf:"varint,6,rep,packed,name=webhooks,proto3" json:"webhooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestMemoRulesResponse) Reset() {
+	*x = TestMemoRulesResponse{}
+	mi := &file_api_v1_memo_rule_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestMemoRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestMemoRulesResponse) ProtoMessage() {}
+
+func (x *TestMemoRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_rule_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestMemoRulesResponse.ProtoReflect.Descriptor instead.
+func (*TestMemoRulesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_rule_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TestMemoRulesResponse) GetMatchedRules() []*MemoRule {
+	if x != nil {
+		return x.MatchedRules
+	}
+	return nil
+}
+
+func (x *TestMemoRulesResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *TestMemoRulesResponse) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+func (x *TestMemoRulesResponse) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *TestMemoRulesResponse) GetRelations() []string {
+	if x != nil {
+		return x.Relations
+	}
+	return nil
+}
+
+func (x *TestMemoRulesResponse) GetWebhooks() []int32 {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+var File_api_v1_memo_rule_service_proto protoreflect.FileDescriptor
+
+const file_api_v1_memo_rule_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1eapi/v1/memo_rule_service.proto\x12\fmemos.api.v1\x1a\x19api/v1/memo_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\"\xb1\x01\n" +
+	"\bMemoRule\x12\x18\n" +
+	"\x04name\x18\x01 \x01(\tB\x04\xe2A\x01\x03R\x04name\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12=\n" +
+	"\tcondition\x18\x03 \x01(\v2\x1f.memos.api.v1.MemoRuleConditionR\tcondition\x126\n" +
+	"\aactions\x18\x04 \x03(\v2\x1c.memos.api.v1.MemoRuleActionR\aactions\"\x8a\x01\n" +
+	"\x11MemoRuleCondition\x12'\n" +
+	"\x0fcontent_pattern\x18\x01 \x01(\tR\x0econtentPattern\x12\x19\n" +
+	"\bhas_link\x18\x02 \x01(\bR\ahasLink\x12\x19\n" +
+	"\bhas_code\x18\x03 \x01(\bR\ahasCode\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\"\xde\x01\n" +
+	"\x0eMemoRuleAction\x12\x19\n" +
+	"\aadd_tag\x18\x01 \x01(\tH\x00R\x06addTag\x12A\n" +
+	"\x0eset_visibility\x18\x02 \x01(\x0e2\x18.memos.api.v1.VisibilityH\x00R\rsetVisibility\x12\x12\n" +
+	"\x03pin\x18\x03 \x01(\bH\x00R\x03pin\x12#\n" +
+	"\fadd_relation\x18\x04 \x01(\tH\x00R\vaddRelation\x12+\n" +
+	"\x10dispatch_webhook\x18\x05 \x01(\x05H\x00R\x0fdispatchWebhookB\b\n" +
+	"\x06action\".\n" +
+	"\x14ListMemoRulesRequest\x12\x16\n" +
+	"\x06parent\x18\x01 \x01(\tR\x06parent\"E\n" +
+	"\x15ListMemoRulesResponse\x12,\n" +
+	"\x05rules\x18\x01 \x03(\v2\x16.memos.api.v1.MemoRuleR\x05rules\"[\n" +
+	"\x15CreateMemoRuleRequest\x12\x16\n" +
+	"\x06parent\x18\x01 \x01(\tR\x06parent\x12*\n" +
+	"\x04rule\x18\x02 \x01(\v2\x16.memos.api.v1.MemoRuleR\x04rule\"\x80\x01\n" +
+	"\x15UpdateMemoRuleRequest\x12*\n" +
+	"\x04rule\x18\x01 \x01(\v2\x16.memos.api.v1.MemoRuleR\x04rule\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"+\n" +
+	"\x15DeleteMemoRuleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\x8e\x01\n" +
+	"\x14TestMemoRulesRequest\x12\x16\n" +
+	"\x06parent\x18\x01 \x01(\tR\x06parent\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12,\n" +
+	"\x05rules\x18\x04 \x03(\v2\x16.memos.api.v1.MemoRuleR\x05rules\"\xfa\x01\n" +
+	"\x15TestMemoRulesResponse\x12;\n" +
+	"\rmatched_rules\x18\x01 \x03(\v2\x16.memos.api.v1.MemoRuleR\fmatchedRules\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x128\n" +
+	"\n" +
+	"visibility\x18\x03 \x01(\x0e2\x18.memos.api.v1.VisibilityR\n" +
+	"visibility\x12\x16\n" +
+	"\x06pinned\x18\x04 \x01(\bR\x06pinned\x12\x1c\n" +
+	"\trelations\x18\x05 \x03(\tR\trelations\x12\x1a\n" +
+	"\bwebhooks\x18\x06 \x03(\x05R\bwebhooks2\xee\x05\n" +
+	"\x0fMemoRuleService\x12\x8d\x01\n" +
+	"\rListMemoRules\x12\".memos.api.v1.ListMemoRulesRequest\x1a#.memos.api.v1.ListMemoRulesResponse\"3\xdaA\x06parent\x82\xd3\xe4\x93\x02$\x12\"/api/v1/{parent=users/*}/memoRules\x12\x8d\x01\n" +
+	"\x0eCreateMemoRule\x12#.memos.api.v1.CreateMemoRuleRequest\x1a\x16.memos.api.v1.MemoRule\">\xdaA\vparent,rule\x82\xd3\xe4\x93\x02*:\x04rule\"\"/api/v1/{parent=users/*}/memoRules\x12\x97\x01\n" +
+	"\x0eUpdateMemoRule\x12#.memos.api.v1.UpdateMemoRuleRequest\x1a\x16.memos.api.v1.MemoRule\"H\xdaA\x10rule,update_mask\x82\xd3\xe4\x93\x02/:\x04rule2'/api/v1/{rule.name=users/*/memoRules/*}\x12\x80\x01\n" +
+	"\x0eDeleteMemoRule\x12#.memos.api.v1.DeleteMemoRuleRequest\x1a\x16.google.protobuf.Empty\"1\xdaA\x04name\x82\xd3\xe4\x93\x02$*\"/api/v1/{name=users/*/memoRules/*}\x12\x9d\x01\n" +
+	"\rTestMemoRules\x12\".memos.api.v1.TestMemoRulesRequest\x1a#.memos.api.v1.TestMemoRulesResponse\"C\xdaA\x0eparent,content\x82\xd3\xe4\x93\x02,:\x01*\"'/api/v1/{parent=users/*}/memoRules:testB\xac\x01\n" +
+	"\x10com.memos.api.v1B\x14MemoRuleServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
+
+var (
+	file_api_v1_memo_rule_service_proto_rawDescOnce sync.Once
+	file_api_v1_memo_rule_service_proto_rawDescData []byte
+)
+
+func file_api_v1_memo_rule_service_proto_rawDescGZIP() []byte {
+	file_api_v1_memo_rule_service_proto_rawDescOnce.Do(func() {
+		file_api_v1_memo_rule_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_v1_memo_rule_service_proto_rawDesc), len(file_api_v1_memo_rule_service_proto_rawDesc)))
+	})
+	return file_api_v1_memo_rule_service_proto_rawDescData
+}
+
+var file_api_v1_memo_rule_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_api_v1_memo_rule_service_proto_goTypes = []any{
+	(*MemoRule)(nil),              // 0: memos.api.v1.MemoRule
+	(*MemoRuleCondition)(nil),     // 1: memos.api.v1.MemoRuleCondition
+	(*MemoRuleAction)(nil),        // 2: memos.api.v1.MemoRuleAction
+	(*ListMemoRulesRequest)(nil),  // 3: memos.api.v1.ListMemoRulesRequest
+	(*ListMemoRulesResponse)(nil), // 4: memos.api.v1.ListMemoRulesResponse
+	(*CreateMemoRuleRequest)(nil), // 5: memos.api.v1.CreateMemoRuleRequest
+	(*UpdateMemoRuleRequest)(nil), // 6: memos.api.v1.UpdateMemoRuleRequest
+	(*DeleteMemoRuleRequest)(nil), // 7: memos.api.v1.DeleteMemoRuleRequest
+	(*TestMemoRulesRequest)(nil),  // 8: memos.api.v1.TestMemoRulesRequest
+	(*TestMemoRulesResponse)(nil), // 9: memos.api.v1.TestMemoRulesResponse
+	(Visibility)(0),               // 10: memos.api.v1.Visibility
+	(*fieldmaskpb.FieldMask)(nil), // 11: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),         // 12: google.protobuf.Empty
+}
+var file_api_v1_memo_rule_service_proto_depIdxs = []int32{
+	1,  // 0: memos.api.v1.MemoRule.condition:type_name -> memos.api.v1.MemoRuleCondition
+	2,  // 1: memos.api.v1.MemoRule.actions:type_name -> memos.api.v1.MemoRuleAction
+	10, // 2: memos.api.v1.MemoRuleAction.set_visibility:type_name -> memos.api.v1.Visibility
+	0,  // 3: memos.api.v1.ListMemoRulesResponse.rules:type_name -> memos.api.v1.MemoRule
+	0,  // 4: memos.api.v1.CreateMemoRuleRequest.rule:type_name -> memos.api.v1.MemoRule
+	0,  // 5: memos.api.v1.UpdateMemoRuleRequest.rule:type_name -> memos.api.v1.MemoRule
+	11, // 6: memos.api.v1.UpdateMemoRuleRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 7: memos.api.v1.TestMemoRulesRequest.rules:type_name -> memos.api.v1.MemoRule
+	0,  // 8: memos.api.v1.TestMemoRulesResponse.matched_rules:type_name -> memos.api.v1.MemoRule
+	10, // 9: memos.api.v1.TestMemoRulesResponse.visibility:type_name -> memos.api.v1.Visibility
+	3,  // 10: memos.api.v1.MemoRuleService.ListMemoRules:input_type -> memos.api.v1.ListMemoRulesRequest
+	5,  // 11: memos.api.v1.MemoRuleService.CreateMemoRule:input_type -> memos.api.v1.CreateMemoRuleRequest
+	6,  // 12: memos.api.v1.MemoRuleService.UpdateMemoRule:input_type -> memos.api.v1.UpdateMemoRuleRequest
+	7,  // 13: memos.api.v1.MemoRuleService.DeleteMemoRule:input_type -> memos.api.v1.DeleteMemoRuleRequest
+	8,  // 14: memos.api.v1.MemoRuleService.TestMemoRules:input_type -> memos.api.v1.TestMemoRulesRequest
+	4,  // 15: memos.api.v1.MemoRuleService.ListMemoRules:output_type -> memos.api.v1.ListMemoRulesResponse
+	0,  // 16: memos.api.v1.MemoRuleService.CreateMemoRule:output_type -> memos.api.v1.MemoRule
+	0,  // 17: memos.api.v1.MemoRuleService.UpdateMemoRule:output_type -> memos.api.v1.MemoRule
+	12, // 18: memos.api.v1.MemoRuleService.DeleteMemoRule:output_type -> google.protobuf.Empty
+	9,  // 19: memos.api.v1.MemoRuleService.TestMemoRules:output_type -> memos.api.v1.TestMemoRulesResponse
+	15, // [15:20] is the sub-list for method output_type
+	10, // [10:15] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_memo_rule_service_proto_init() }
+func file_api_v1_memo_rule_service_proto_init() {
+	if File_api_v1_memo_rule_service_proto != nil {
+		return
+	}
+	file_api_v1_memo_service_proto_init()
+	file_api_v1_memo_rule_service_proto_msgTypes[2].OneofWrappers = []any{
+		(*MemoRuleAction_AddTag)(nil),
+		(*MemoRuleAction_SetVisibility)(nil),
+		(*MemoRuleAction_Pin)(nil),
+		(*MemoRuleAction_AddRelation)(nil),
+		(*MemoRuleAction_DispatchWebhook)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_memo_rule_service_proto_rawDesc), len(file_api_v1_memo_rule_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_memo_rule_service_proto_goTypes,
+		DependencyIndexes: file_api_v1_memo_rule_service_proto_depIdxs,
+		MessageInfos:      file_api_v1_memo_rule_service_proto_msgTypes,
+	}.Build()
+	File_api_v1_memo_rule_service_proto = out.File
+	file_api_v1_memo_rule_service_proto_goTypes = nil
+	file_api_v1_memo_rule_service_proto_depIdxs = nil
+}
@@ -0,0 +1,284 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.1
+// - protoc             (unknown)
+// source: api/v1/memo_rule_service.proto
+
+package apiv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	MemoRuleService_ListMemoRules_FullMethodName  = "/memos.api.v1.MemoRuleService/ListMemoRules"
+	MemoRuleService_CreateMemoRule_FullMethodName = "/memos.api.v1.MemoRuleService/CreateMemoRule"
+	MemoRuleService_UpdateMemoRule_FullMethodName = "/memos.api.v1.MemoRuleService/UpdateMemoRule"
+	MemoRuleService_DeleteMemoRule_FullMethodName = "/memos.api.v1.MemoRuleService/DeleteMemoRule"
+	MemoRuleService_TestMemoRules_FullMethodName  = "/memos.api.v1.MemoRuleService/TestMemoRules"
+)
+
+// MemoRuleServiceClient is the client API for MemoRuleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MemoRuleServiceClient interface {
+	// ListMemoRules lists the memo rules of a user in the order they are applied.
+	ListMemoRules(ctx context.Context, in *ListMemoRulesRequest, opts ...grpc.CallOption) (*ListMemoRulesResponse, error)
+	// CreateMemoRule creates a memo rule applied after the other rules of a user.
+	CreateMemoRule(ctx context.Context, in *CreateMemoRuleRequest, opts ...grpc.CallOption) (*MemoRule, error)
+	// UpdateMemoRule updates a memo rule.
+	UpdateMemoRule(ctx context.Context, in *UpdateMemoRuleRequest, opts ...grpc.CallOption) (*MemoRule, error)
+	// DeleteMemoRule deletes a memo rule.
+	DeleteMemoRule(ctx context.Context, in *DeleteMemoRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// TestMemoRules returns what the memo rules of a user would do to a memo, without saving anything.
+	TestMemoRules(ctx context.Context, in *TestMemoRulesRequest, opts ...grpc.CallOption) (*TestMemoRulesResponse, error)
+}
+
+type memoRuleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMemoRuleServiceClient(cc grpc.ClientConnInterface) MemoRuleServiceClient {
+	return &memoRuleServiceClient{cc}
+}
+
+func (c *memoRuleServiceClient) ListMemoRules(ctx context.Context, in *ListMemoRulesRequest, opts ...grpc.CallOption) (*ListMemoRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemoRulesResponse)
+	err := c.cc.Invoke(ctx, MemoRuleService_ListMemoRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoRuleServiceClient) CreateMemoRule(ctx context.Context, in *CreateMemoRuleRequest, opts ...grpc.CallOption) (*MemoRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoRule)
+	err := c.cc.Invoke(ctx, MemoRuleService_CreateMemoRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoRuleServiceClient) UpdateMemoRule(ctx context.Context, in *UpdateMemoRuleRequest, opts ...grpc.CallOption) (*MemoRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoRule)
+	err := c.cc.Invoke(ctx, MemoRuleService_UpdateMemoRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoRuleServiceClient) DeleteMemoRule(ctx context.Context, in *DeleteMemoRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MemoRuleService_DeleteMemoRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoRuleServiceClient) TestMemoRules(ctx context.Context, in *TestMemoRulesRequest, opts ...grpc.CallOption) (*TestMemoRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TestMemoRulesResponse)
+	err := c.cc.Invoke(ctx, MemoRuleService_TestMemoRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MemoRuleServiceServer is the server API for MemoRuleService service.
+// All implementations must embed UnimplementedMemoRuleServiceServer
+// for forward compatibility.
+type MemoRuleServiceServer interface {
+	// ListMemoRules lists the memo rules of a user in the order they are applied.
+	ListMemoRules(context.Context, *ListMemoRulesRequest) (*ListMemoRulesResponse, error)
+	// CreateMemoRule creates a memo rule applied after the other rules of a user.
+	CreateMemoRule(context.Context, *CreateMemoRuleRequest) (*MemoRule, error)
+	// UpdateMemoRule updates a memo rule.
+	UpdateMemoRule(context.Context, *UpdateMemoRuleRequest) (*MemoRule, error)
+	// DeleteMemoRule deletes a memo rule.
+	DeleteMemoRule(context.Context, *DeleteMemoRuleRequest) (*emptypb.Empty, error)
+	// TestMemoRules returns what the memo rules of a user would do to a memo, without saving anything.
+	TestMemoRules(context.Context, *TestMemoRulesRequest) (*TestMemoRulesResponse, error)
+	mustEmbedUnimplementedMemoRuleServiceServer()
+}
+
+// UnimplementedMemoRuleServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMemoRuleServiceServer struct{}
+
+func (UnimplementedMemoRuleServiceServer) ListMemoRules(context.Context, *ListMemoRulesRequest) (*ListMemoRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMemoRules not implemented")
+}
+func (UnimplementedMemoRuleServiceServer) CreateMemoRule(context.Context, *CreateMemoRuleRequest) (*MemoRule, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateMemoRule not implemented")
+}
+func (UnimplementedMemoRuleServiceServer) UpdateMemoRule(context.Context, *UpdateMemoRuleRequest) (*MemoRule, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateMemoRule not implemented")
+}
+func (UnimplementedMemoRuleServiceServer) DeleteMemoRule(context.Context, *DeleteMemoRuleRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteMemoRule not implemented")
+}
+func (UnimplementedMemoRuleServiceServer) TestMemoRules(context.Context, *TestMemoRulesRequest) (*TestMemoRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TestMemoRules not implemented")
+}
+func (UnimplementedMemoRuleServiceServer) mustEmbedUnimplementedMemoRuleServiceServer() {}
+func (UnimplementedMemoRuleServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeMemoRuleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MemoRuleServiceServer will
+// result in compilation errors.
+type UnsafeMemoRuleServiceServer interface {
+	mustEmbedUnimplementedMemoRuleServiceServer()
+}
+
+func RegisterMemoRuleServiceServer(s grpc.ServiceRegistrar, srv MemoRuleServiceServer) {
+	// If the following call panics, it indicates UnimplementedMemoRuleServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MemoRuleService_ServiceDesc, srv)
+}
+
+func _MemoRuleService_ListMemoRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoRuleServiceServer).ListMemoRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoRuleService_ListMemoRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoRuleServiceServer).ListMemoRules(ctx, req.(*ListMemoRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoRuleService_CreateMemoRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMemoRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoRuleServiceServer).CreateMemoRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoRuleService_CreateMemoRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoRuleServiceServer).CreateMemoRule(ctx, req.(*CreateMemoRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoRuleService_UpdateMemoRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMemoRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoRuleServiceServer).UpdateMemoRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoRuleService_UpdateMemoRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoRuleServiceServer).UpdateMemoRule(ctx, req.(*UpdateMemoRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoRuleService_DeleteMemoRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMemoRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoRuleServiceServer).DeleteMemoRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoRuleService_DeleteMemoRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoRuleServiceServer).DeleteMemoRule(ctx, req.(*DeleteMemoRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoRuleService_TestMemoRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestMemoRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoRuleServiceServer).TestMemoRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoRuleService_TestMemoRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoRuleServiceServer).TestMemoRules(ctx, req.(*TestMemoRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MemoRuleService_ServiceDesc is the grpc.ServiceDesc for MemoRuleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MemoRuleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "memos.api.v1.MemoRuleService",
+	HandlerType: (*MemoRuleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListMemoRules",
+			Handler:    _MemoRuleService_ListMemoRules_Handler,
+		},
+		{
+			MethodName: "CreateMemoRule",
+			Handler:    _MemoRuleService_CreateMemoRule_Handler,
+		},
+		{
+			MethodName: "UpdateMemoRule",
+			Handler:    _MemoRuleService_UpdateMemoRule_Handler,
+		},
+		{
+			MethodName: "DeleteMemoRule",
+			Handler:    _MemoRuleService_DeleteMemoRule_Handler,
+		},
+		{
+			MethodName: "TestMemoRules",
+			Handler:    _MemoRuleService_TestMemoRules_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/memo_rule_service.proto",
+}
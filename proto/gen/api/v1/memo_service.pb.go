@@ -128,6 +128,220 @@ func (MemoView) EnumDescriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{1}
 }
 
+type ImportMemosRequest_Source int32
+
+const (
+	ImportMemosRequest_SOURCE_UNSPECIFIED ImportMemosRequest_Source = 0
+	// A zip of Markdown files with optional YAML front matter, such as the archive of ExportUserData.
+	ImportMemosRequest_MARKDOWN ImportMemosRequest_Source = 1
+	// A zip of an Obsidian vault.
+	ImportMemosRequest_OBSIDIAN ImportMemosRequest_Source = 2
+	// A zip of the Keep folder of Google Takeout.
+	ImportMemosRequest_GOOGLE_KEEP ImportMemosRequest_Source = 3
+	// An Evernote ENEX file.
+	ImportMemosRequest_EVERNOTE ImportMemosRequest_Source = 4
+)
+
+// Enum value maps for ImportMemosRequest_Source.
+var (
+	ImportMemosRequest_Source_name = map[int32]string{
+		0: "SOURCE_UNSPECIFIED",
+		1: "MARKDOWN",
+		2: "OBSIDIAN",
+		3: "GOOGLE_KEEP",
+		4: "EVERNOTE",
+	}
+	ImportMemosRequest_Source_value = map[string]int32{
+		"SOURCE_UNSPECIFIED": 0,
+		"MARKDOWN":           1,
+		"OBSIDIAN":           2,
+		"GOOGLE_KEEP":        3,
+		"EVERNOTE":           4,
+	}
+)
+
+func (x ImportMemosRequest_Source) Enum() *ImportMemosRequest_Source {
+	p := new(ImportMemosRequest_Source)
+	*p = x
+	return p
+}
+
+func (x ImportMemosRequest_Source) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportMemosRequest_Source) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_memo_service_proto_enumTypes[2].Descriptor()
+}
+
+func (ImportMemosRequest_Source) Type() protoreflect.EnumType {
+	return &file_api_v1_memo_service_proto_enumTypes[2]
+}
+
+func (x ImportMemosRequest_Source) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportMemosRequest_Source.Descriptor instead.
+func (ImportMemosRequest_Source) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{19, 0}
+}
+
+type MemoGraph_Node_Type int32
+
+const (
+	MemoGraph_Node_TYPE_UNSPECIFIED MemoGraph_Node_Type = 0
+	MemoGraph_Node_MEMO             MemoGraph_Node_Type = 1
+	MemoGraph_Node_TAG              MemoGraph_Node_Type = 2
+)
+
+// Enum value maps for MemoGraph_Node_Type.
+var (
+	MemoGraph_Node_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "MEMO",
+		2: "TAG",
+	}
+	MemoGraph_Node_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"MEMO":             1,
+		"TAG":              2,
+	}
+)
+
+func (x MemoGraph_Node_Type) Enum() *MemoGraph_Node_Type {
+	p := new(MemoGraph_Node_Type)
+	*p = x
+	return p
+}
+
+func (x MemoGraph_Node_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MemoGraph_Node_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_memo_service_proto_enumTypes[3].Descriptor()
+}
+
+func (MemoGraph_Node_Type) Type() protoreflect.EnumType {
+	return &file_api_v1_memo_service_proto_enumTypes[3]
+}
+
+func (x MemoGraph_Node_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MemoGraph_Node_Type.Descriptor instead.
+func (MemoGraph_Node_Type) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{35, 0, 0}
+}
+
+type MemoGraph_Edge_Type int32
+
+const (
+	MemoGraph_Edge_TYPE_UNSPECIFIED MemoGraph_Edge_Type = 0
+	// The source memo references the target memo.
+	MemoGraph_Edge_REFERENCE MemoGraph_Edge_Type = 1
+	// The source memo is a comment of the target memo.
+	MemoGraph_Edge_COMMENT MemoGraph_Edge_Type = 2
+	// The source memo has the target tag. The memos sharing a tag are linked through the node of the tag.
+	MemoGraph_Edge_TAG MemoGraph_Edge_Type = 3
+)
+
+// Enum value maps for MemoGraph_Edge_Type.
+var (
+	MemoGraph_Edge_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "REFERENCE",
+		2: "COMMENT",
+		3: "TAG",
+	}
+	MemoGraph_Edge_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"REFERENCE":        1,
+		"COMMENT":          2,
+		"TAG":              3,
+	}
+)
+
+func (x MemoGraph_Edge_Type) Enum() *MemoGraph_Edge_Type {
+	p := new(MemoGraph_Edge_Type)
+	*p = x
+	return p
+}
+
+func (x MemoGraph_Edge_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MemoGraph_Edge_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_memo_service_proto_enumTypes[4].Descriptor()
+}
+
+func (MemoGraph_Edge_Type) Type() protoreflect.EnumType {
+	return &file_api_v1_memo_service_proto_enumTypes[4]
+}
+
+func (x MemoGraph_Edge_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MemoGraph_Edge_Type.Descriptor instead.
+func (MemoGraph_Edge_Type) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{35, 1, 0}
+}
+
+type MemoCollaborator_Role int32
+
+const (
+	MemoCollaborator_ROLE_UNSPECIFIED MemoCollaborator_Role = 0
+	// The collaborator can view the memo.
+	MemoCollaborator_VIEWER MemoCollaborator_Role = 1
+	// The collaborator can view the memo and edit its content.
+	MemoCollaborator_EDITOR MemoCollaborator_Role = 2
+)
+
+// Enum value maps for MemoCollaborator_Role.
+var (
+	MemoCollaborator_Role_name = map[int32]string{
+		0: "ROLE_UNSPECIFIED",
+		1: "VIEWER",
+		2: "EDITOR",
+	}
+	MemoCollaborator_Role_value = map[string]int32{
+		"ROLE_UNSPECIFIED": 0,
+		"VIEWER":           1,
+		"EDITOR":           2,
+	}
+)
+
+func (x MemoCollaborator_Role) Enum() *MemoCollaborator_Role {
+	p := new(MemoCollaborator_Role)
+	*p = x
+	return p
+}
+
+func (x MemoCollaborator_Role) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MemoCollaborator_Role) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_memo_service_proto_enumTypes[5].Descriptor()
+}
+
+func (MemoCollaborator_Role) Type() protoreflect.EnumType {
+	return &file_api_v1_memo_service_proto_enumTypes[5]
+}
+
+func (x MemoCollaborator_Role) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MemoCollaborator_Role.Descriptor instead.
+func (MemoCollaborator_Role) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{64, 0}
+}
+
 type Memo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The name of the memo.
@@ -135,6 +349,7 @@ type Memo struct {
 	// id is the system generated id.
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// The user defined id of the memo.
+	// When it is updated, the previous uid keeps leading to the memo.
 	Uid       string    `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
 	RowStatus RowStatus `protobuf:"varint,3,opt,name=row_status,json=rowStatus,proto3,enum=memos.api.v1.RowStatus" json:"row_status,omitempty"`
 	// The name of the creator.
@@ -157,7 +372,21 @@ type Memo struct {
 	// The snippet of the memo content. Plain text only.
 	Snippet string `protobuf:"bytes,19,opt,name=snippet,proto3" json:"snippet,omitempty"`
 	// The location of the memo.
-	Location      *Location `protobuf:"bytes,20,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	Location *Location `protobuf:"bytes,20,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	// The time the memo was moved to trash. Only set for trashed memos.
+	TrashTime *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=trash_time,json=trashTime,proto3" json:"trash_time,omitempty"`
+	// The time the memo is scheduled to be published. Only set for scheduled memos.
+	// A scheduled memo stays private until then, and the memo created webhook is dispatched on publishing.
+	PublishTime *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=publish_time,json=publishTime,proto3" json:"publish_time,omitempty"`
+	// The visibility the memo will have once it is published. Only set for scheduled memos.
+	PublishVisibility Visibility `protobuf:"varint,23,opt,name=publish_visibility,json=publishVisibility,proto3,enum=memos.api.v1.Visibility" json:"publish_visibility,omitempty"`
+	// The etag of the memo, which changes on every update of the memo.
+	// It can be sent back with a mutation so that the mutation fails if the memo was updated meanwhile.
+	Etag string `protobuf:"bytes,24,opt,name=etag,proto3" json:"etag,omitempty"`
+	// The time to remind the creator of the memo with an inbox message and the memo reminder webhook.
+	// The creator is also reminded at the start of the due dates of the incomplete tasks, written as @due(YYYY-MM-DD), in UTC.
+	// The due dates are not resolved in the timezone of the creator, so a reminder at a local time is set here instead.
+	RemindTime    *timestamppb.Timestamp `protobuf:"bytes,25,opt,name=remind_time,json=remindTime,proto3" json:"remind_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -318,6 +547,41 @@ func (x *Memo) GetLocation() *Location {
 	return nil
 }
 
+func (x *Memo) GetTrashTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TrashTime
+	}
+	return nil
+}
+
+func (x *Memo) GetPublishTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishTime
+	}
+	return nil
+}
+
+func (x *Memo) GetPublishVisibility() Visibility {
+	if x != nil {
+		return x.PublishVisibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+func (x *Memo) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *Memo) GetRemindTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RemindTime
+	}
+	return nil
+}
+
 type MemoProperty struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	HasLink            bool                   `protobuf:"varint,1,opt,name=has_link,json=hasLink,proto3" json:"has_link,omitempty"`
@@ -463,7 +727,15 @@ type CreateMemoRequest struct {
 	Location   *Location              `protobuf:"bytes,5,opt,name=location,proto3,oneof" json:"location,omitempty"`
 	// Optional. If set, the memo is created with this timestamp as both its
 	// create_time and update_time. Must not be in the future.
-	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Optional. If set to a future time, the memo stays private until then and
+	// is published with the requested visibility.
+	PublishTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=publish_time,json=publishTime,proto3" json:"publish_time,omitempty"`
+	// Optional. If set, the creator is reminded of the memo at this time. Must be in the future.
+	RemindTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=remind_time,json=remindTime,proto3" json:"remind_time,omitempty"`
+	// Optional. The client the memo is sent from, matched by the source of the memo rules of the creator.
+	// e.g. "telegram"
+	Source        string `protobuf:"bytes,9,opt,name=source,proto3" json:"source,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -540,6 +812,27 @@ func (x *CreateMemoRequest) GetCreateTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *CreateMemoRequest) GetPublishTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishTime
+	}
+	return nil
+}
+
+func (x *CreateMemoRequest) GetRemindTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RemindTime
+	}
+	return nil
+}
+
+func (x *CreateMemoRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
 type ListMemosRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The maximum number of memos to return.
@@ -551,7 +844,11 @@ type ListMemosRequest struct {
 	// Format: "creator == 'users/{uid}' && visibilities == ['PUBLIC', 'PROTECTED']"
 	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
 	// The view of the memo.
-	View          MemoView `protobuf:"varint,4,opt,name=view,proto3,enum=memos.api.v1.MemoView" json:"view,omitempty"`
+	View MemoView `protobuf:"varint,4,opt,name=view,proto3,enum=memos.api.v1.MemoView" json:"view,omitempty"`
+	// The name of a shortcut of the current user whose filter is applied.
+	// It is combined with `filter` if both are set.
+	// Format: users/{id}/shortcuts/{shortcut}
+	Shortcut      string `protobuf:"bytes,5,opt,name=shortcut,proto3" json:"shortcut,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -614,14 +911,24 @@ func (x *ListMemosRequest) GetView() MemoView {
 	return MemoView_MEMO_VIEW_UNSPECIFIED
 }
 
+func (x *ListMemosRequest) GetShortcut() string {
+	if x != nil {
+		return x.Shortcut
+	}
+	return ""
+}
+
 type ListMemosResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	Memos []*Memo                `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
 	// A token, which can be sent as `page_token` to retrieve the next page.
 	// If this field is omitted, there are no subsequent pages.
 	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// The highlighted snippets of the memos matched by `content_search`.
+	// Only present when the filter contains a search term.
+	SearchSnippets []*MemoSearchSnippet `protobuf:"bytes,3,rep,name=search_snippets,json=searchSnippets,proto3" json:"search_snippets,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ListMemosResponse) Reset() {
@@ -668,29 +975,43 @@ func (x *ListMemosResponse) GetNextPageToken() string {
 	return ""
 }
 
-type GetMemoRequest struct {
+func (x *ListMemosResponse) GetSearchSnippets() []*MemoSearchSnippet {
+	if x != nil {
+		return x.SearchSnippets
+	}
+	return nil
+}
+
+type ListMemoLocationsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The name of the memo.
-	// Format: memos/{id}
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Filter is used to filter the memos whose locations are listed, as in `ListMemos`.
+	Filter string `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// The name of a shortcut of the current user whose filter is applied.
+	// It is combined with `filter` if both are set.
+	// Format: users/{id}/shortcuts/{shortcut}
+	Shortcut string `protobuf:"bytes,2,opt,name=shortcut,proto3" json:"shortcut,omitempty"`
+	// The zoom level of the map, from 0 to 22.
+	// The locations are clustered on a grid whose cells are 90/2^zoom degrees wide,
+	// a quarter of a map tile at that zoom level.
+	Zoom          int32 `protobuf:"varint,3,opt,name=zoom,proto3" json:"zoom,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMemoRequest) Reset() {
-	*x = GetMemoRequest{}
+func (x *ListMemoLocationsRequest) Reset() {
+	*x = ListMemoLocationsRequest{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMemoRequest) String() string {
+func (x *ListMemoLocationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMemoRequest) ProtoMessage() {}
+func (*ListMemoLocationsRequest) ProtoMessage() {}
 
-func (x *GetMemoRequest) ProtoReflect() protoreflect.Message {
+func (x *ListMemoLocationsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -702,40 +1023,54 @@ func (x *GetMemoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMemoRequest.ProtoReflect.Descriptor instead.
-func (*GetMemoRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListMemoLocationsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoLocationsRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetMemoRequest) GetName() string {
+func (x *ListMemoLocationsRequest) GetFilter() string {
 	if x != nil {
-		return x.Name
+		return x.Filter
 	}
 	return ""
 }
 
-type GetMemoByUidRequest struct {
+func (x *ListMemoLocationsRequest) GetShortcut() string {
+	if x != nil {
+		return x.Shortcut
+	}
+	return ""
+}
+
+func (x *ListMemoLocationsRequest) GetZoom() int32 {
+	if x != nil {
+		return x.Zoom
+	}
+	return 0
+}
+
+type ListMemoLocationsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The uid of the memo.
-	Uid           string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// The clusters, ordered by the number of memos in them.
+	Clusters      []*MemoLocationCluster `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMemoByUidRequest) Reset() {
-	*x = GetMemoByUidRequest{}
+func (x *ListMemoLocationsResponse) Reset() {
+	*x = ListMemoLocationsResponse{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMemoByUidRequest) String() string {
+func (x *ListMemoLocationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMemoByUidRequest) ProtoMessage() {}
+func (*ListMemoLocationsResponse) ProtoMessage() {}
 
-func (x *GetMemoByUidRequest) ProtoReflect() protoreflect.Message {
+func (x *ListMemoLocationsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -747,42 +1082,47 @@ func (x *GetMemoByUidRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMemoByUidRequest.ProtoReflect.Descriptor instead.
-func (*GetMemoByUidRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListMemoLocationsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoLocationsResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetMemoByUidRequest) GetUid() string {
+func (x *ListMemoLocationsResponse) GetClusters() []*MemoLocationCluster {
 	if x != nil {
-		return x.Uid
+		return x.Clusters
 	}
-	return ""
+	return nil
 }
 
-type UpdateMemoRequest struct {
-	state      protoimpl.MessageState `protogen:"open.v1"`
-	Memo       *Memo                  `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
-	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
-	// When true, the memo's update_time will not be changed.
-	PreserveUpdateTime bool `protobuf:"varint,3,opt,name=preserve_update_time,json=preserveUpdateTime,proto3" json:"preserve_update_time,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type MemoLocationCluster struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The latitude of the center of the locations in the cluster.
+	Latitude float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// The longitude of the center of the locations in the cluster.
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// The number of memos in the cluster.
+	Count int32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// The name of the latest memo in the cluster.
+	// Format: memos/{id}
+	Memo          string `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateMemoRequest) Reset() {
-	*x = UpdateMemoRequest{}
+func (x *MemoLocationCluster) Reset() {
+	*x = MemoLocationCluster{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateMemoRequest) String() string {
+func (x *MemoLocationCluster) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMemoRequest) ProtoMessage() {}
+func (*MemoLocationCluster) ProtoMessage() {}
 
-func (x *UpdateMemoRequest) ProtoReflect() protoreflect.Message {
+func (x *MemoLocationCluster) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -794,55 +1134,64 @@ func (x *UpdateMemoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMemoRequest.ProtoReflect.Descriptor instead.
-func (*UpdateMemoRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MemoLocationCluster.ProtoReflect.Descriptor instead.
+func (*MemoLocationCluster) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *UpdateMemoRequest) GetMemo() *Memo {
+func (x *MemoLocationCluster) GetLatitude() float64 {
 	if x != nil {
-		return x.Memo
+		return x.Latitude
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateMemoRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *MemoLocationCluster) GetLongitude() float64 {
 	if x != nil {
-		return x.UpdateMask
+		return x.Longitude
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateMemoRequest) GetPreserveUpdateTime() bool {
+func (x *MemoLocationCluster) GetCount() int32 {
 	if x != nil {
-		return x.PreserveUpdateTime
+		return x.Count
 	}
-	return false
+	return 0
 }
 
-type DeleteMemoRequest struct {
+func (x *MemoLocationCluster) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+type MemoSearchSnippet struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The name of the memo.
 	// Format: memos/{id}
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The matched part of the content, HTML-escaped, with matched terms wrapped in <mark> and </mark>.
+	Snippet       string `protobuf:"bytes,2,opt,name=snippet,proto3" json:"snippet,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteMemoRequest) Reset() {
-	*x = DeleteMemoRequest{}
+func (x *MemoSearchSnippet) Reset() {
+	*x = MemoSearchSnippet{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMemoRequest) String() string {
+func (x *MemoSearchSnippet) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMemoRequest) ProtoMessage() {}
+func (*MemoSearchSnippet) ProtoMessage() {}
 
-func (x *DeleteMemoRequest) ProtoReflect() protoreflect.Message {
+func (x *MemoSearchSnippet) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -854,44 +1203,2592 @@ func (x *DeleteMemoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMemoRequest.ProtoReflect.Descriptor instead.
-func (*DeleteMemoRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MemoSearchSnippet.ProtoReflect.Descriptor instead.
+func (*MemoSearchSnippet) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *DeleteMemoRequest) GetName() string {
+func (x *MemoSearchSnippet) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type RenameMemoTagRequest struct {
+func (x *MemoSearchSnippet) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+type GetMemoRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The parent, who owns the tags.
-	// Format: memos/{id}. Use "memos/-" to rename all tags.
-	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
-	OldTag        string `protobuf:"bytes,2,opt,name=old_tag,json=oldTag,proto3" json:"old_tag,omitempty"`
-	NewTag        string `protobuf:"bytes,3,opt,name=new_tag,json=newTag,proto3" json:"new_tag,omitempty"`
+	// The name of the memo.
+	// Format: memos/{id}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RenameMemoTagRequest) Reset() {
-	*x = RenameMemoTagRequest{}
+func (x *GetMemoRequest) Reset() {
+	*x = GetMemoRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoRequest) ProtoMessage() {}
+
+func (x *GetMemoRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoRequest.ProtoReflect.Descriptor instead.
+func (*GetMemoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetMemoRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetMemoByUidRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The uid of the memo, or one of its previous uids.
+	// The returned memo has its current uid, which links should use.
+	Uid           string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemoByUidRequest) Reset() {
+	*x = GetMemoByUidRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemoByUidRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoByUidRequest) ProtoMessage() {}
+
+func (x *GetMemoByUidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoByUidRequest.ProtoReflect.Descriptor instead.
+func (*GetMemoByUidRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetMemoByUidRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+type UpdateMemoRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Memo       *Memo                  `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// When true, the memo's update_time will not be changed.
+	PreserveUpdateTime bool `protobuf:"varint,3,opt,name=preserve_update_time,json=preserveUpdateTime,proto3" json:"preserve_update_time,omitempty"`
+	// Optional. The etag of the memo the mutation is based on.
+	// If the memo was updated since, the request fails with FAILED_PRECONDITION and the current memo in its details.
+	Etag string `protobuf:"bytes,4,opt,name=etag,proto3" json:"etag,omitempty"`
+	// Optional. The client the memo is sent from, matched by the source of the memo rules of the creator.
+	Source        string `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMemoRequest) Reset() {
+	*x = UpdateMemoRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMemoRequest) ProtoMessage() {}
+
+func (x *UpdateMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMemoRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMemoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateMemoRequest) GetMemo() *Memo {
+	if x != nil {
+		return x.Memo
+	}
+	return nil
+}
+
+func (x *UpdateMemoRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateMemoRequest) GetPreserveUpdateTime() bool {
+	if x != nil {
+		return x.PreserveUpdateTime
+	}
+	return false
+}
+
+func (x *UpdateMemoRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *UpdateMemoRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type DeleteMemoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo.
+	// Format: memos/{id}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// If true, the memo is deleted permanently instead of being moved to the trash.
+	// A memo that is already in the trash is always deleted permanently.
+	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	// Optional. The etag of the memo the mutation is based on.
+	// If the memo was updated since, the request fails with FAILED_PRECONDITION and the current memo in its details.
+	Etag          string `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMemoRequest) Reset() {
+	*x = DeleteMemoRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMemoRequest) ProtoMessage() {}
+
+func (x *DeleteMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMemoRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMemoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteMemoRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeleteMemoRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *DeleteMemoRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type BatchUpdateMemosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The names of the memos to update.
+	// Format: memos/{id}
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	// The filter of the memos to update, in the same format as the filter of ListMemosRequest.
+	// Exactly one of names and filter must be set.
+	Filter string `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// The values of the fields in update_mask applied to every memo.
+	// Supported fields: visibility, row_status, pinned.
+	Memo       *Memo                  `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo,omitempty"`
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// The tags appended to the content of every memo that does not have them yet.
+	AddTags []string `protobuf:"bytes,5,rep,name=add_tags,json=addTags,proto3" json:"add_tags,omitempty"`
+	// The tags removed from the content of every memo.
+	RemoveTags []string `protobuf:"bytes,6,rep,name=remove_tags,json=removeTags,proto3" json:"remove_tags,omitempty"`
+	// Optional. The etags of the memos the mutation is based on, in the order of names.
+	// A memo updated since its etag is left unchanged, and its result has the error.
+	// An empty etag skips the check of its memo.
+	Etags         []string `protobuf:"bytes,7,rep,name=etags,proto3" json:"etags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateMemosRequest) Reset() {
+	*x = BatchUpdateMemosRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateMemosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateMemosRequest) ProtoMessage() {}
+
+func (x *BatchUpdateMemosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateMemosRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateMemosRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BatchUpdateMemosRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *BatchUpdateMemosRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *BatchUpdateMemosRequest) GetMemo() *Memo {
+	if x != nil {
+		return x.Memo
+	}
+	return nil
+}
+
+func (x *BatchUpdateMemosRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *BatchUpdateMemosRequest) GetAddTags() []string {
+	if x != nil {
+		return x.AddTags
+	}
+	return nil
+}
+
+func (x *BatchUpdateMemosRequest) GetRemoveTags() []string {
+	if x != nil {
+		return x.RemoveTags
+	}
+	return nil
+}
+
+func (x *BatchUpdateMemosRequest) GetEtags() []string {
+	if x != nil {
+		return x.Etags
+	}
+	return nil
+}
+
+type BatchUpdateMemosResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BatchMemoResult     `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateMemosResponse) Reset() {
+	*x = BatchUpdateMemosResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateMemosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateMemosResponse) ProtoMessage() {}
+
+func (x *BatchUpdateMemosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateMemosResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdateMemosResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BatchUpdateMemosResponse) GetResults() []*BatchMemoResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BatchDeleteMemosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The names of the memos to delete.
+	// Format: memos/{id}
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	// The filter of the memos to delete, in the same format as the filter of ListMemosRequest.
+	// Exactly one of names and filter must be set.
+	Filter string `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// If true, the memos are deleted permanently instead of being moved to the trash.
+	Force bool `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+	// Optional. The etags of the memos the mutation is based on, in the order of names.
+	// A memo updated since its etag is left unchanged, and its result has the error.
+	// An empty etag skips the check of its memo.
+	Etags         []string `protobuf:"bytes,4,rep,name=etags,proto3" json:"etags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteMemosRequest) Reset() {
+	*x = BatchDeleteMemosRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteMemosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteMemosRequest) ProtoMessage() {}
+
+func (x *BatchDeleteMemosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteMemosRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteMemosRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BatchDeleteMemosRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *BatchDeleteMemosRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *BatchDeleteMemosRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *BatchDeleteMemosRequest) GetEtags() []string {
+	if x != nil {
+		return x.Etags
+	}
+	return nil
+}
+
+type BatchDeleteMemosResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BatchMemoResult     `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteMemosResponse) Reset() {
+	*x = BatchDeleteMemosResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteMemosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteMemosResponse) ProtoMessage() {}
+
+func (x *BatchDeleteMemosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteMemosResponse.ProtoReflect.Descriptor instead.
+func (*BatchDeleteMemosResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BatchDeleteMemosResponse) GetResults() []*BatchMemoResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// BatchMemoResult is the result of a batch operation on one memo.
+type BatchMemoResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo.
+	// Format: memos/{id}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The reason the memo was not changed, empty if the operation succeeded.
+	Error         string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchMemoResult) Reset() {
+	*x = BatchMemoResult{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchMemoResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchMemoResult) ProtoMessage() {}
+
+func (x *BatchMemoResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchMemoResult.ProtoReflect.Descriptor instead.
+func (*BatchMemoResult) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BatchMemoResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BatchMemoResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ImportMemosRequest struct {
+	state  protoimpl.MessageState    `protogen:"open.v1"`
+	Source ImportMemosRequest_Source `protobuf:"varint,1,opt,name=source,proto3,enum=memos.api.v1.ImportMemosRequest_Source" json:"source,omitempty"`
+	// The content of the imported file.
+	Content []byte `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// If true, nothing is created and the response only reports what would be imported.
+	DryRun        bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportMemosRequest) Reset() {
+	*x = ImportMemosRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportMemosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportMemosRequest) ProtoMessage() {}
+
+func (x *ImportMemosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportMemosRequest.ProtoReflect.Descriptor instead.
+func (*ImportMemosRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ImportMemosRequest) GetSource() ImportMemosRequest_Source {
+	if x != nil {
+		return x.Source
+	}
+	return ImportMemosRequest_SOURCE_UNSPECIFIED
+}
+
+func (x *ImportMemosRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ImportMemosRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportMemosResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*ImportMemosResult   `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportMemosResponse) Reset() {
+	*x = ImportMemosResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportMemosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportMemosResponse) ProtoMessage() {}
+
+func (x *ImportMemosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportMemosResponse.ProtoReflect.Descriptor instead.
+func (*ImportMemosResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ImportMemosResponse) GetResults() []*ImportMemosResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// ImportMemosResult is the result of importing one note.
+type ImportMemosResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The path or the title of the note in the imported file.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// The name of the created memo, empty if the memo was not created.
+	// Format: memos/{id}
+	Memo       string                 `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The number of the resources attached to the memo.
+	ResourceCount int32 `protobuf:"varint,4,opt,name=resource_count,json=resourceCount,proto3" json:"resource_count,omitempty"`
+	// The number of the relations from the memo to other memos.
+	RelationCount int32 `protobuf:"varint,5,opt,name=relation_count,json=relationCount,proto3" json:"relation_count,omitempty"`
+	// The problems met while importing the note, such as unresolved links.
+	Warnings []string `protobuf:"bytes,6,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	// The reason the note was not imported, empty if it was.
+	Error         string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportMemosResult) Reset() {
+	*x = ImportMemosResult{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportMemosResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportMemosResult) ProtoMessage() {}
+
+func (x *ImportMemosResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportMemosResult.ProtoReflect.Descriptor instead.
+func (*ImportMemosResult) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ImportMemosResult) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ImportMemosResult) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *ImportMemosResult) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *ImportMemosResult) GetResourceCount() int32 {
+	if x != nil {
+		return x.ResourceCount
+	}
+	return 0
+}
+
+func (x *ImportMemosResult) GetRelationCount() int32 {
+	if x != nil {
+		return x.RelationCount
+	}
+	return 0
+}
+
+func (x *ImportMemosResult) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *ImportMemosResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ListTrashedMemosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The maximum number of memos to return.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// A page token, received from a previous `ListTrashedMemos` call.
+	// Provide this to retrieve the subsequent page.
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashedMemosRequest) Reset() {
+	*x = ListTrashedMemosRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashedMemosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashedMemosRequest) ProtoMessage() {}
+
+func (x *ListTrashedMemosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashedMemosRequest.ProtoReflect.Descriptor instead.
+func (*ListTrashedMemosRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListTrashedMemosRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTrashedMemosRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListTrashedMemosResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Memos []*Memo                `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
+	// A token, which can be sent as `page_token` to retrieve the next page.
+	// If this field is omitted, there are no subsequent pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashedMemosResponse) Reset() {
+	*x = ListTrashedMemosResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashedMemosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashedMemosResponse) ProtoMessage() {}
+
+func (x *ListTrashedMemosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashedMemosResponse.ProtoReflect.Descriptor instead.
+func (*ListTrashedMemosResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListTrashedMemosResponse) GetMemos() []*Memo {
+	if x != nil {
+		return x.Memos
+	}
+	return nil
+}
+
+func (x *ListTrashedMemosResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RestoreMemoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo.
+	// Format: memos/{id}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. The etag of the memo the mutation is based on.
+	// If the memo was updated since, the request fails with FAILED_PRECONDITION and the current memo in its details.
+	Etag          string `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreMemoRequest) Reset() {
+	*x = RestoreMemoRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreMemoRequest) ProtoMessage() {}
+
+func (x *RestoreMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreMemoRequest.ProtoReflect.Descriptor instead.
+func (*RestoreMemoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RestoreMemoRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RestoreMemoRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type EmptyTrashRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmptyTrashRequest) Reset() {
+	*x = EmptyTrashRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmptyTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmptyTrashRequest) ProtoMessage() {}
+
+func (x *EmptyTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmptyTrashRequest.ProtoReflect.Descriptor instead.
+func (*EmptyTrashRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{25}
+}
+
+type RenameMemoTagRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The parent, who owns the tags.
+	// Format: memos/{id}. Use "memos/-" to rename all tags.
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	OldTag        string `protobuf:"bytes,2,opt,name=old_tag,json=oldTag,proto3" json:"old_tag,omitempty"`
+	NewTag        string `protobuf:"bytes,3,opt,name=new_tag,json=newTag,proto3" json:"new_tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameMemoTagRequest) Reset() {
+	*x = RenameMemoTagRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameMemoTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameMemoTagRequest) ProtoMessage() {}
+
+func (x *RenameMemoTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameMemoTagRequest.ProtoReflect.Descriptor instead.
+func (*RenameMemoTagRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RenameMemoTagRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *RenameMemoTagRequest) GetOldTag() string {
+	if x != nil {
+		return x.OldTag
+	}
+	return ""
+}
+
+func (x *RenameMemoTagRequest) GetNewTag() string {
+	if x != nil {
+		return x.NewTag
+	}
+	return ""
+}
+
+type DeleteMemoTagRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The parent, who owns the tags.
+	// Format: memos/{id}. Use "memos/-" to delete all tags.
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	Tag    string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Whether the memos with the tag are moved to the trash instead of being archived.
+	DeleteRelatedMemos bool `protobuf:"varint,3,opt,name=delete_related_memos,json=deleteRelatedMemos,proto3" json:"delete_related_memos,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DeleteMemoTagRequest) Reset() {
+	*x = DeleteMemoTagRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMemoTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMemoTagRequest) ProtoMessage() {}
+
+func (x *DeleteMemoTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMemoTagRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMemoTagRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteMemoTagRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *DeleteMemoTagRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *DeleteMemoTagRequest) GetDeleteRelatedMemos() bool {
+	if x != nil {
+		return x.DeleteRelatedMemos
+	}
+	return false
+}
+
+type SetMemoResourcesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo.
+	// Format: memos/{id}
+	Name      string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Resources []*Resource `protobuf:"bytes,2,rep,name=resources,proto3" json:"resources,omitempty"`
+	// Optional. The etag of the memo the mutation is based on.
+	// If the memo was updated since, the request fails with FAILED_PRECONDITION and the current memo in its details.
+	Etag          string `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMemoResourcesRequest) Reset() {
+	*x = SetMemoResourcesRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMemoResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMemoResourcesRequest) ProtoMessage() {}
+
+func (x *SetMemoResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMemoResourcesRequest.ProtoReflect.Descriptor instead.
+func (*SetMemoResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SetMemoResourcesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetMemoResourcesRequest) GetResources() []*Resource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *SetMemoResourcesRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type ListMemoResourcesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo.
+	// Format: memos/{id}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoResourcesRequest) Reset() {
+	*x = ListMemoResourcesRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoResourcesRequest) ProtoMessage() {}
+
+func (x *ListMemoResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoResourcesRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListMemoResourcesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListMemoResourcesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resources     []*Resource            `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoResourcesResponse) Reset() {
+	*x = ListMemoResourcesResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoResourcesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoResourcesResponse) ProtoMessage() {}
+
+func (x *ListMemoResourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoResourcesResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoResourcesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListMemoResourcesResponse) GetResources() []*Resource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+type SetMemoRelationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo.
+	// Format: memos/{id}
+	Name      string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Relations []*MemoRelation `protobuf:"bytes,2,rep,name=relations,proto3" json:"relations,omitempty"`
+	// Optional. The etag of the memo the mutation is based on.
+	// If the memo was updated since, the request fails with FAILED_PRECONDITION and the current memo in its details.
+	Etag          string `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMemoRelationsRequest) Reset() {
+	*x = SetMemoRelationsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMemoRelationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMemoRelationsRequest) ProtoMessage() {}
+
+func (x *SetMemoRelationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMemoRelationsRequest.ProtoReflect.Descriptor instead.
+func (*SetMemoRelationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SetMemoRelationsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetMemoRelationsRequest) GetRelations() []*MemoRelation {
+	if x != nil {
+		return x.Relations
+	}
+	return nil
+}
+
+func (x *SetMemoRelationsRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type ListMemoRelationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo.
+	// Format: memos/{id}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoRelationsRequest) Reset() {
+	*x = ListMemoRelationsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoRelationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoRelationsRequest) ProtoMessage() {}
+
+func (x *ListMemoRelationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoRelationsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoRelationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListMemoRelationsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListMemoRelationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relations     []*MemoRelation        `protobuf:"bytes,1,rep,name=relations,proto3" json:"relations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoRelationsResponse) Reset() {
+	*x = ListMemoRelationsResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoRelationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoRelationsResponse) ProtoMessage() {}
+
+func (x *ListMemoRelationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoRelationsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoRelationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListMemoRelationsResponse) GetRelations() []*MemoRelation {
+	if x != nil {
+		return x.Relations
+	}
+	return nil
+}
+
+type GetMemoGraphRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo to traverse the graph from, or empty for the whole graph.
+	// Format: memos/{id}
+	Root string `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	// The maximum number of edges between the root and the returned nodes.
+	// Defaults to 1, and is capped at 5. Ignored without a root.
+	Depth         int32 `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemoGraphRequest) Reset() {
+	*x = GetMemoGraphRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemoGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoGraphRequest) ProtoMessage() {}
+
+func (x *GetMemoGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoGraphRequest.ProtoReflect.Descriptor instead.
+func (*GetMemoGraphRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetMemoGraphRequest) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+func (x *GetMemoGraphRequest) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+type MemoGraph struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nodes         []*MemoGraph_Node      `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Edges         []*MemoGraph_Edge      `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoGraph) Reset() {
+	*x = MemoGraph{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoGraph) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoGraph) ProtoMessage() {}
+
+func (x *MemoGraph) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoGraph.ProtoReflect.Descriptor instead.
+func (*MemoGraph) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *MemoGraph) GetNodes() []*MemoGraph_Node {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *MemoGraph) GetEdges() []*MemoGraph_Edge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+type ListMemoBacklinksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo.
+	// Format: memos/{id}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoBacklinksRequest) Reset() {
+	*x = ListMemoBacklinksRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoBacklinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoBacklinksRequest) ProtoMessage() {}
+
+func (x *ListMemoBacklinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoBacklinksRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoBacklinksRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListMemoBacklinksRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListMemoBacklinksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Backlinks     []*MemoBacklink        `protobuf:"bytes,1,rep,name=backlinks,proto3" json:"backlinks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoBacklinksResponse) Reset() {
+	*x = ListMemoBacklinksResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoBacklinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoBacklinksResponse) ProtoMessage() {}
+
+func (x *ListMemoBacklinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoBacklinksResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoBacklinksResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListMemoBacklinksResponse) GetBacklinks() []*MemoBacklink {
+	if x != nil {
+		return x.Backlinks
+	}
+	return nil
+}
+
+// MemoBacklink is a memo referencing another memo.
+type MemoBacklink struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The referencing memo.
+	Memo *MemoRelation_Memo `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// The sentence around the reference in the content of the referencing memo.
+	// Empty if the relation was set without a reference in the content.
+	Sentence      string `protobuf:"bytes,2,opt,name=sentence,proto3" json:"sentence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoBacklink) Reset() {
+	*x = MemoBacklink{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoBacklink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoBacklink) ProtoMessage() {}
+
+func (x *MemoBacklink) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoBacklink.ProtoReflect.Descriptor instead.
+func (*MemoBacklink) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *MemoBacklink) GetMemo() *MemoRelation_Memo {
+	if x != nil {
+		return x.Memo
+	}
+	return nil
+}
+
+func (x *MemoBacklink) GetSentence() string {
+	if x != nil {
+		return x.Sentence
+	}
+	return ""
+}
+
+type ListRelatedMemosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo.
+	// Format: memos/{id}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The maximum number of related memos to return. Defaults to 5, at most 20.
+	PageSize      int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRelatedMemosRequest) Reset() {
+	*x = ListRelatedMemosRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRelatedMemosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRelatedMemosRequest) ProtoMessage() {}
+
+func (x *ListRelatedMemosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRelatedMemosRequest.ProtoReflect.Descriptor instead.
+func (*ListRelatedMemosRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListRelatedMemosRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListRelatedMemosRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListRelatedMemosResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The related memos, from the most similar.
+	RelatedMemos  []*RelatedMemo `protobuf:"bytes,1,rep,name=related_memos,json=relatedMemos,proto3" json:"related_memos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRelatedMemosResponse) Reset() {
+	*x = ListRelatedMemosResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRelatedMemosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRelatedMemosResponse) ProtoMessage() {}
+
+func (x *ListRelatedMemosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRelatedMemosResponse.ProtoReflect.Descriptor instead.
+func (*ListRelatedMemosResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListRelatedMemosResponse) GetRelatedMemos() []*RelatedMemo {
+	if x != nil {
+		return x.RelatedMemos
+	}
+	return nil
+}
+
+// RelatedMemo is a memo similar to another memo.
+type RelatedMemo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The similar memo.
+	Memo *MemoRelation_Memo `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// The BM25 score of the memo with the terms of the other memo as the query.
+	Score         float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RelatedMemo) Reset() {
+	*x = RelatedMemo{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelatedMemo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelatedMemo) ProtoMessage() {}
+
+func (x *RelatedMemo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelatedMemo.ProtoReflect.Descriptor instead.
+func (*RelatedMemo) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RelatedMemo) GetMemo() *MemoRelation_Memo {
+	if x != nil {
+		return x.Memo
+	}
+	return nil
+}
+
+func (x *RelatedMemo) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type ListDuplicateMemosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The maximum number of bits in which the fingerprints of the content of duplicates differ.
+	// Defaults to 3, at most 10.
+	MaxDistance   int32 `protobuf:"varint,1,opt,name=max_distance,json=maxDistance,proto3" json:"max_distance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDuplicateMemosRequest) Reset() {
+	*x = ListDuplicateMemosRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDuplicateMemosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDuplicateMemosRequest) ProtoMessage() {}
+
+func (x *ListDuplicateMemosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDuplicateMemosRequest.ProtoReflect.Descriptor instead.
+func (*ListDuplicateMemosRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListDuplicateMemosRequest) GetMaxDistance() int32 {
+	if x != nil {
+		return x.MaxDistance
+	}
+	return 0
+}
+
+type ListDuplicateMemosResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*DuplicateMemoGroup  `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDuplicateMemosResponse) Reset() {
+	*x = ListDuplicateMemosResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDuplicateMemosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDuplicateMemosResponse) ProtoMessage() {}
+
+func (x *ListDuplicateMemosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDuplicateMemosResponse.ProtoReflect.Descriptor instead.
+func (*ListDuplicateMemosResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListDuplicateMemosResponse) GetGroups() []*DuplicateMemoGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// DuplicateMemoGroup is a group of memos that are likely duplicates of each other.
+type DuplicateMemoGroup struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The memos of the group, from the latest.
+	Memos         []*MemoRelation_Memo `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateMemoGroup) Reset() {
+	*x = DuplicateMemoGroup{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateMemoGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateMemoGroup) ProtoMessage() {}
+
+func (x *DuplicateMemoGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateMemoGroup.ProtoReflect.Descriptor instead.
+func (*DuplicateMemoGroup) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DuplicateMemoGroup) GetMemos() []*MemoRelation_Memo {
+	if x != nil {
+		return x.Memos
+	}
+	return nil
+}
+
+type MergeMemosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo the other memos are merged into.
+	// Format: memos/{id}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The names of the memos merged into the memo, which are archived.
+	// Their content is appended to the content of the memo in order, and their resources,
+	// relations and reactions are moved to the memo.
+	// Format: memos/{id}
+	Memos         []string `protobuf:"bytes,2,rep,name=memos,proto3" json:"memos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeMemosRequest) Reset() {
+	*x = MergeMemosRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeMemosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeMemosRequest) ProtoMessage() {}
+
+func (x *MergeMemosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeMemosRequest.ProtoReflect.Descriptor instead.
+func (*MergeMemosRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *MergeMemosRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MergeMemosRequest) GetMemos() []string {
+	if x != nil {
+		return x.Memos
+	}
+	return nil
+}
+
+type CreateMemoCommentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo.
+	// Format: memos/{id}
+	Name          string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Comment       *CreateMemoRequest `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMemoCommentRequest) Reset() {
+	*x = CreateMemoCommentRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMemoCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMemoCommentRequest) ProtoMessage() {}
+
+func (x *CreateMemoCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMemoCommentRequest.ProtoReflect.Descriptor instead.
+func (*CreateMemoCommentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CreateMemoCommentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateMemoCommentRequest) GetComment() *CreateMemoRequest {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+type ListMemoCommentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo.
+	// Format: memos/{id}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The maximum number of comments to return.
+	// If neither page_size nor page_token is set, all comments are returned.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// A page token, received from a previous `ListMemoComments` call.
+	// Provide this to retrieve the subsequent page.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoCommentsRequest) Reset() {
+	*x = ListMemoCommentsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoCommentsRequest) ProtoMessage() {}
+
+func (x *ListMemoCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListMemoCommentsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListMemoCommentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMemoCommentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListMemoCommentsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Memos []*Memo                `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
+	// A token, which can be sent as `page_token` to retrieve the next page.
+	// If this field is omitted, there are no subsequent pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoCommentsResponse) Reset() {
+	*x = ListMemoCommentsResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoCommentsResponse) ProtoMessage() {}
+
+func (x *ListMemoCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListMemoCommentsResponse) GetMemos() []*Memo {
+	if x != nil {
+		return x.Memos
+	}
+	return nil
+}
+
+func (x *ListMemoCommentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListMemoReactionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo.
+	// Format: memos/{id}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoReactionsRequest) Reset() {
+	*x = ListMemoReactionsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoReactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoReactionsRequest) ProtoMessage() {}
+
+func (x *ListMemoReactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoReactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoReactionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListMemoReactionsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListMemoReactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reactions     []*Reaction            `protobuf:"bytes,1,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoReactionsResponse) Reset() {
+	*x = ListMemoReactionsResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoReactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoReactionsResponse) ProtoMessage() {}
+
+func (x *ListMemoReactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoReactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoReactionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListMemoReactionsResponse) GetReactions() []*Reaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+type UpsertMemoReactionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo.
+	// Format: memos/{id}
+	Name          string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Reaction      *Reaction `protobuf:"bytes,2,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertMemoReactionRequest) Reset() {
+	*x = UpsertMemoReactionRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertMemoReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertMemoReactionRequest) ProtoMessage() {}
+
+func (x *UpsertMemoReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertMemoReactionRequest.ProtoReflect.Descriptor instead.
+func (*UpsertMemoReactionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UpsertMemoReactionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpsertMemoReactionRequest) GetReaction() *Reaction {
+	if x != nil {
+		return x.Reaction
+	}
+	return nil
+}
+
+type DeleteMemoReactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReactionId    int32                  `protobuf:"varint,1,opt,name=reaction_id,json=reactionId,proto3" json:"reaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMemoReactionRequest) Reset() {
+	*x = DeleteMemoReactionRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMemoReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMemoReactionRequest) ProtoMessage() {}
+
+func (x *DeleteMemoReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMemoReactionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMemoReactionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeleteMemoReactionRequest) GetReactionId() int32 {
+	if x != nil {
+		return x.ReactionId
+	}
+	return 0
+}
+
+type MemoRevision struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo revision.
+	// Format: memos/{memo}/revisions/{revision}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The name of the user who made the change that produced this revision.
+	// Format: users/{id}
+	Creator    string                 `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The content of the memo before the change.
+	Content string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	// The visibility of the memo before the change.
+	Visibility    Visibility `protobuf:"varint,5,opt,name=visibility,proto3,enum=memos.api.v1.Visibility" json:"visibility,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoRevision) Reset() {
+	*x = MemoRevision{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoRevision) ProtoMessage() {}
+
+func (x *MemoRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoRevision.ProtoReflect.Descriptor instead.
+func (*MemoRevision) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *MemoRevision) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MemoRevision) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MemoRevision) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *MemoRevision) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *MemoRevision) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+type ListMemoRevisionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo.
+	// Format: memos/{id}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoRevisionsRequest) Reset() {
+	*x = ListMemoRevisionsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoRevisionsRequest) ProtoMessage() {}
+
+func (x *ListMemoRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListMemoRevisionsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListMemoRevisionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The revisions of the memo, ordered from newest to oldest.
+	Revisions     []*MemoRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoRevisionsResponse) Reset() {
+	*x = ListMemoRevisionsResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoRevisionsResponse) ProtoMessage() {}
+
+func (x *ListMemoRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListMemoRevisionsResponse) GetRevisions() []*MemoRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type GetMemoRevisionDiffRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo revision.
+	// Format: memos/{memo}/revisions/{revision}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. The name of another revision of the same memo to compare with.
+	// If empty, the revision is compared with the current memo.
+	// Format: memos/{memo}/revisions/{revision}
+	CompareTo     string `protobuf:"bytes,2,opt,name=compare_to,json=compareTo,proto3" json:"compare_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemoRevisionDiffRequest) Reset() {
+	*x = GetMemoRevisionDiffRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RenameMemoTagRequest) String() string {
+func (x *GetMemoRevisionDiffRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RenameMemoTagRequest) ProtoMessage() {}
+func (*GetMemoRevisionDiffRequest) ProtoMessage() {}
 
-func (x *RenameMemoTagRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[10]
+func (x *GetMemoRevisionDiffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -902,58 +3799,115 @@ func (x *RenameMemoTagRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RenameMemoTagRequest.ProtoReflect.Descriptor instead.
-func (*RenameMemoTagRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GetMemoRevisionDiffRequest.ProtoReflect.Descriptor instead.
+func (*GetMemoRevisionDiffRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *RenameMemoTagRequest) GetParent() string {
+func (x *GetMemoRevisionDiffRequest) GetName() string {
 	if x != nil {
-		return x.Parent
+		return x.Name
 	}
 	return ""
 }
 
-func (x *RenameMemoTagRequest) GetOldTag() string {
+func (x *GetMemoRevisionDiffRequest) GetCompareTo() string {
 	if x != nil {
-		return x.OldTag
+		return x.CompareTo
 	}
 	return ""
 }
 
-func (x *RenameMemoTagRequest) GetNewTag() string {
+type GetMemoRevisionDiffResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The unified diff of the content.
+	Diff string `protobuf:"bytes,1,opt,name=diff,proto3" json:"diff,omitempty"`
+	// The visibility of the revision.
+	OldVisibility Visibility `protobuf:"varint,2,opt,name=old_visibility,json=oldVisibility,proto3,enum=memos.api.v1.Visibility" json:"old_visibility,omitempty"`
+	// The visibility of the compared revision or the current memo.
+	NewVisibility Visibility `protobuf:"varint,3,opt,name=new_visibility,json=newVisibility,proto3,enum=memos.api.v1.Visibility" json:"new_visibility,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemoRevisionDiffResponse) Reset() {
+	*x = GetMemoRevisionDiffResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemoRevisionDiffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoRevisionDiffResponse) ProtoMessage() {}
+
+func (x *GetMemoRevisionDiffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[57]
 	if x != nil {
-		return x.NewTag
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoRevisionDiffResponse.ProtoReflect.Descriptor instead.
+func (*GetMemoRevisionDiffResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetMemoRevisionDiffResponse) GetDiff() string {
+	if x != nil {
+		return x.Diff
 	}
 	return ""
 }
 
-type DeleteMemoTagRequest struct {
+func (x *GetMemoRevisionDiffResponse) GetOldVisibility() Visibility {
+	if x != nil {
+		return x.OldVisibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+func (x *GetMemoRevisionDiffResponse) GetNewVisibility() Visibility {
+	if x != nil {
+		return x.NewVisibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+type RestoreMemoRevisionRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The parent, who owns the tags.
-	// Format: memos/{id}. Use "memos/-" to delete all tags.
-	Parent             string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
-	Tag                string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
-	DeleteRelatedMemos bool   `protobuf:"varint,3,opt,name=delete_related_memos,json=deleteRelatedMemos,proto3" json:"delete_related_memos,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// The name of the memo revision.
+	// Format: memos/{memo}/revisions/{revision}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. The etag of the memo the mutation is based on.
+	// If the memo was updated since, the request fails with FAILED_PRECONDITION and the current memo in its details.
+	Etag          string `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteMemoTagRequest) Reset() {
-	*x = DeleteMemoTagRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[11]
+func (x *RestoreMemoRevisionRequest) Reset() {
+	*x = RestoreMemoRevisionRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMemoTagRequest) String() string {
+func (x *RestoreMemoRevisionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMemoTagRequest) ProtoMessage() {}
+func (*RestoreMemoRevisionRequest) ProtoMessage() {}
 
-func (x *DeleteMemoTagRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[11]
+func (x *RestoreMemoRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -964,57 +3918,154 @@ func (x *DeleteMemoTagRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMemoTagRequest.ProtoReflect.Descriptor instead.
-func (*DeleteMemoTagRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use RestoreMemoRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreMemoRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *DeleteMemoTagRequest) GetParent() string {
+func (x *RestoreMemoRevisionRequest) GetName() string {
 	if x != nil {
-		return x.Parent
+		return x.Name
 	}
 	return ""
 }
 
-func (x *DeleteMemoTagRequest) GetTag() string {
+func (x *RestoreMemoRevisionRequest) GetEtag() string {
 	if x != nil {
-		return x.Tag
+		return x.Etag
 	}
 	return ""
 }
 
-func (x *DeleteMemoTagRequest) GetDeleteRelatedMemos() bool {
+type MemoShareLink struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the share link.
+	// Format: memos/{memo}/shareLinks/{id}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The token of the share link.
+	// It is passed in the X-Memo-Share-Token header or the share_token query parameter.
+	Token      string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The time the share link expires, unset if it never expires.
+	ExpireTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// Whether a password is needed to use the share link.
+	PasswordProtected bool `protobuf:"varint,5,opt,name=password_protected,json=passwordProtected,proto3" json:"password_protected,omitempty"`
+	// The number of times the share link was used.
+	AccessCount int32 `protobuf:"varint,6,opt,name=access_count,json=accessCount,proto3" json:"access_count,omitempty"`
+	// The last time the share link was used, unset if it was never used.
+	LastAccessTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_access_time,json=lastAccessTime,proto3" json:"last_access_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MemoShareLink) Reset() {
+	*x = MemoShareLink{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoShareLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoShareLink) ProtoMessage() {}
+
+func (x *MemoShareLink) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[59]
 	if x != nil {
-		return x.DeleteRelatedMemos
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoShareLink.ProtoReflect.Descriptor instead.
+func (*MemoShareLink) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *MemoShareLink) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MemoShareLink) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *MemoShareLink) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *MemoShareLink) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *MemoShareLink) GetPasswordProtected() bool {
+	if x != nil {
+		return x.PasswordProtected
 	}
 	return false
 }
 
-type SetMemoResourcesRequest struct {
+func (x *MemoShareLink) GetAccessCount() int32 {
+	if x != nil {
+		return x.AccessCount
+	}
+	return 0
+}
+
+func (x *MemoShareLink) GetLastAccessTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAccessTime
+	}
+	return nil
+}
+
+type CreateMemoShareLinkRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The name of the memo.
 	// Format: memos/{id}
-	Name          string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Resources     []*Resource `protobuf:"bytes,2,rep,name=resources,proto3" json:"resources,omitempty"`
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Optional. The time the share link expires.
+	ExpireTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// Optional. The password needed to use the share link.
+	// It is passed in the X-Memo-Share-Password header.
+	Password      string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetMemoResourcesRequest) Reset() {
-	*x = SetMemoResourcesRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[12]
+func (x *CreateMemoShareLinkRequest) Reset() {
+	*x = CreateMemoShareLinkRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetMemoResourcesRequest) String() string {
+func (x *CreateMemoShareLinkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetMemoResourcesRequest) ProtoMessage() {}
+func (*CreateMemoShareLinkRequest) ProtoMessage() {}
 
-func (x *SetMemoResourcesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[12]
+func (x *CreateMemoShareLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1025,49 +4076,147 @@ func (x *SetMemoResourcesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetMemoResourcesRequest.ProtoReflect.Descriptor instead.
-func (*SetMemoResourcesRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use CreateMemoShareLinkRequest.ProtoReflect.Descriptor instead.
+func (*CreateMemoShareLinkRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *SetMemoResourcesRequest) GetName() string {
+func (x *CreateMemoShareLinkRequest) GetParent() string {
 	if x != nil {
-		return x.Name
+		return x.Parent
 	}
 	return ""
 }
 
-func (x *SetMemoResourcesRequest) GetResources() []*Resource {
+func (x *CreateMemoShareLinkRequest) GetExpireTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Resources
+		return x.ExpireTime
 	}
 	return nil
 }
 
-type ListMemoResourcesRequest struct {
+func (x *CreateMemoShareLinkRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type ListMemoShareLinksRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The name of the memo.
 	// Format: memos/{id}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoShareLinksRequest) Reset() {
+	*x = ListMemoShareLinksRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoShareLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoShareLinksRequest) ProtoMessage() {}
+
+func (x *ListMemoShareLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoShareLinksRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoShareLinksRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListMemoShareLinksRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type ListMemoShareLinksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The share links of the memo, ordered from newest to oldest.
+	ShareLinks    []*MemoShareLink `protobuf:"bytes,1,rep,name=share_links,json=shareLinks,proto3" json:"share_links,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoShareLinksResponse) Reset() {
+	*x = ListMemoShareLinksResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoShareLinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoShareLinksResponse) ProtoMessage() {}
+
+func (x *ListMemoShareLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoShareLinksResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoShareLinksResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListMemoShareLinksResponse) GetShareLinks() []*MemoShareLink {
+	if x != nil {
+		return x.ShareLinks
+	}
+	return nil
+}
+
+type RevokeMemoShareLinkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the share link.
+	// Format: memos/{memo}/shareLinks/{id}
 	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoResourcesRequest) Reset() {
-	*x = ListMemoResourcesRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[13]
+func (x *RevokeMemoShareLinkRequest) Reset() {
+	*x = RevokeMemoShareLinkRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoResourcesRequest) String() string {
+func (x *RevokeMemoShareLinkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoResourcesRequest) ProtoMessage() {}
+func (*RevokeMemoShareLinkRequest) ProtoMessage() {}
 
-func (x *ListMemoResourcesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[13]
+func (x *RevokeMemoShareLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1078,40 +4227,45 @@ func (x *ListMemoResourcesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoResourcesRequest.ProtoReflect.Descriptor instead.
-func (*ListMemoResourcesRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use RevokeMemoShareLinkRequest.ProtoReflect.Descriptor instead.
+func (*RevokeMemoShareLinkRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *ListMemoResourcesRequest) GetName() string {
+func (x *RevokeMemoShareLinkRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type ListMemoResourcesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Resources     []*Resource            `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+// MemoCollaborator is a user granted access to a memo by its creator, whatever the visibility of the memo.
+type MemoCollaborator struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the user.
+	// Format: users/{id}
+	User          string                 `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Role          MemoCollaborator_Role  `protobuf:"varint,2,opt,name=role,proto3,enum=memos.api.v1.MemoCollaborator_Role" json:"role,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoResourcesResponse) Reset() {
-	*x = ListMemoResourcesResponse{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[14]
+func (x *MemoCollaborator) Reset() {
+	*x = MemoCollaborator{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoResourcesResponse) String() string {
+func (x *MemoCollaborator) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoResourcesResponse) ProtoMessage() {}
+func (*MemoCollaborator) ProtoMessage() {}
 
-func (x *ListMemoResourcesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[14]
+func (x *MemoCollaborator) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1122,43 +4276,60 @@ func (x *ListMemoResourcesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoResourcesResponse.ProtoReflect.Descriptor instead.
-func (*ListMemoResourcesResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use MemoCollaborator.ProtoReflect.Descriptor instead.
+func (*MemoCollaborator) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *MemoCollaborator) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *MemoCollaborator) GetRole() MemoCollaborator_Role {
+	if x != nil {
+		return x.Role
+	}
+	return MemoCollaborator_ROLE_UNSPECIFIED
 }
 
-func (x *ListMemoResourcesResponse) GetResources() []*Resource {
+func (x *MemoCollaborator) GetCreateTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Resources
+		return x.CreateTime
 	}
 	return nil
 }
 
-type SetMemoRelationsRequest struct {
+type SetMemoCollaboratorsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The name of the memo.
 	// Format: memos/{id}
-	Name          string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Relations     []*MemoRelation `protobuf:"bytes,2,rep,name=relations,proto3" json:"relations,omitempty"`
+	Name          string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Collaborators []*MemoCollaborator `protobuf:"bytes,2,rep,name=collaborators,proto3" json:"collaborators,omitempty"`
+	// Optional. The etag of the memo the mutation is based on.
+	// If the memo was updated since, the request fails with FAILED_PRECONDITION and the current memo in its details.
+	Etag          string `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetMemoRelationsRequest) Reset() {
-	*x = SetMemoRelationsRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[15]
+func (x *SetMemoCollaboratorsRequest) Reset() {
+	*x = SetMemoCollaboratorsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetMemoRelationsRequest) String() string {
+func (x *SetMemoCollaboratorsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetMemoRelationsRequest) ProtoMessage() {}
+func (*SetMemoCollaboratorsRequest) ProtoMessage() {}
 
-func (x *SetMemoRelationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[15]
+func (x *SetMemoCollaboratorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1169,26 +4340,33 @@ func (x *SetMemoRelationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetMemoRelationsRequest.ProtoReflect.Descriptor instead.
-func (*SetMemoRelationsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use SetMemoCollaboratorsRequest.ProtoReflect.Descriptor instead.
+func (*SetMemoCollaboratorsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *SetMemoRelationsRequest) GetName() string {
+func (x *SetMemoCollaboratorsRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *SetMemoRelationsRequest) GetRelations() []*MemoRelation {
+func (x *SetMemoCollaboratorsRequest) GetCollaborators() []*MemoCollaborator {
 	if x != nil {
-		return x.Relations
+		return x.Collaborators
 	}
 	return nil
 }
 
-type ListMemoRelationsRequest struct {
+func (x *SetMemoCollaboratorsRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type ListMemoCollaboratorsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The name of the memo.
 	// Format: memos/{id}
@@ -1197,21 +4375,21 @@ type ListMemoRelationsRequest struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoRelationsRequest) Reset() {
-	*x = ListMemoRelationsRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[16]
+func (x *ListMemoCollaboratorsRequest) Reset() {
+	*x = ListMemoCollaboratorsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoRelationsRequest) String() string {
+func (x *ListMemoCollaboratorsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoRelationsRequest) ProtoMessage() {}
+func (*ListMemoCollaboratorsRequest) ProtoMessage() {}
 
-func (x *ListMemoRelationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[16]
+func (x *ListMemoCollaboratorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1222,40 +4400,40 @@ func (x *ListMemoRelationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoRelationsRequest.ProtoReflect.Descriptor instead.
-func (*ListMemoRelationsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ListMemoCollaboratorsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoCollaboratorsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *ListMemoRelationsRequest) GetName() string {
+func (x *ListMemoCollaboratorsRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type ListMemoRelationsResponse struct {
+type ListMemoCollaboratorsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Relations     []*MemoRelation        `protobuf:"bytes,1,rep,name=relations,proto3" json:"relations,omitempty"`
+	Collaborators []*MemoCollaborator    `protobuf:"bytes,1,rep,name=collaborators,proto3" json:"collaborators,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoRelationsResponse) Reset() {
-	*x = ListMemoRelationsResponse{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[17]
+func (x *ListMemoCollaboratorsResponse) Reset() {
+	*x = ListMemoCollaboratorsResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoRelationsResponse) String() string {
+func (x *ListMemoCollaboratorsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoRelationsResponse) ProtoMessage() {}
+func (*ListMemoCollaboratorsResponse) ProtoMessage() {}
 
-func (x *ListMemoRelationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[17]
+func (x *ListMemoCollaboratorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1266,43 +4444,54 @@ func (x *ListMemoRelationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoRelationsResponse.ProtoReflect.Descriptor instead.
-func (*ListMemoRelationsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ListMemoCollaboratorsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoCollaboratorsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *ListMemoRelationsResponse) GetRelations() []*MemoRelation {
+func (x *ListMemoCollaboratorsResponse) GetCollaborators() []*MemoCollaborator {
 	if x != nil {
-		return x.Relations
+		return x.Collaborators
 	}
 	return nil
 }
 
-type CreateMemoCommentRequest struct {
+// Task is an item of a task list in the content of a memo.
+type Task struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the task.
+	// Format: memos/{memo}/tasks/{line}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// The name of the memo.
 	// Format: memos/{id}
-	Name          string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Comment       *CreateMemoRequest `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	Memo string `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
+	// The index of the line of the task in the content of the memo, starting from 0.
+	Line int32 `protobuf:"varint,3,opt,name=line,proto3" json:"line,omitempty"`
+	// The content of the task, without its due date.
+	Content  string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Complete bool   `protobuf:"varint,5,opt,name=complete,proto3" json:"complete,omitempty"`
+	// The date of the @due(YYYY-MM-DD) in the task, in the format YYYY-MM-DD.
+	// Empty if the task has no due date.
+	DueDate       string `protobuf:"bytes,6,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateMemoCommentRequest) Reset() {
-	*x = CreateMemoCommentRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[18]
+func (x *Task) Reset() {
+	*x = Task{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateMemoCommentRequest) String() string {
+func (x *Task) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateMemoCommentRequest) ProtoMessage() {}
+func (*Task) ProtoMessage() {}
 
-func (x *CreateMemoCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[18]
+func (x *Task) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1313,93 +4502,79 @@ func (x *CreateMemoCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateMemoCommentRequest.ProtoReflect.Descriptor instead.
-func (*CreateMemoCommentRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use Task.ProtoReflect.Descriptor instead.
+func (*Task) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *CreateMemoCommentRequest) GetName() string {
+func (x *Task) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateMemoCommentRequest) GetComment() *CreateMemoRequest {
+func (x *Task) GetMemo() string {
 	if x != nil {
-		return x.Comment
+		return x.Memo
 	}
-	return nil
-}
-
-type ListMemoCommentsRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The name of the memo.
-	// Format: memos/{id}
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListMemoCommentsRequest) Reset() {
-	*x = ListMemoCommentsRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[19]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return ""
 }
 
-func (x *ListMemoCommentsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Task) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
 }
 
-func (*ListMemoCommentsRequest) ProtoMessage() {}
-
-func (x *ListMemoCommentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[19]
+func (x *Task) GetContent() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Content
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListMemoCommentsRequest.ProtoReflect.Descriptor instead.
-func (*ListMemoCommentsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{19}
+func (x *Task) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
 }
 
-func (x *ListMemoCommentsRequest) GetName() string {
+func (x *Task) GetDueDate() string {
 	if x != nil {
-		return x.Name
+		return x.DueDate
 	}
 	return ""
 }
 
-type ListMemoCommentsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Memos         []*Memo                `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ListTasksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the user.
+	// Format: users/{id}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Whether to exclude the completed tasks.
+	ExcludeCompleted bool `protobuf:"varint,2,opt,name=exclude_completed,json=excludeCompleted,proto3" json:"exclude_completed,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *ListMemoCommentsResponse) Reset() {
-	*x = ListMemoCommentsResponse{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[20]
+func (x *ListTasksRequest) Reset() {
+	*x = ListTasksRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoCommentsResponse) String() string {
+func (x *ListTasksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoCommentsResponse) ProtoMessage() {}
+func (*ListTasksRequest) ProtoMessage() {}
 
-func (x *ListMemoCommentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[20]
+func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1410,42 +4585,48 @@ func (x *ListMemoCommentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoCommentsResponse.ProtoReflect.Descriptor instead.
-func (*ListMemoCommentsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListTasksRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *ListMemoCommentsResponse) GetMemos() []*Memo {
+func (x *ListTasksRequest) GetParent() string {
 	if x != nil {
-		return x.Memos
+		return x.Parent
 	}
-	return nil
+	return ""
 }
 
-type ListMemoReactionsRequest struct {
+func (x *ListTasksRequest) GetExcludeCompleted() bool {
+	if x != nil {
+		return x.ExcludeCompleted
+	}
+	return false
+}
+
+type ListTasksResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The name of the memo.
-	// Format: memos/{id}
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The tasks, ordered as their memos in ListMemos and then by line.
+	Tasks         []*Task `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoReactionsRequest) Reset() {
-	*x = ListMemoReactionsRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[21]
+func (x *ListTasksResponse) Reset() {
+	*x = ListTasksResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoReactionsRequest) String() string {
+func (x *ListTasksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoReactionsRequest) ProtoMessage() {}
+func (*ListTasksResponse) ProtoMessage() {}
 
-func (x *ListMemoReactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[21]
+func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1456,40 +4637,46 @@ func (x *ListMemoReactionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoReactionsRequest.ProtoReflect.Descriptor instead.
-func (*ListMemoReactionsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ListTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListTasksResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *ListMemoReactionsRequest) GetName() string {
+func (x *ListTasksResponse) GetTasks() []*Task {
 	if x != nil {
-		return x.Name
+		return x.Tasks
 	}
-	return ""
+	return nil
 }
 
-type ListMemoReactionsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Reactions     []*Reaction            `protobuf:"bytes,1,rep,name=reactions,proto3" json:"reactions,omitempty"`
+type SetTaskStatusRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the task.
+	// Format: memos/{memo}/tasks/{line}
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Complete bool   `protobuf:"varint,2,opt,name=complete,proto3" json:"complete,omitempty"`
+	// Optional. The etag of the memo the mutation is based on.
+	// If the memo was updated since, the request fails with FAILED_PRECONDITION and the current memo in its details.
+	Etag          string `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoReactionsResponse) Reset() {
-	*x = ListMemoReactionsResponse{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[22]
+func (x *SetTaskStatusRequest) Reset() {
+	*x = SetTaskStatusRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoReactionsResponse) String() string {
+func (x *SetTaskStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoReactionsResponse) ProtoMessage() {}
+func (*SetTaskStatusRequest) ProtoMessage() {}
 
-func (x *ListMemoReactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[22]
+func (x *SetTaskStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1500,43 +4687,61 @@ func (x *ListMemoReactionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoReactionsResponse.ProtoReflect.Descriptor instead.
-func (*ListMemoReactionsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use SetTaskStatusRequest.ProtoReflect.Descriptor instead.
+func (*SetTaskStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *ListMemoReactionsResponse) GetReactions() []*Reaction {
+func (x *SetTaskStatusRequest) GetName() string {
 	if x != nil {
-		return x.Reactions
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type UpsertMemoReactionRequest struct {
+func (x *SetTaskStatusRequest) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
+}
+
+func (x *SetTaskStatusRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type MemoGraph_Node struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The name of the memo.
-	// Format: memos/{id}
-	Name          string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Reaction      *Reaction `protobuf:"bytes,2,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	// The name of the node.
+	// Format: memos/{id} for a memo, tags/{tag} for a tag.
+	Name string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type MemoGraph_Node_Type `protobuf:"varint,2,opt,name=type,proto3,enum=memos.api.v1.MemoGraph_Node_Type" json:"type,omitempty"`
+	// The snippet of the memo content, or the tag.
+	Label string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	// The number of the edges of the node in the whole graph, including the edges to the nodes that are not returned.
+	Degree        int32 `protobuf:"varint,4,opt,name=degree,proto3" json:"degree,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpsertMemoReactionRequest) Reset() {
-	*x = UpsertMemoReactionRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[23]
+func (x *MemoGraph_Node) Reset() {
+	*x = MemoGraph_Node{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpsertMemoReactionRequest) String() string {
+func (x *MemoGraph_Node) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpsertMemoReactionRequest) ProtoMessage() {}
+func (*MemoGraph_Node) ProtoMessage() {}
 
-func (x *UpsertMemoReactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[23]
+func (x *MemoGraph_Node) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1547,47 +4752,65 @@ func (x *UpsertMemoReactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpsertMemoReactionRequest.ProtoReflect.Descriptor instead.
-func (*UpsertMemoReactionRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use MemoGraph_Node.ProtoReflect.Descriptor instead.
+func (*MemoGraph_Node) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{35, 0}
 }
 
-func (x *UpsertMemoReactionRequest) GetName() string {
+func (x *MemoGraph_Node) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *UpsertMemoReactionRequest) GetReaction() *Reaction {
+func (x *MemoGraph_Node) GetType() MemoGraph_Node_Type {
 	if x != nil {
-		return x.Reaction
+		return x.Type
 	}
-	return nil
+	return MemoGraph_Node_TYPE_UNSPECIFIED
 }
 
-type DeleteMemoReactionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ReactionId    int32                  `protobuf:"varint,1,opt,name=reaction_id,json=reactionId,proto3" json:"reaction_id,omitempty"`
+func (x *MemoGraph_Node) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *MemoGraph_Node) GetDegree() int32 {
+	if x != nil {
+		return x.Degree
+	}
+	return 0
+}
+
+type MemoGraph_Edge struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the source node.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// The name of the target node.
+	Target        string              `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Type          MemoGraph_Edge_Type `protobuf:"varint,3,opt,name=type,proto3,enum=memos.api.v1.MemoGraph_Edge_Type" json:"type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteMemoReactionRequest) Reset() {
-	*x = DeleteMemoReactionRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[24]
+func (x *MemoGraph_Edge) Reset() {
+	*x = MemoGraph_Edge{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMemoReactionRequest) String() string {
+func (x *MemoGraph_Edge) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMemoReactionRequest) ProtoMessage() {}
+func (*MemoGraph_Edge) ProtoMessage() {}
 
-func (x *DeleteMemoReactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[24]
+func (x *MemoGraph_Edge) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1598,23 +4821,37 @@ func (x *DeleteMemoReactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMemoReactionRequest.ProtoReflect.Descriptor instead.
-func (*DeleteMemoReactionRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use MemoGraph_Edge.ProtoReflect.Descriptor instead.
+func (*MemoGraph_Edge) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{35, 1}
 }
 
-func (x *DeleteMemoReactionRequest) GetReactionId() int32 {
+func (x *MemoGraph_Edge) GetSource() string {
 	if x != nil {
-		return x.ReactionId
+		return x.Source
 	}
-	return 0
+	return ""
+}
+
+func (x *MemoGraph_Edge) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *MemoGraph_Edge) GetType() MemoGraph_Edge_Type {
+	if x != nil {
+		return x.Type
+	}
+	return MemoGraph_Edge_TYPE_UNSPECIFIED
 }
 
 var File_api_v1_memo_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\n" +
-	"\x19api/v1/memo_service.proto\x12\fmemos.api.v1\x1a\x13api/v1/common.proto\x1a\x1dapi/v1/markdown_service.proto\x1a\"api/v1/memo_relation_service.proto\x1a\x1dapi/v1/reaction_service.proto\x1a\x1dapi/v1/resource_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb2\x06\n" +
+	"\x19api/v1/memo_service.proto\x12\fmemos.api.v1\x1a\x13api/v1/common.proto\x1a\x1dapi/v1/markdown_service.proto\x1a\"api/v1/memo_relation_service.proto\x1a\x1dapi/v1/reaction_service.proto\x1a\x1dapi/v1/resource_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd8\b\n" +
 	"\x04Memo\x12\x18\n" +
 	"\x04name\x18\x01 \x01(\tB\x04\xe2A\x01\x03R\x04name\x12\x10\n" +
 	"\x03uid\x18\x02 \x01(\tR\x03uid\x126\n" +
@@ -1639,7 +4876,14 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\bproperty\x18\x11 \x01(\v2\x1a.memos.api.v1.MemoPropertyB\x04\xe2A\x01\x03R\bproperty\x12!\n" +
 	"\x06parent\x18\x12 \x01(\tB\x04\xe2A\x01\x03H\x00R\x06parent\x88\x01\x01\x12\x1e\n" +
 	"\asnippet\x18\x13 \x01(\tB\x04\xe2A\x01\x03R\asnippet\x127\n" +
-	"\blocation\x18\x14 \x01(\v2\x16.memos.api.v1.LocationH\x01R\blocation\x88\x01\x01B\t\n" +
+	"\blocation\x18\x14 \x01(\v2\x16.memos.api.v1.LocationH\x01R\blocation\x88\x01\x01\x12?\n" +
+	"\n" +
+	"trash_time\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampB\x04\xe2A\x01\x03R\ttrashTime\x12=\n" +
+	"\fpublish_time\x18\x16 \x01(\v2\x1a.google.protobuf.TimestampR\vpublishTime\x12M\n" +
+	"\x12publish_visibility\x18\x17 \x01(\x0e2\x18.memos.api.v1.VisibilityB\x04\xe2A\x01\x03R\x11publishVisibility\x12\x18\n" +
+	"\x04etag\x18\x18 \x01(\tB\x04\xe2A\x01\x03R\x04etag\x12;\n" +
+	"\vremind_time\x18\x19 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"remindTimeB\t\n" +
 	"\a_parentB\v\n" +
 	"\t_location\"\xb7\x01\n" +
 	"\fMemoProperty\x12\x19\n" +
@@ -1651,7 +4895,7 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\bLocation\x12 \n" +
 	"\vplaceholder\x18\x01 \x01(\tR\vplaceholder\x12\x1a\n" +
 	"\blatitude\x18\x02 \x01(\x01R\blatitude\x12\x1c\n" +
-	"\tlongitude\x18\x03 \x01(\x01R\tlongitude\"\xda\x02\n" +
+	"\tlongitude\x18\x03 \x01(\x01R\tlongitude\"\xee\x03\n" +
 	"\x11CreateMemoRequest\x12\x18\n" +
 	"\acontent\x18\x01 \x01(\tR\acontent\x128\n" +
 	"\n" +
@@ -1661,28 +4905,106 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\trelations\x18\x04 \x03(\v2\x1a.memos.api.v1.MemoRelationR\trelations\x127\n" +
 	"\blocation\x18\x05 \x01(\v2\x16.memos.api.v1.LocationH\x00R\blocation\x88\x01\x01\x12;\n" +
 	"\vcreate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"createTimeB\v\n" +
-	"\t_location\"\x92\x01\n" +
+	"createTime\x12=\n" +
+	"\fpublish_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vpublishTime\x12;\n" +
+	"\vremind_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"remindTime\x12\x16\n" +
+	"\x06source\x18\t \x01(\tR\x06sourceB\v\n" +
+	"\t_location\"\xae\x01\n" +
 	"\x10ListMemosRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
 	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x16\n" +
 	"\x06filter\x18\x03 \x01(\tR\x06filter\x12*\n" +
-	"\x04view\x18\x04 \x01(\x0e2\x16.memos.api.v1.MemoViewR\x04view\"e\n" +
+	"\x04view\x18\x04 \x01(\x0e2\x16.memos.api.v1.MemoViewR\x04view\x12\x1a\n" +
+	"\bshortcut\x18\x05 \x01(\tR\bshortcut\"\xaf\x01\n" +
 	"\x11ListMemosResponse\x12(\n" +
 	"\x05memos\x18\x01 \x03(\v2\x12.memos.api.v1.MemoR\x05memos\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"$\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12H\n" +
+	"\x0fsearch_snippets\x18\x03 \x03(\v2\x1f.memos.api.v1.MemoSearchSnippetR\x0esearchSnippets\"b\n" +
+	"\x18ListMemoLocationsRequest\x12\x16\n" +
+	"\x06filter\x18\x01 \x01(\tR\x06filter\x12\x1a\n" +
+	"\bshortcut\x18\x02 \x01(\tR\bshortcut\x12\x12\n" +
+	"\x04zoom\x18\x03 \x01(\x05R\x04zoom\"Z\n" +
+	"\x19ListMemoLocationsResponse\x12=\n" +
+	"\bclusters\x18\x01 \x03(\v2!.memos.api.v1.MemoLocationClusterR\bclusters\"y\n" +
+	"\x13MemoLocationCluster\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\x12\x12\n" +
+	"\x04memo\x18\x04 \x01(\tR\x04memo\"A\n" +
+	"\x11MemoSearchSnippet\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\asnippet\x18\x02 \x01(\tR\asnippet\"$\n" +
 	"\x0eGetMemoRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\"'\n" +
 	"\x13GetMemoByUidRequest\x12\x10\n" +
-	"\x03uid\x18\x01 \x01(\tR\x03uid\"\xaa\x01\n" +
+	"\x03uid\x18\x01 \x01(\tR\x03uid\"\xd6\x01\n" +
 	"\x11UpdateMemoRequest\x12&\n" +
 	"\x04memo\x18\x01 \x01(\v2\x12.memos.api.v1.MemoR\x04memo\x12;\n" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
 	"updateMask\x120\n" +
-	"\x14preserve_update_time\x18\x03 \x01(\bR\x12preserveUpdateTime\"'\n" +
+	"\x14preserve_update_time\x18\x03 \x01(\bR\x12preserveUpdateTime\x12\x12\n" +
+	"\x04etag\x18\x04 \x01(\tR\x04etag\x12\x16\n" +
+	"\x06source\x18\x05 \x01(\tR\x06source\"Q\n" +
 	"\x11DeleteMemoRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"`\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\x12\x12\n" +
+	"\x04etag\x18\x03 \x01(\tR\x04etag\"\xfe\x01\n" +
+	"\x17BatchUpdateMemosRequest\x12\x14\n" +
+	"\x05names\x18\x01 \x03(\tR\x05names\x12\x16\n" +
+	"\x06filter\x18\x02 \x01(\tR\x06filter\x12&\n" +
+	"\x04memo\x18\x03 \x01(\v2\x12.memos.api.v1.MemoR\x04memo\x12;\n" +
+	"\vupdate_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x19\n" +
+	"\badd_tags\x18\x05 \x03(\tR\aaddTags\x12\x1f\n" +
+	"\vremove_tags\x18\x06 \x03(\tR\n" +
+	"removeTags\x12\x14\n" +
+	"\x05etags\x18\a \x03(\tR\x05etags\"S\n" +
+	"\x18BatchUpdateMemosResponse\x127\n" +
+	"\aresults\x18\x01 \x03(\v2\x1d.memos.api.v1.BatchMemoResultR\aresults\"s\n" +
+	"\x17BatchDeleteMemosRequest\x12\x14\n" +
+	"\x05names\x18\x01 \x03(\tR\x05names\x12\x16\n" +
+	"\x06filter\x18\x02 \x01(\tR\x06filter\x12\x14\n" +
+	"\x05force\x18\x03 \x01(\bR\x05force\x12\x14\n" +
+	"\x05etags\x18\x04 \x03(\tR\x05etags\"S\n" +
+	"\x18BatchDeleteMemosResponse\x127\n" +
+	"\aresults\x18\x01 \x03(\v2\x1d.memos.api.v1.BatchMemoResultR\aresults\";\n" +
+	"\x0fBatchMemoResult\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\xe5\x01\n" +
+	"\x12ImportMemosRequest\x12?\n" +
+	"\x06source\x18\x01 \x01(\x0e2'.memos.api.v1.ImportMemosRequest.SourceR\x06source\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"[\n" +
+	"\x06Source\x12\x16\n" +
+	"\x12SOURCE_UNSPECIFIED\x10\x00\x12\f\n" +
+	"\bMARKDOWN\x10\x01\x12\f\n" +
+	"\bOBSIDIAN\x10\x02\x12\x0f\n" +
+	"\vGOOGLE_KEEP\x10\x03\x12\f\n" +
+	"\bEVERNOTE\x10\x04\"P\n" +
+	"\x13ImportMemosResponse\x129\n" +
+	"\aresults\x18\x01 \x03(\v2\x1f.memos.api.v1.ImportMemosResultR\aresults\"\xfc\x01\n" +
+	"\x11ImportMemosResult\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x12\n" +
+	"\x04memo\x18\x02 \x01(\tR\x04memo\x12;\n" +
+	"\vcreate_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12%\n" +
+	"\x0eresource_count\x18\x04 \x01(\x05R\rresourceCount\x12%\n" +
+	"\x0erelation_count\x18\x05 \x01(\x05R\rrelationCount\x12\x1a\n" +
+	"\bwarnings\x18\x06 \x03(\tR\bwarnings\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\"U\n" +
+	"\x17ListTrashedMemosRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"l\n" +
+	"\x18ListTrashedMemosResponse\x12(\n" +
+	"\x05memos\x18\x01 \x03(\v2\x12.memos.api.v1.MemoR\x05memos\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"<\n" +
+	"\x12RestoreMemoRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\"\x13\n" +
+	"\x11EmptyTrashRequest\"`\n" +
 	"\x14RenameMemoTagRequest\x12\x16\n" +
 	"\x06parent\x18\x01 \x01(\tR\x06parent\x12\x17\n" +
 	"\aold_tag\x18\x02 \x01(\tR\x06oldTag\x12\x17\n" +
@@ -1690,28 +5012,82 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\x14DeleteMemoTagRequest\x12\x16\n" +
 	"\x06parent\x18\x01 \x01(\tR\x06parent\x12\x10\n" +
 	"\x03tag\x18\x02 \x01(\tR\x03tag\x120\n" +
-	"\x14delete_related_memos\x18\x03 \x01(\bR\x12deleteRelatedMemos\"c\n" +
+	"\x14delete_related_memos\x18\x03 \x01(\bR\x12deleteRelatedMemos\"w\n" +
 	"\x17SetMemoResourcesRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x124\n" +
-	"\tresources\x18\x02 \x03(\v2\x16.memos.api.v1.ResourceR\tresources\".\n" +
+	"\tresources\x18\x02 \x03(\v2\x16.memos.api.v1.ResourceR\tresources\x12\x12\n" +
+	"\x04etag\x18\x03 \x01(\tR\x04etag\".\n" +
 	"\x18ListMemoResourcesRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\"Q\n" +
 	"\x19ListMemoResourcesResponse\x124\n" +
-	"\tresources\x18\x01 \x03(\v2\x16.memos.api.v1.ResourceR\tresources\"g\n" +
+	"\tresources\x18\x01 \x03(\v2\x16.memos.api.v1.ResourceR\tresources\"{\n" +
 	"\x17SetMemoRelationsRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x128\n" +
-	"\trelations\x18\x02 \x03(\v2\x1a.memos.api.v1.MemoRelationR\trelations\".\n" +
+	"\trelations\x18\x02 \x03(\v2\x1a.memos.api.v1.MemoRelationR\trelations\x12\x12\n" +
+	"\x04etag\x18\x03 \x01(\tR\x04etag\".\n" +
 	"\x18ListMemoRelationsRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\"U\n" +
 	"\x19ListMemoRelationsResponse\x128\n" +
-	"\trelations\x18\x01 \x03(\v2\x1a.memos.api.v1.MemoRelationR\trelations\"i\n" +
+	"\trelations\x18\x01 \x03(\v2\x1a.memos.api.v1.MemoRelationR\trelations\"?\n" +
+	"\x13GetMemoGraphRequest\x12\x12\n" +
+	"\x04root\x18\x01 \x01(\tR\x04root\x12\x14\n" +
+	"\x05depth\x18\x02 \x01(\x05R\x05depth\"\xd9\x03\n" +
+	"\tMemoGraph\x122\n" +
+	"\x05nodes\x18\x01 \x03(\v2\x1c.memos.api.v1.MemoGraph.NodeR\x05nodes\x122\n" +
+	"\x05edges\x18\x02 \x03(\v2\x1c.memos.api.v1.MemoGraph.EdgeR\x05edges\x1a\xb0\x01\n" +
+	"\x04Node\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x125\n" +
+	"\x04type\x18\x02 \x01(\x0e2!.memos.api.v1.MemoGraph.Node.TypeR\x04type\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12\x16\n" +
+	"\x06degree\x18\x04 \x01(\x05R\x06degree\"/\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04MEMO\x10\x01\x12\a\n" +
+	"\x03TAG\x10\x02\x1a\xb0\x01\n" +
+	"\x04Edge\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x125\n" +
+	"\x04type\x18\x03 \x01(\x0e2!.memos.api.v1.MemoGraph.Edge.TypeR\x04type\"A\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tREFERENCE\x10\x01\x12\v\n" +
+	"\aCOMMENT\x10\x02\x12\a\n" +
+	"\x03TAG\x10\x03\".\n" +
+	"\x18ListMemoBacklinksRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"U\n" +
+	"\x19ListMemoBacklinksResponse\x128\n" +
+	"\tbacklinks\x18\x01 \x03(\v2\x1a.memos.api.v1.MemoBacklinkR\tbacklinks\"_\n" +
+	"\fMemoBacklink\x123\n" +
+	"\x04memo\x18\x01 \x01(\v2\x1f.memos.api.v1.MemoRelation.MemoR\x04memo\x12\x1a\n" +
+	"\bsentence\x18\x02 \x01(\tR\bsentence\"J\n" +
+	"\x17ListRelatedMemosRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"Z\n" +
+	"\x18ListRelatedMemosResponse\x12>\n" +
+	"\rrelated_memos\x18\x01 \x03(\v2\x19.memos.api.v1.RelatedMemoR\frelatedMemos\"X\n" +
+	"\vRelatedMemo\x123\n" +
+	"\x04memo\x18\x01 \x01(\v2\x1f.memos.api.v1.MemoRelation.MemoR\x04memo\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\">\n" +
+	"\x19ListDuplicateMemosRequest\x12!\n" +
+	"\fmax_distance\x18\x01 \x01(\x05R\vmaxDistance\"V\n" +
+	"\x1aListDuplicateMemosResponse\x128\n" +
+	"\x06groups\x18\x01 \x03(\v2 .memos.api.v1.DuplicateMemoGroupR\x06groups\"K\n" +
+	"\x12DuplicateMemoGroup\x125\n" +
+	"\x05memos\x18\x01 \x03(\v2\x1f.memos.api.v1.MemoRelation.MemoR\x05memos\"=\n" +
+	"\x11MergeMemosRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05memos\x18\x02 \x03(\tR\x05memos\"i\n" +
 	"\x18CreateMemoCommentRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x129\n" +
-	"\acomment\x18\x02 \x01(\v2\x1f.memos.api.v1.CreateMemoRequestR\acomment\"-\n" +
+	"\acomment\x18\x02 \x01(\v2\x1f.memos.api.v1.CreateMemoRequestR\acomment\"i\n" +
 	"\x17ListMemoCommentsRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"D\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"l\n" +
 	"\x18ListMemoCommentsResponse\x12(\n" +
-	"\x05memos\x18\x01 \x03(\v2\x12.memos.api.v1.MemoR\x05memos\".\n" +
+	"\x05memos\x18\x01 \x03(\v2\x12.memos.api.v1.MemoR\x05memos\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\".\n" +
 	"\x18ListMemoReactionsRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\"Q\n" +
 	"\x19ListMemoReactionsResponse\x124\n" +
@@ -1721,7 +5097,88 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\breaction\x18\x02 \x01(\v2\x16.memos.api.v1.ReactionR\breaction\"<\n" +
 	"\x19DeleteMemoReactionRequest\x12\x1f\n" +
 	"\vreaction_id\x18\x01 \x01(\x05R\n" +
-	"reactionId*P\n" +
+	"reactionId\"\xdf\x01\n" +
+	"\fMemoRevision\x12\x18\n" +
+	"\x04name\x18\x01 \x01(\tB\x04\xe2A\x01\x03R\x04name\x12\x1e\n" +
+	"\acreator\x18\x02 \x01(\tB\x04\xe2A\x01\x03R\acreator\x12A\n" +
+	"\vcreate_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x04\xe2A\x01\x03R\n" +
+	"createTime\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x128\n" +
+	"\n" +
+	"visibility\x18\x05 \x01(\x0e2\x18.memos.api.v1.VisibilityR\n" +
+	"visibility\".\n" +
+	"\x18ListMemoRevisionsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"U\n" +
+	"\x19ListMemoRevisionsResponse\x128\n" +
+	"\trevisions\x18\x01 \x03(\v2\x1a.memos.api.v1.MemoRevisionR\trevisions\"O\n" +
+	"\x1aGetMemoRevisionDiffRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"compare_to\x18\x02 \x01(\tR\tcompareTo\"\xb3\x01\n" +
+	"\x1bGetMemoRevisionDiffResponse\x12\x12\n" +
+	"\x04diff\x18\x01 \x01(\tR\x04diff\x12?\n" +
+	"\x0eold_visibility\x18\x02 \x01(\x0e2\x18.memos.api.v1.VisibilityR\roldVisibility\x12?\n" +
+	"\x0enew_visibility\x18\x03 \x01(\x0e2\x18.memos.api.v1.VisibilityR\rnewVisibility\"D\n" +
+	"\x1aRestoreMemoRevisionRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\"\xef\x02\n" +
+	"\rMemoShareLink\x12\x18\n" +
+	"\x04name\x18\x01 \x01(\tB\x04\xe2A\x01\x03R\x04name\x12\x1a\n" +
+	"\x05token\x18\x02 \x01(\tB\x04\xe2A\x01\x03R\x05token\x12A\n" +
+	"\vcreate_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x04\xe2A\x01\x03R\n" +
+	"createTime\x12;\n" +
+	"\vexpire_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\x123\n" +
+	"\x12password_protected\x18\x05 \x01(\bB\x04\xe2A\x01\x03R\x11passwordProtected\x12'\n" +
+	"\faccess_count\x18\x06 \x01(\x05B\x04\xe2A\x01\x03R\vaccessCount\x12J\n" +
+	"\x10last_access_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x04\xe2A\x01\x03R\x0elastAccessTime\"\x8d\x01\n" +
+	"\x1aCreateMemoShareLinkRequest\x12\x16\n" +
+	"\x06parent\x18\x01 \x01(\tR\x06parent\x12;\n" +
+	"\vexpire_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\"3\n" +
+	"\x19ListMemoShareLinksRequest\x12\x16\n" +
+	"\x06parent\x18\x01 \x01(\tR\x06parent\"Z\n" +
+	"\x1aListMemoShareLinksResponse\x12<\n" +
+	"\vshare_links\x18\x01 \x03(\v2\x1b.memos.api.v1.MemoShareLinkR\n" +
+	"shareLinks\"0\n" +
+	"\x1aRevokeMemoShareLinkRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\xd8\x01\n" +
+	"\x10MemoCollaborator\x12\x12\n" +
+	"\x04user\x18\x01 \x01(\tR\x04user\x127\n" +
+	"\x04role\x18\x02 \x01(\x0e2#.memos.api.v1.MemoCollaborator.RoleR\x04role\x12A\n" +
+	"\vcreate_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x04\xe2A\x01\x03R\n" +
+	"createTime\"4\n" +
+	"\x04Role\x12\x14\n" +
+	"\x10ROLE_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06VIEWER\x10\x01\x12\n" +
+	"\n" +
+	"\x06EDITOR\x10\x02\"\x8b\x01\n" +
+	"\x1bSetMemoCollaboratorsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12D\n" +
+	"\rcollaborators\x18\x02 \x03(\v2\x1e.memos.api.v1.MemoCollaboratorR\rcollaborators\x12\x12\n" +
+	"\x04etag\x18\x03 \x01(\tR\x04etag\"2\n" +
+	"\x1cListMemoCollaboratorsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"e\n" +
+	"\x1dListMemoCollaboratorsResponse\x12D\n" +
+	"\rcollaborators\x18\x01 \x03(\v2\x1e.memos.api.v1.MemoCollaboratorR\rcollaborators\"\x93\x01\n" +
+	"\x04Task\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04memo\x18\x02 \x01(\tR\x04memo\x12\x12\n" +
+	"\x04line\x18\x03 \x01(\x05R\x04line\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x1a\n" +
+	"\bcomplete\x18\x05 \x01(\bR\bcomplete\x12\x19\n" +
+	"\bdue_date\x18\x06 \x01(\tR\adueDate\"W\n" +
+	"\x10ListTasksRequest\x12\x16\n" +
+	"\x06parent\x18\x01 \x01(\tR\x06parent\x12+\n" +
+	"\x11exclude_completed\x18\x02 \x01(\bR\x10excludeCompleted\"=\n" +
+	"\x11ListTasksResponse\x12(\n" +
+	"\x05tasks\x18\x01 \x03(\v2\x12.memos.api.v1.TaskR\x05tasks\"Z\n" +
+	"\x14SetTaskStatusRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcomplete\x18\x02 \x01(\bR\bcomplete\x12\x12\n" +
+	"\x04etag\x18\x03 \x01(\tR\x04etag*P\n" +
 	"\n" +
 	"Visibility\x12\x1a\n" +
 	"\x16VISIBILITY_UNSPECIFIED\x10\x00\x12\v\n" +
@@ -1732,11 +5189,12 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\bMemoView\x12\x19\n" +
 	"\x15MEMO_VIEW_UNSPECIFIED\x10\x00\x12\x12\n" +
 	"\x0eMEMO_VIEW_FULL\x10\x01\x12\x1b\n" +
-	"\x17MEMO_VIEW_METADATA_ONLY\x10\x022\x9d\x11\n" +
+	"\x17MEMO_VIEW_METADATA_ONLY\x10\x022\x83)\n" +
 	"\vMemoService\x12[\n" +
 	"\n" +
 	"CreateMemo\x12\x1f.memos.api.v1.CreateMemoRequest\x1a\x12.memos.api.v1.Memo\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/api/v1/memos\x12c\n" +
-	"\tListMemos\x12\x1e.memos.api.v1.ListMemosRequest\x1a\x1f.memos.api.v1.ListMemosResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/memos\x12b\n" +
+	"\tListMemos\x12\x1e.memos.api.v1.ListMemosRequest\x1a\x1f.memos.api.v1.ListMemosResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/memos\x12\x85\x01\n" +
+	"\x11ListMemoLocations\x12&.memos.api.v1.ListMemoLocationsRequest\x1a'.memos.api.v1.ListMemoLocationsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/memos:locations\x12b\n" +
 	"\aGetMemo\x12\x1c.memos.api.v1.GetMemoRequest\x1a\x12.memos.api.v1.Memo\"%\xdaA\x04name\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/{name=memos/*}\x12o\n" +
 	"\fGetMemoByUid\x12!.memos.api.v1.GetMemoByUidRequest\x1a\x12.memos.api.v1.Memo\"(\xdaA\x03uid\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/memos:by-uid/{uid}\x12\x7f\n" +
 	"\n" +
@@ -1748,12 +5206,36 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\x10SetMemoResources\x12%.memos.api.v1.SetMemoResourcesRequest\x1a\x16.google.protobuf.Empty\"2\xdaA\x04name\x82\xd3\xe4\x93\x02%:\x01*2 /api/v1/{name=memos/*}/resources\x12\x95\x01\n" +
 	"\x11ListMemoResources\x12&.memos.api.v1.ListMemoResourcesRequest\x1a'.memos.api.v1.ListMemoResourcesResponse\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"\x12 /api/v1/{name=memos/*}/resources\x12\x85\x01\n" +
 	"\x10SetMemoRelations\x12%.memos.api.v1.SetMemoRelationsRequest\x1a\x16.google.protobuf.Empty\"2\xdaA\x04name\x82\xd3\xe4\x93\x02%:\x01*2 /api/v1/{name=memos/*}/relations\x12\x95\x01\n" +
-	"\x11ListMemoRelations\x12&.memos.api.v1.ListMemoRelationsRequest\x1a'.memos.api.v1.ListMemoRelationsResponse\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"\x12 /api/v1/{name=memos/*}/relations\x12\x88\x01\n" +
+	"\x11ListMemoRelations\x12&.memos.api.v1.ListMemoRelationsRequest\x1a'.memos.api.v1.ListMemoRelationsResponse\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"\x12 /api/v1/{name=memos/*}/relations\x12g\n" +
+	"\fGetMemoGraph\x12!.memos.api.v1.GetMemoGraphRequest\x1a\x17.memos.api.v1.MemoGraph\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/memos:graph\x12\x95\x01\n" +
+	"\x11ListMemoBacklinks\x12&.memos.api.v1.ListMemoBacklinksRequest\x1a'.memos.api.v1.ListMemoBacklinksResponse\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"\x12 /api/v1/{name=memos/*}/backlinks\x12\x90\x01\n" +
+	"\x10ListRelatedMemos\x12%.memos.api.v1.ListRelatedMemosRequest\x1a&.memos.api.v1.ListRelatedMemosResponse\"-\xdaA\x04name\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/{name=memos/*}/related\x12\x89\x01\n" +
+	"\x12ListDuplicateMemos\x12'.memos.api.v1.ListDuplicateMemosRequest\x1a(.memos.api.v1.ListDuplicateMemosResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/memos:duplicates\x12w\n" +
+	"\n" +
+	"MergeMemos\x12\x1f.memos.api.v1.MergeMemosRequest\x1a\x12.memos.api.v1.Memo\"4\xdaA\n" +
+	"name,memos\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/{name=memos/*}:merge\x12\x88\x01\n" +
 	"\x11CreateMemoComment\x12&.memos.api.v1.CreateMemoCommentRequest\x1a\x12.memos.api.v1.Memo\"7\xdaA\x04name\x82\xd3\xe4\x93\x02*:\acomment\"\x1f/api/v1/{name=memos/*}/comments\x12\x91\x01\n" +
 	"\x10ListMemoComments\x12%.memos.api.v1.ListMemoCommentsRequest\x1a&.memos.api.v1.ListMemoCommentsResponse\".\xdaA\x04name\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/{name=memos/*}/comments\x12\x95\x01\n" +
 	"\x11ListMemoReactions\x12&.memos.api.v1.ListMemoReactionsRequest\x1a'.memos.api.v1.ListMemoReactionsResponse\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"\x12 /api/v1/{name=memos/*}/reactions\x12\x89\x01\n" +
 	"\x12UpsertMemoReaction\x12'.memos.api.v1.UpsertMemoReactionRequest\x1a\x16.memos.api.v1.Reaction\"2\xdaA\x04name\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/{name=memos/*}/reactions\x12\x8c\x01\n" +
-	"\x12DeleteMemoReaction\x12'.memos.api.v1.DeleteMemoReactionRequest\x1a\x16.google.protobuf.Empty\"5\xdaA\vreaction_id\x82\xd3\xe4\x93\x02!*\x1f/api/v1/reactions/{reaction_id}B\xa8\x01\n" +
+	"\x12DeleteMemoReaction\x12'.memos.api.v1.DeleteMemoReactionRequest\x1a\x16.google.protobuf.Empty\"5\xdaA\vreaction_id\x82\xd3\xe4\x93\x02!*\x1f/api/v1/reactions/{reaction_id}\x12\x95\x01\n" +
+	"\x11ListMemoRevisions\x12&.memos.api.v1.ListMemoRevisionsRequest\x1a'.memos.api.v1.ListMemoRevisionsResponse\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"\x12 /api/v1/{name=memos/*}/revisions\x12\xa2\x01\n" +
+	"\x13GetMemoRevisionDiff\x12(.memos.api.v1.GetMemoRevisionDiffRequest\x1a).memos.api.v1.GetMemoRevisionDiffResponse\"6\xdaA\x04name\x82\xd3\xe4\x93\x02)\x12'/api/v1/{name=memos/*/revisions/*}:diff\x12~\n" +
+	"\x10ListTrashedMemos\x12%.memos.api.v1.ListTrashedMemosRequest\x1a&.memos.api.v1.ListTrashedMemosResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/memos:trash\x12u\n" +
+	"\vRestoreMemo\x12 .memos.api.v1.RestoreMemoRequest\x1a\x12.memos.api.v1.Memo\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/{name=memos/*}:restore\x12b\n" +
+	"\n" +
+	"EmptyTrash\x12\x1f.memos.api.v1.EmptyTrashRequest\x1a\x16.google.protobuf.Empty\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/api/v1/memos:trash\x12\x91\x01\n" +
+	"\x13RestoreMemoRevision\x12(.memos.api.v1.RestoreMemoRevisionRequest\x1a\x12.memos.api.v1.Memo\"<\xdaA\x04name\x82\xd3\xe4\x93\x02/:\x01*\"*/api/v1/{name=memos/*/revisions/*}:restore\x12\x87\x01\n" +
+	"\x10BatchUpdateMemos\x12%.memos.api.v1.BatchUpdateMemosRequest\x1a&.memos.api.v1.BatchUpdateMemosResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/memos:batchUpdate\x12\x87\x01\n" +
+	"\x10BatchDeleteMemos\x12%.memos.api.v1.BatchDeleteMemosRequest\x1a&.memos.api.v1.BatchDeleteMemosResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/memos:batchDelete\x12s\n" +
+	"\vImportMemos\x12 .memos.api.v1.ImportMemosRequest\x1a!.memos.api.v1.ImportMemosResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/v1/memos:import\x12\x95\x01\n" +
+	"\x13CreateMemoShareLink\x12(.memos.api.v1.CreateMemoShareLinkRequest\x1a\x1b.memos.api.v1.MemoShareLink\"7\xdaA\x06parent\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/{parent=memos/*}/shareLinks\x12\x9d\x01\n" +
+	"\x12ListMemoShareLinks\x12'.memos.api.v1.ListMemoShareLinksRequest\x1a(.memos.api.v1.ListMemoShareLinksResponse\"4\xdaA\x06parent\x82\xd3\xe4\x93\x02%\x12#/api/v1/{parent=memos/*}/shareLinks\x12\x8b\x01\n" +
+	"\x13RevokeMemoShareLink\x12(.memos.api.v1.RevokeMemoShareLinkRequest\x1a\x16.google.protobuf.Empty\"2\xdaA\x04name\x82\xd3\xe4\x93\x02%*#/api/v1/{name=memos/*/shareLinks/*}\x12\x91\x01\n" +
+	"\x14SetMemoCollaborators\x12).memos.api.v1.SetMemoCollaboratorsRequest\x1a\x16.google.protobuf.Empty\"6\xdaA\x04name\x82\xd3\xe4\x93\x02):\x01*2$/api/v1/{name=memos/*}/collaborators\x12\xa5\x01\n" +
+	"\x15ListMemoCollaborators\x12*.memos.api.v1.ListMemoCollaboratorsRequest\x1a+.memos.api.v1.ListMemoCollaboratorsResponse\"3\xdaA\x04name\x82\xd3\xe4\x93\x02&\x12$/api/v1/{name=memos/*}/collaborators\x12}\n" +
+	"\tListTasks\x12\x1e.memos.api.v1.ListTasksRequest\x1a\x1f.memos.api.v1.ListTasksResponse\"/\xdaA\x06parent\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/{parent=users/*}/tasks\x12\x8c\x01\n" +
+	"\rSetTaskStatus\x12\".memos.api.v1.SetTaskStatusRequest\x1a\x12.memos.api.v1.Memo\"C\xdaA\rname,complete\x82\xd3\xe4\x93\x02-:\x01*\"(/api/v1/{name=memos/*/tasks/*}:setStatusB\xa8\x01\n" +
 	"\x10com.memos.api.v1B\x10MemoServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
 
 var (
@@ -1768,112 +5250,251 @@ func file_api_v1_memo_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_memo_service_proto_rawDescData
 }
 
-var file_api_v1_memo_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_v1_memo_service_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_api_v1_memo_service_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_api_v1_memo_service_proto_msgTypes = make([]protoimpl.MessageInfo, 74)
 var file_api_v1_memo_service_proto_goTypes = []any{
-	(Visibility)(0),                   // 0: memos.api.v1.Visibility
-	(MemoView)(0),                     // 1: memos.api.v1.MemoView
-	(*Memo)(nil),                      // 2: memos.api.v1.Memo
-	(*MemoProperty)(nil),              // 3: memos.api.v1.MemoProperty
-	(*Location)(nil),                  // 4: memos.api.v1.Location
-	(*CreateMemoRequest)(nil),         // 5: memos.api.v1.CreateMemoRequest
-	(*ListMemosRequest)(nil),          // 6: memos.api.v1.ListMemosRequest
-	(*ListMemosResponse)(nil),         // 7: memos.api.v1.ListMemosResponse
-	(*GetMemoRequest)(nil),            // 8: memos.api.v1.GetMemoRequest
-	(*GetMemoByUidRequest)(nil),       // 9: memos.api.v1.GetMemoByUidRequest
-	(*UpdateMemoRequest)(nil),         // 10: memos.api.v1.UpdateMemoRequest
-	(*DeleteMemoRequest)(nil),         // 11: memos.api.v1.DeleteMemoRequest
-	(*RenameMemoTagRequest)(nil),      // 12: memos.api.v1.RenameMemoTagRequest
-	(*DeleteMemoTagRequest)(nil),      // 13: memos.api.v1.DeleteMemoTagRequest
-	(*SetMemoResourcesRequest)(nil),   // 14: memos.api.v1.SetMemoResourcesRequest
-	(*ListMemoResourcesRequest)(nil),  // 15: memos.api.v1.ListMemoResourcesRequest
-	(*ListMemoResourcesResponse)(nil), // 16: memos.api.v1.ListMemoResourcesResponse
-	(*SetMemoRelationsRequest)(nil),   // 17: memos.api.v1.SetMemoRelationsRequest
-	(*ListMemoRelationsRequest)(nil),  // 18: memos.api.v1.ListMemoRelationsRequest
-	(*ListMemoRelationsResponse)(nil), // 19: memos.api.v1.ListMemoRelationsResponse
-	(*CreateMemoCommentRequest)(nil),  // 20: memos.api.v1.CreateMemoCommentRequest
-	(*ListMemoCommentsRequest)(nil),   // 21: memos.api.v1.ListMemoCommentsRequest
-	(*ListMemoCommentsResponse)(nil),  // 22: memos.api.v1.ListMemoCommentsResponse
-	(*ListMemoReactionsRequest)(nil),  // 23: memos.api.v1.ListMemoReactionsRequest
-	(*ListMemoReactionsResponse)(nil), // 24: memos.api.v1.ListMemoReactionsResponse
-	(*UpsertMemoReactionRequest)(nil), // 25: memos.api.v1.UpsertMemoReactionRequest
-	(*DeleteMemoReactionRequest)(nil), // 26: memos.api.v1.DeleteMemoReactionRequest
-	(RowStatus)(0),                    // 27: memos.api.v1.RowStatus
-	(*timestamppb.Timestamp)(nil),     // 28: google.protobuf.Timestamp
-	(*Node)(nil),                      // 29: memos.api.v1.Node
-	(*Resource)(nil),                  // 30: memos.api.v1.Resource
-	(*MemoRelation)(nil),              // 31: memos.api.v1.MemoRelation
-	(*Reaction)(nil),                  // 32: memos.api.v1.Reaction
-	(*fieldmaskpb.FieldMask)(nil),     // 33: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),             // 34: google.protobuf.Empty
+	(Visibility)(0),                       // 0: memos.api.v1.Visibility
+	(MemoView)(0),                         // 1: memos.api.v1.MemoView
+	(ImportMemosRequest_Source)(0),        // 2: memos.api.v1.ImportMemosRequest.Source
+	(MemoGraph_Node_Type)(0),              // 3: memos.api.v1.MemoGraph.Node.Type
+	(MemoGraph_Edge_Type)(0),              // 4: memos.api.v1.MemoGraph.Edge.Type
+	(MemoCollaborator_Role)(0),            // 5: memos.api.v1.MemoCollaborator.Role
+	(*Memo)(nil),                          // 6: memos.api.v1.Memo
+	(*MemoProperty)(nil),                  // 7: memos.api.v1.MemoProperty
+	(*Location)(nil),                      // 8: memos.api.v1.Location
+	(*CreateMemoRequest)(nil),             // 9: memos.api.v1.CreateMemoRequest
+	(*ListMemosRequest)(nil),              // 10: memos.api.v1.ListMemosRequest
+	(*ListMemosResponse)(nil),             // 11: memos.api.v1.ListMemosResponse
+	(*ListMemoLocationsRequest)(nil),      // 12: memos.api.v1.ListMemoLocationsRequest
+	(*ListMemoLocationsResponse)(nil),     // 13: memos.api.v1.ListMemoLocationsResponse
+	(*MemoLocationCluster)(nil),           // 14: memos.api.v1.MemoLocationCluster
+	(*MemoSearchSnippet)(nil),             // 15: memos.api.v1.MemoSearchSnippet
+	(*GetMemoRequest)(nil),                // 16: memos.api.v1.GetMemoRequest
+	(*GetMemoByUidRequest)(nil),           // 17: memos.api.v1.GetMemoByUidRequest
+	(*UpdateMemoRequest)(nil),             // 18: memos.api.v1.UpdateMemoRequest
+	(*DeleteMemoRequest)(nil),             // 19: memos.api.v1.DeleteMemoRequest
+	(*BatchUpdateMemosRequest)(nil),       // 20: memos.api.v1.BatchUpdateMemosRequest
+	(*BatchUpdateMemosResponse)(nil),      // 21: memos.api.v1.BatchUpdateMemosResponse
+	(*BatchDeleteMemosRequest)(nil),       // 22: memos.api.v1.BatchDeleteMemosRequest
+	(*BatchDeleteMemosResponse)(nil),      // 23: memos.api.v1.BatchDeleteMemosResponse
+	(*BatchMemoResult)(nil),               // 24: memos.api.v1.BatchMemoResult
+	(*ImportMemosRequest)(nil),            // 25: memos.api.v1.ImportMemosRequest
+	(*ImportMemosResponse)(nil),           // 26: memos.api.v1.ImportMemosResponse
+	(*ImportMemosResult)(nil),             // 27: memos.api.v1.ImportMemosResult
+	(*ListTrashedMemosRequest)(nil),       // 28: memos.api.v1.ListTrashedMemosRequest
+	(*ListTrashedMemosResponse)(nil),      // 29: memos.api.v1.ListTrashedMemosResponse
+	(*RestoreMemoRequest)(nil),            // 30: memos.api.v1.RestoreMemoRequest
+	(*EmptyTrashRequest)(nil),             // 31: memos.api.v1.EmptyTrashRequest
+	(*RenameMemoTagRequest)(nil),          // 32: memos.api.v1.RenameMemoTagRequest
+	(*DeleteMemoTagRequest)(nil),          // 33: memos.api.v1.DeleteMemoTagRequest
+	(*SetMemoResourcesRequest)(nil),       // 34: memos.api.v1.SetMemoResourcesRequest
+	(*ListMemoResourcesRequest)(nil),      // 35: memos.api.v1.ListMemoResourcesRequest
+	(*ListMemoResourcesResponse)(nil),     // 36: memos.api.v1.ListMemoResourcesResponse
+	(*SetMemoRelationsRequest)(nil),       // 37: memos.api.v1.SetMemoRelationsRequest
+	(*ListMemoRelationsRequest)(nil),      // 38: memos.api.v1.ListMemoRelationsRequest
+	(*ListMemoRelationsResponse)(nil),     // 39: memos.api.v1.ListMemoRelationsResponse
+	(*GetMemoGraphRequest)(nil),           // 40: memos.api.v1.GetMemoGraphRequest
+	(*MemoGraph)(nil),                     // 41: memos.api.v1.MemoGraph
+	(*ListMemoBacklinksRequest)(nil),      // 42: memos.api.v1.ListMemoBacklinksRequest
+	(*ListMemoBacklinksResponse)(nil),     // 43: memos.api.v1.ListMemoBacklinksResponse
+	(*MemoBacklink)(nil),                  // 44: memos.api.v1.MemoBacklink
+	(*ListRelatedMemosRequest)(nil),       // 45: memos.api.v1.ListRelatedMemosRequest
+	(*ListRelatedMemosResponse)(nil),      // 46: memos.api.v1.ListRelatedMemosResponse
+	(*RelatedMemo)(nil),                   // 47: memos.api.v1.RelatedMemo
+	(*ListDuplicateMemosRequest)(nil),     // 48: memos.api.v1.ListDuplicateMemosRequest
+	(*ListDuplicateMemosResponse)(nil),    // 49: memos.api.v1.ListDuplicateMemosResponse
+	(*DuplicateMemoGroup)(nil),            // 50: memos.api.v1.DuplicateMemoGroup
+	(*MergeMemosRequest)(nil),             // 51: memos.api.v1.MergeMemosRequest
+	(*CreateMemoCommentRequest)(nil),      // 52: memos.api.v1.CreateMemoCommentRequest
+	(*ListMemoCommentsRequest)(nil),       // 53: memos.api.v1.ListMemoCommentsRequest
+	(*ListMemoCommentsResponse)(nil),      // 54: memos.api.v1.ListMemoCommentsResponse
+	(*ListMemoReactionsRequest)(nil),      // 55: memos.api.v1.ListMemoReactionsRequest
+	(*ListMemoReactionsResponse)(nil),     // 56: memos.api.v1.ListMemoReactionsResponse
+	(*UpsertMemoReactionRequest)(nil),     // 57: memos.api.v1.UpsertMemoReactionRequest
+	(*DeleteMemoReactionRequest)(nil),     // 58: memos.api.v1.DeleteMemoReactionRequest
+	(*MemoRevision)(nil),                  // 59: memos.api.v1.MemoRevision
+	(*ListMemoRevisionsRequest)(nil),      // 60: memos.api.v1.ListMemoRevisionsRequest
+	(*ListMemoRevisionsResponse)(nil),     // 61: memos.api.v1.ListMemoRevisionsResponse
+	(*GetMemoRevisionDiffRequest)(nil),    // 62: memos.api.v1.GetMemoRevisionDiffRequest
+	(*GetMemoRevisionDiffResponse)(nil),   // 63: memos.api.v1.GetMemoRevisionDiffResponse
+	(*RestoreMemoRevisionRequest)(nil),    // 64: memos.api.v1.RestoreMemoRevisionRequest
+	(*MemoShareLink)(nil),                 // 65: memos.api.v1.MemoShareLink
+	(*CreateMemoShareLinkRequest)(nil),    // 66: memos.api.v1.CreateMemoShareLinkRequest
+	(*ListMemoShareLinksRequest)(nil),     // 67: memos.api.v1.ListMemoShareLinksRequest
+	(*ListMemoShareLinksResponse)(nil),    // 68: memos.api.v1.ListMemoShareLinksResponse
+	(*RevokeMemoShareLinkRequest)(nil),    // 69: memos.api.v1.RevokeMemoShareLinkRequest
+	(*MemoCollaborator)(nil),              // 70: memos.api.v1.MemoCollaborator
+	(*SetMemoCollaboratorsRequest)(nil),   // 71: memos.api.v1.SetMemoCollaboratorsRequest
+	(*ListMemoCollaboratorsRequest)(nil),  // 72: memos.api.v1.ListMemoCollaboratorsRequest
+	(*ListMemoCollaboratorsResponse)(nil), // 73: memos.api.v1.ListMemoCollaboratorsResponse
+	(*Task)(nil),                          // 74: memos.api.v1.Task
+	(*ListTasksRequest)(nil),              // 75: memos.api.v1.ListTasksRequest
+	(*ListTasksResponse)(nil),             // 76: memos.api.v1.ListTasksResponse
+	(*SetTaskStatusRequest)(nil),          // 77: memos.api.v1.SetTaskStatusRequest
+	(*MemoGraph_Node)(nil),                // 78: memos.api.v1.MemoGraph.Node
+	(*MemoGraph_Edge)(nil),                // 79: memos.api.v1.MemoGraph.Edge
+	(RowStatus)(0),                        // 80: memos.api.v1.RowStatus
+	(*timestamppb.Timestamp)(nil),         // 81: google.protobuf.Timestamp
+	(*Node)(nil),                          // 82: memos.api.v1.Node
+	(*Resource)(nil),                      // 83: memos.api.v1.Resource
+	(*MemoRelation)(nil),                  // 84: memos.api.v1.MemoRelation
+	(*Reaction)(nil),                      // 85: memos.api.v1.Reaction
+	(*fieldmaskpb.FieldMask)(nil),         // 86: google.protobuf.FieldMask
+	(*MemoRelation_Memo)(nil),             // 87: memos.api.v1.MemoRelation.Memo
+	(*emptypb.Empty)(nil),                 // 88: google.protobuf.Empty
 }
 var file_api_v1_memo_service_proto_depIdxs = []int32{
-	27, // 0: memos.api.v1.Memo.row_status:type_name -> memos.api.v1.RowStatus
-	28, // 1: memos.api.v1.Memo.create_time:type_name -> google.protobuf.Timestamp
-	28, // 2: memos.api.v1.Memo.update_time:type_name -> google.protobuf.Timestamp
-	29, // 3: memos.api.v1.Memo.nodes:type_name -> memos.api.v1.Node
-	0,  // 4: memos.api.v1.Memo.visibility:type_name -> memos.api.v1.Visibility
-	30, // 5: memos.api.v1.Memo.resources:type_name -> memos.api.v1.Resource
-	31, // 6: memos.api.v1.Memo.relations:type_name -> memos.api.v1.MemoRelation
-	32, // 7: memos.api.v1.Memo.reactions:type_name -> memos.api.v1.Reaction
-	3,  // 8: memos.api.v1.Memo.property:type_name -> memos.api.v1.MemoProperty
-	4,  // 9: memos.api.v1.Memo.location:type_name -> memos.api.v1.Location
-	0,  // 10: memos.api.v1.CreateMemoRequest.visibility:type_name -> memos.api.v1.Visibility
-	30, // 11: memos.api.v1.CreateMemoRequest.resources:type_name -> memos.api.v1.Resource
-	31, // 12: memos.api.v1.CreateMemoRequest.relations:type_name -> memos.api.v1.MemoRelation
-	4,  // 13: memos.api.v1.CreateMemoRequest.location:type_name -> memos.api.v1.Location
-	28, // 14: memos.api.v1.CreateMemoRequest.create_time:type_name -> google.protobuf.Timestamp
-	1,  // 15: memos.api.v1.ListMemosRequest.view:type_name -> memos.api.v1.MemoView
-	2,  // 16: memos.api.v1.ListMemosResponse.memos:type_name -> memos.api.v1.Memo
-	2,  // 17: memos.api.v1.UpdateMemoRequest.memo:type_name -> memos.api.v1.Memo
-	33, // 18: memos.api.v1.UpdateMemoRequest.update_mask:type_name -> google.protobuf.FieldMask
-	30, // 19: memos.api.v1.SetMemoResourcesRequest.resources:type_name -> memos.api.v1.Resource
-	30, // 20: memos.api.v1.ListMemoResourcesResponse.resources:type_name -> memos.api.v1.Resource
-	31, // 21: memos.api.v1.SetMemoRelationsRequest.relations:type_name -> memos.api.v1.MemoRelation
-	31, // 22: memos.api.v1.ListMemoRelationsResponse.relations:type_name -> memos.api.v1.MemoRelation
-	5,  // 23: memos.api.v1.CreateMemoCommentRequest.comment:type_name -> memos.api.v1.CreateMemoRequest
-	2,  // 24: memos.api.v1.ListMemoCommentsResponse.memos:type_name -> memos.api.v1.Memo
-	32, // 25: memos.api.v1.ListMemoReactionsResponse.reactions:type_name -> memos.api.v1.Reaction
-	32, // 26: memos.api.v1.UpsertMemoReactionRequest.reaction:type_name -> memos.api.v1.Reaction
-	5,  // 27: memos.api.v1.MemoService.CreateMemo:input_type -> memos.api.v1.CreateMemoRequest
-	6,  // 28: memos.api.v1.MemoService.ListMemos:input_type -> memos.api.v1.ListMemosRequest
-	8,  // 29: memos.api.v1.MemoService.GetMemo:input_type -> memos.api.v1.GetMemoRequest
-	9,  // 30: memos.api.v1.MemoService.GetMemoByUid:input_type -> memos.api.v1.GetMemoByUidRequest
-	10, // 31: memos.api.v1.MemoService.UpdateMemo:input_type -> memos.api.v1.UpdateMemoRequest
-	11, // 32: memos.api.v1.MemoService.DeleteMemo:input_type -> memos.api.v1.DeleteMemoRequest
-	12, // 33: memos.api.v1.MemoService.RenameMemoTag:input_type -> memos.api.v1.RenameMemoTagRequest
-	13, // 34: memos.api.v1.MemoService.DeleteMemoTag:input_type -> memos.api.v1.DeleteMemoTagRequest
-	14, // 35: memos.api.v1.MemoService.SetMemoResources:input_type -> memos.api.v1.SetMemoResourcesRequest
-	15, // 36: memos.api.v1.MemoService.ListMemoResources:input_type -> memos.api.v1.ListMemoResourcesRequest
-	17, // 37: memos.api.v1.MemoService.SetMemoRelations:input_type -> memos.api.v1.SetMemoRelationsRequest
-	18, // 38: memos.api.v1.MemoService.ListMemoRelations:input_type -> memos.api.v1.ListMemoRelationsRequest
-	20, // 39: memos.api.v1.MemoService.CreateMemoComment:input_type -> memos.api.v1.CreateMemoCommentRequest
-	21, // 40: memos.api.v1.MemoService.ListMemoComments:input_type -> memos.api.v1.ListMemoCommentsRequest
-	23, // 41: memos.api.v1.MemoService.ListMemoReactions:input_type -> memos.api.v1.ListMemoReactionsRequest
-	25, // 42: memos.api.v1.MemoService.UpsertMemoReaction:input_type -> memos.api.v1.UpsertMemoReactionRequest
-	26, // 43: memos.api.v1.MemoService.DeleteMemoReaction:input_type -> memos.api.v1.DeleteMemoReactionRequest
-	2,  // 44: memos.api.v1.MemoService.CreateMemo:output_type -> memos.api.v1.Memo
-	7,  // 45: memos.api.v1.MemoService.ListMemos:output_type -> memos.api.v1.ListMemosResponse
-	2,  // 46: memos.api.v1.MemoService.GetMemo:output_type -> memos.api.v1.Memo
-	2,  // 47: memos.api.v1.MemoService.GetMemoByUid:output_type -> memos.api.v1.Memo
-	2,  // 48: memos.api.v1.MemoService.UpdateMemo:output_type -> memos.api.v1.Memo
-	34, // 49: memos.api.v1.MemoService.DeleteMemo:output_type -> google.protobuf.Empty
-	34, // 50: memos.api.v1.MemoService.RenameMemoTag:output_type -> google.protobuf.Empty
-	34, // 51: memos.api.v1.MemoService.DeleteMemoTag:output_type -> google.protobuf.Empty
-	34, // 52: memos.api.v1.MemoService.SetMemoResources:output_type -> google.protobuf.Empty
-	16, // 53: memos.api.v1.MemoService.ListMemoResources:output_type -> memos.api.v1.ListMemoResourcesResponse
-	34, // 54: memos.api.v1.MemoService.SetMemoRelations:output_type -> google.protobuf.Empty
-	19, // 55: memos.api.v1.MemoService.ListMemoRelations:output_type -> memos.api.v1.ListMemoRelationsResponse
-	2,  // 56: memos.api.v1.MemoService.CreateMemoComment:output_type -> memos.api.v1.Memo
-	22, // 57: memos.api.v1.MemoService.ListMemoComments:output_type -> memos.api.v1.ListMemoCommentsResponse
-	24, // 58: memos.api.v1.MemoService.ListMemoReactions:output_type -> memos.api.v1.ListMemoReactionsResponse
-	32, // 59: memos.api.v1.MemoService.UpsertMemoReaction:output_type -> memos.api.v1.Reaction
-	34, // 60: memos.api.v1.MemoService.DeleteMemoReaction:output_type -> google.protobuf.Empty
-	44, // [44:61] is the sub-list for method output_type
-	27, // [27:44] is the sub-list for method input_type
-	27, // [27:27] is the sub-list for extension type_name
-	27, // [27:27] is the sub-list for extension extendee
-	0,  // [0:27] is the sub-list for field type_name
+	80,  // 0: memos.api.v1.Memo.row_status:type_name -> memos.api.v1.RowStatus
+	81,  // 1: memos.api.v1.Memo.create_time:type_name -> google.protobuf.Timestamp
+	81,  // 2: memos.api.v1.Memo.update_time:type_name -> google.protobuf.Timestamp
+	82,  // 3: memos.api.v1.Memo.nodes:type_name -> memos.api.v1.Node
+	0,   // 4: memos.api.v1.Memo.visibility:type_name -> memos.api.v1.Visibility
+	83,  // 5: memos.api.v1.Memo.resources:type_name -> memos.api.v1.Resource
+	84,  // 6: memos.api.v1.Memo.relations:type_name -> memos.api.v1.MemoRelation
+	85,  // 7: memos.api.v1.Memo.reactions:type_name -> memos.api.v1.Reaction
+	7,   // 8: memos.api.v1.Memo.property:type_name -> memos.api.v1.MemoProperty
+	8,   // 9: memos.api.v1.Memo.location:type_name -> memos.api.v1.Location
+	81,  // 10: memos.api.v1.Memo.trash_time:type_name -> google.protobuf.Timestamp
+	81,  // 11: memos.api.v1.Memo.publish_time:type_name -> google.protobuf.Timestamp
+	0,   // 12: memos.api.v1.Memo.publish_visibility:type_name -> memos.api.v1.Visibility
+	81,  // 13: memos.api.v1.Memo.remind_time:type_name -> google.protobuf.Timestamp
+	0,   // 14: memos.api.v1.CreateMemoRequest.visibility:type_name -> memos.api.v1.Visibility
+	83,  // 15: memos.api.v1.CreateMemoRequest.resources:type_name -> memos.api.v1.Resource
+	84,  // 16: memos.api.v1.CreateMemoRequest.relations:type_name -> memos.api.v1.MemoRelation
+	8,   // 17: memos.api.v1.CreateMemoRequest.location:type_name -> memos.api.v1.Location
+	81,  // 18: memos.api.v1.CreateMemoRequest.create_time:type_name -> google.protobuf.Timestamp
+	81,  // 19: memos.api.v1.CreateMemoRequest.publish_time:type_name -> google.protobuf.Timestamp
+	81,  // 20: memos.api.v1.CreateMemoRequest.remind_time:type_name -> google.protobuf.Timestamp
+	1,   // 21: memos.api.v1.ListMemosRequest.view:type_name -> memos.api.v1.MemoView
+	6,   // 22: memos.api.v1.ListMemosResponse.memos:type_name -> memos.api.v1.Memo
+	15,  // 23: memos.api.v1.ListMemosResponse.search_snippets:type_name -> memos.api.v1.MemoSearchSnippet
+	14,  // 24: memos.api.v1.ListMemoLocationsResponse.clusters:type_name -> memos.api.v1.MemoLocationCluster
+	6,   // 25: memos.api.v1.UpdateMemoRequest.memo:type_name -> memos.api.v1.Memo
+	86,  // 26: memos.api.v1.UpdateMemoRequest.update_mask:type_name -> google.protobuf.FieldMask
+	6,   // 27: memos.api.v1.BatchUpdateMemosRequest.memo:type_name -> memos.api.v1.Memo
+	86,  // 28: memos.api.v1.BatchUpdateMemosRequest.update_mask:type_name -> google.protobuf.FieldMask
+	24,  // 29: memos.api.v1.BatchUpdateMemosResponse.results:type_name -> memos.api.v1.BatchMemoResult
+	24,  // 30: memos.api.v1.BatchDeleteMemosResponse.results:type_name -> memos.api.v1.BatchMemoResult
+	2,   // 31: memos.api.v1.ImportMemosRequest.source:type_name -> memos.api.v1.ImportMemosRequest.Source
+	27,  // 32: memos.api.v1.ImportMemosResponse.results:type_name -> memos.api.v1.ImportMemosResult
+	81,  // 33: memos.api.v1.ImportMemosResult.create_time:type_name -> google.protobuf.Timestamp
+	6,   // 34: memos.api.v1.ListTrashedMemosResponse.memos:type_name -> memos.api.v1.Memo
+	83,  // 35: memos.api.v1.SetMemoResourcesRequest.resources:type_name -> memos.api.v1.Resource
+	83,  // 36: memos.api.v1.ListMemoResourcesResponse.resources:type_name -> memos.api.v1.Resource
+	84,  // 37: memos.api.v1.SetMemoRelationsRequest.relations:type_name -> memos.api.v1.MemoRelation
+	84,  // 38: memos.api.v1.ListMemoRelationsResponse.relations:type_name -> memos.api.v1.MemoRelation
+	78,  // 39: memos.api.v1.MemoGraph.nodes:type_name -> memos.api.v1.MemoGraph.Node
+	79,  // 40: memos.api.v1.MemoGraph.edges:type_name -> memos.api.v1.MemoGraph.Edge
+	44,  // 41: memos.api.v1.ListMemoBacklinksResponse.backlinks:type_name -> memos.api.v1.MemoBacklink
+	87,  // 42: memos.api.v1.MemoBacklink.memo:type_name -> memos.api.v1.MemoRelation.Memo
+	47,  // 43: memos.api.v1.ListRelatedMemosResponse.related_memos:type_name -> memos.api.v1.RelatedMemo
+	87,  // 44: memos.api.v1.RelatedMemo.memo:type_name -> memos.api.v1.MemoRelation.Memo
+	50,  // 45: memos.api.v1.ListDuplicateMemosResponse.groups:type_name -> memos.api.v1.DuplicateMemoGroup
+	87,  // 46: memos.api.v1.DuplicateMemoGroup.memos:type_name -> memos.api.v1.MemoRelation.Memo
+	9,   // 47: memos.api.v1.CreateMemoCommentRequest.comment:type_name -> memos.api.v1.CreateMemoRequest
+	6,   // 48: memos.api.v1.ListMemoCommentsResponse.memos:type_name -> memos.api.v1.Memo
+	85,  // 49: memos.api.v1.ListMemoReactionsResponse.reactions:type_name -> memos.api.v1.Reaction
+	85,  // 50: memos.api.v1.UpsertMemoReactionRequest.reaction:type_name -> memos.api.v1.Reaction
+	81,  // 51: memos.api.v1.MemoRevision.create_time:type_name -> google.protobuf.Timestamp
+	0,   // 52: memos.api.v1.MemoRevision.visibility:type_name -> memos.api.v1.Visibility
+	59,  // 53: memos.api.v1.ListMemoRevisionsResponse.revisions:type_name -> memos.api.v1.MemoRevision
+	0,   // 54: memos.api.v1.GetMemoRevisionDiffResponse.old_visibility:type_name -> memos.api.v1.Visibility
+	0,   // 55: memos.api.v1.GetMemoRevisionDiffResponse.new_visibility:type_name -> memos.api.v1.Visibility
+	81,  // 56: memos.api.v1.MemoShareLink.create_time:type_name -> google.protobuf.Timestamp
+	81,  // 57: memos.api.v1.MemoShareLink.expire_time:type_name -> google.protobuf.Timestamp
+	81,  // 58: memos.api.v1.MemoShareLink.last_access_time:type_name -> google.protobuf.Timestamp
+	81,  // 59: memos.api.v1.CreateMemoShareLinkRequest.expire_time:type_name -> google.protobuf.Timestamp
+	65,  // 60: memos.api.v1.ListMemoShareLinksResponse.share_links:type_name -> memos.api.v1.MemoShareLink
+	5,   // 61: memos.api.v1.MemoCollaborator.role:type_name -> memos.api.v1.MemoCollaborator.Role
+	81,  // 62: memos.api.v1.MemoCollaborator.create_time:type_name -> google.protobuf.Timestamp
+	70,  // 63: memos.api.v1.SetMemoCollaboratorsRequest.collaborators:type_name -> memos.api.v1.MemoCollaborator
+	70,  // 64: memos.api.v1.ListMemoCollaboratorsResponse.collaborators:type_name -> memos.api.v1.MemoCollaborator
+	74,  // 65: memos.api.v1.ListTasksResponse.tasks:type_name -> memos.api.v1.Task
+	3,   // 66: memos.api.v1.MemoGraph.Node.type:type_name -> memos.api.v1.MemoGraph.Node.Type
+	4,   // 67: memos.api.v1.MemoGraph.Edge.type:type_name -> memos.api.v1.MemoGraph.Edge.Type
+	9,   // 68: memos.api.v1.MemoService.CreateMemo:input_type -> memos.api.v1.CreateMemoRequest
+	10,  // 69: memos.api.v1.MemoService.ListMemos:input_type -> memos.api.v1.ListMemosRequest
+	12,  // 70: memos.api.v1.MemoService.ListMemoLocations:input_type -> memos.api.v1.ListMemoLocationsRequest
+	16,  // 71: memos.api.v1.MemoService.GetMemo:input_type -> memos.api.v1.GetMemoRequest
+	17,  // 72: memos.api.v1.MemoService.GetMemoByUid:input_type -> memos.api.v1.GetMemoByUidRequest
+	18,  // 73: memos.api.v1.MemoService.UpdateMemo:input_type -> memos.api.v1.UpdateMemoRequest
+	19,  // 74: memos.api.v1.MemoService.DeleteMemo:input_type -> memos.api.v1.DeleteMemoRequest
+	32,  // 75: memos.api.v1.MemoService.RenameMemoTag:input_type -> memos.api.v1.RenameMemoTagRequest
+	33,  // 76: memos.api.v1.MemoService.DeleteMemoTag:input_type -> memos.api.v1.DeleteMemoTagRequest
+	34,  // 77: memos.api.v1.MemoService.SetMemoResources:input_type -> memos.api.v1.SetMemoResourcesRequest
+	35,  // 78: memos.api.v1.MemoService.ListMemoResources:input_type -> memos.api.v1.ListMemoResourcesRequest
+	37,  // 79: memos.api.v1.MemoService.SetMemoRelations:input_type -> memos.api.v1.SetMemoRelationsRequest
+	38,  // 80: memos.api.v1.MemoService.ListMemoRelations:input_type -> memos.api.v1.ListMemoRelationsRequest
+	40,  // 81: memos.api.v1.MemoService.GetMemoGraph:input_type -> memos.api.v1.GetMemoGraphRequest
+	42,  // 82: memos.api.v1.MemoService.ListMemoBacklinks:input_type -> memos.api.v1.ListMemoBacklinksRequest
+	45,  // 83: memos.api.v1.MemoService.ListRelatedMemos:input_type -> memos.api.v1.ListRelatedMemosRequest
+	48,  // 84: memos.api.v1.MemoService.ListDuplicateMemos:input_type -> memos.api.v1.ListDuplicateMemosRequest
+	51,  // 85: memos.api.v1.MemoService.MergeMemos:input_type -> memos.api.v1.MergeMemosRequest
+	52,  // 86: memos.api.v1.MemoService.CreateMemoComment:input_type -> memos.api.v1.CreateMemoCommentRequest
+	53,  // 87: memos.api.v1.MemoService.ListMemoComments:input_type -> memos.api.v1.ListMemoCommentsRequest
+	55,  // 88: memos.api.v1.MemoService.ListMemoReactions:input_type -> memos.api.v1.ListMemoReactionsRequest
+	57,  // 89: memos.api.v1.MemoService.UpsertMemoReaction:input_type -> memos.api.v1.UpsertMemoReactionRequest
+	58,  // 90: memos.api.v1.MemoService.DeleteMemoReaction:input_type -> memos.api.v1.DeleteMemoReactionRequest
+	60,  // 91: memos.api.v1.MemoService.ListMemoRevisions:input_type -> memos.api.v1.ListMemoRevisionsRequest
+	62,  // 92: memos.api.v1.MemoService.GetMemoRevisionDiff:input_type -> memos.api.v1.GetMemoRevisionDiffRequest
+	28,  // 93: memos.api.v1.MemoService.ListTrashedMemos:input_type -> memos.api.v1.ListTrashedMemosRequest
+	30,  // 94: memos.api.v1.MemoService.RestoreMemo:input_type -> memos.api.v1.RestoreMemoRequest
+	31,  // 95: memos.api.v1.MemoService.EmptyTrash:input_type -> memos.api.v1.EmptyTrashRequest
+	64,  // 96: memos.api.v1.MemoService.RestoreMemoRevision:input_type -> memos.api.v1.RestoreMemoRevisionRequest
+	20,  // 97: memos.api.v1.MemoService.BatchUpdateMemos:input_type -> memos.api.v1.BatchUpdateMemosRequest
+	22,  // 98: memos.api.v1.MemoService.BatchDeleteMemos:input_type -> memos.api.v1.BatchDeleteMemosRequest
+	25,  // 99: memos.api.v1.MemoService.ImportMemos:input_type -> memos.api.v1.ImportMemosRequest
+	66,  // 100: memos.api.v1.MemoService.CreateMemoShareLink:input_type -> memos.api.v1.CreateMemoShareLinkRequest
+	67,  // 101: memos.api.v1.MemoService.ListMemoShareLinks:input_type -> memos.api.v1.ListMemoShareLinksRequest
+	69,  // 102: memos.api.v1.MemoService.RevokeMemoShareLink:input_type -> memos.api.v1.RevokeMemoShareLinkRequest
+	71,  // 103: memos.api.v1.MemoService.SetMemoCollaborators:input_type -> memos.api.v1.SetMemoCollaboratorsRequest
+	72,  // 104: memos.api.v1.MemoService.ListMemoCollaborators:input_type -> memos.api.v1.ListMemoCollaboratorsRequest
+	75,  // 105: memos.api.v1.MemoService.ListTasks:input_type -> memos.api.v1.ListTasksRequest
+	77,  // 106: memos.api.v1.MemoService.SetTaskStatus:input_type -> memos.api.v1.SetTaskStatusRequest
+	6,   // 107: memos.api.v1.MemoService.CreateMemo:output_type -> memos.api.v1.Memo
+	11,  // 108: memos.api.v1.MemoService.ListMemos:output_type -> memos.api.v1.ListMemosResponse
+	13,  // 109: memos.api.v1.MemoService.ListMemoLocations:output_type -> memos.api.v1.ListMemoLocationsResponse
+	6,   // 110: memos.api.v1.MemoService.GetMemo:output_type -> memos.api.v1.Memo
+	6,   // 111: memos.api.v1.MemoService.GetMemoByUid:output_type -> memos.api.v1.Memo
+	6,   // 112: memos.api.v1.MemoService.UpdateMemo:output_type -> memos.api.v1.Memo
+	88,  // 113: memos.api.v1.MemoService.DeleteMemo:output_type -> google.protobuf.Empty
+	88,  // 114: memos.api.v1.MemoService.RenameMemoTag:output_type -> google.protobuf.Empty
+	88,  // 115: memos.api.v1.MemoService.DeleteMemoTag:output_type -> google.protobuf.Empty
+	88,  // 116: memos.api.v1.MemoService.SetMemoResources:output_type -> google.protobuf.Empty
+	36,  // 117: memos.api.v1.MemoService.ListMemoResources:output_type -> memos.api.v1.ListMemoResourcesResponse
+	88,  // 118: memos.api.v1.MemoService.SetMemoRelations:output_type -> google.protobuf.Empty
+	39,  // 119: memos.api.v1.MemoService.ListMemoRelations:output_type -> memos.api.v1.ListMemoRelationsResponse
+	41,  // 120: memos.api.v1.MemoService.GetMemoGraph:output_type -> memos.api.v1.MemoGraph
+	43,  // 121: memos.api.v1.MemoService.ListMemoBacklinks:output_type -> memos.api.v1.ListMemoBacklinksResponse
+	46,  // 122: memos.api.v1.MemoService.ListRelatedMemos:output_type -> memos.api.v1.ListRelatedMemosResponse
+	49,  // 123: memos.api.v1.MemoService.ListDuplicateMemos:output_type -> memos.api.v1.ListDuplicateMemosResponse
+	6,   // 124: memos.api.v1.MemoService.MergeMemos:output_type -> memos.api.v1.Memo
+	6,   // 125: memos.api.v1.MemoService.CreateMemoComment:output_type -> memos.api.v1.Memo
+	54,  // 126: memos.api.v1.MemoService.ListMemoComments:output_type -> memos.api.v1.ListMemoCommentsResponse
+	56,  // 127: memos.api.v1.MemoService.ListMemoReactions:output_type -> memos.api.v1.ListMemoReactionsResponse
+	85,  // 128: memos.api.v1.MemoService.UpsertMemoReaction:output_type -> memos.api.v1.Reaction
+	88,  // 129: memos.api.v1.MemoService.DeleteMemoReaction:output_type -> google.protobuf.Empty
+	61,  // 130: memos.api.v1.MemoService.ListMemoRevisions:output_type -> memos.api.v1.ListMemoRevisionsResponse
+	63,  // 131: memos.api.v1.MemoService.GetMemoRevisionDiff:output_type -> memos.api.v1.GetMemoRevisionDiffResponse
+	29,  // 132: memos.api.v1.MemoService.ListTrashedMemos:output_type -> memos.api.v1.ListTrashedMemosResponse
+	6,   // 133: memos.api.v1.MemoService.RestoreMemo:output_type -> memos.api.v1.Memo
+	88,  // 134: memos.api.v1.MemoService.EmptyTrash:output_type -> google.protobuf.Empty
+	6,   // 135: memos.api.v1.MemoService.RestoreMemoRevision:output_type -> memos.api.v1.Memo
+	21,  // 136: memos.api.v1.MemoService.BatchUpdateMemos:output_type -> memos.api.v1.BatchUpdateMemosResponse
+	23,  // 137: memos.api.v1.MemoService.BatchDeleteMemos:output_type -> memos.api.v1.BatchDeleteMemosResponse
+	26,  // 138: memos.api.v1.MemoService.ImportMemos:output_type -> memos.api.v1.ImportMemosResponse
+	65,  // 139: memos.api.v1.MemoService.CreateMemoShareLink:output_type -> memos.api.v1.MemoShareLink
+	68,  // 140: memos.api.v1.MemoService.ListMemoShareLinks:output_type -> memos.api.v1.ListMemoShareLinksResponse
+	88,  // 141: memos.api.v1.MemoService.RevokeMemoShareLink:output_type -> google.protobuf.Empty
+	88,  // 142: memos.api.v1.MemoService.SetMemoCollaborators:output_type -> google.protobuf.Empty
+	73,  // 143: memos.api.v1.MemoService.ListMemoCollaborators:output_type -> memos.api.v1.ListMemoCollaboratorsResponse
+	76,  // 144: memos.api.v1.MemoService.ListTasks:output_type -> memos.api.v1.ListTasksResponse
+	6,   // 145: memos.api.v1.MemoService.SetTaskStatus:output_type -> memos.api.v1.Memo
+	107, // [107:146] is the sub-list for method output_type
+	68,  // [68:107] is the sub-list for method input_type
+	68,  // [68:68] is the sub-list for extension type_name
+	68,  // [68:68] is the sub-list for extension extendee
+	0,   // [0:68] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_memo_service_proto_init() }
@@ -1893,8 +5514,8 @@ func file_api_v1_memo_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_memo_service_proto_rawDesc), len(file_api_v1_memo_service_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   25,
+			NumEnums:      6,
+			NumMessages:   74,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
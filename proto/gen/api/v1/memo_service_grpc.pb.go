@@ -20,23 +20,45 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MemoService_CreateMemo_FullMethodName         = "/memos.api.v1.MemoService/CreateMemo"
-	MemoService_ListMemos_FullMethodName          = "/memos.api.v1.MemoService/ListMemos"
-	MemoService_GetMemo_FullMethodName            = "/memos.api.v1.MemoService/GetMemo"
-	MemoService_GetMemoByUid_FullMethodName       = "/memos.api.v1.MemoService/GetMemoByUid"
-	MemoService_UpdateMemo_FullMethodName         = "/memos.api.v1.MemoService/UpdateMemo"
-	MemoService_DeleteMemo_FullMethodName         = "/memos.api.v1.MemoService/DeleteMemo"
-	MemoService_RenameMemoTag_FullMethodName      = "/memos.api.v1.MemoService/RenameMemoTag"
-	MemoService_DeleteMemoTag_FullMethodName      = "/memos.api.v1.MemoService/DeleteMemoTag"
-	MemoService_SetMemoResources_FullMethodName   = "/memos.api.v1.MemoService/SetMemoResources"
-	MemoService_ListMemoResources_FullMethodName  = "/memos.api.v1.MemoService/ListMemoResources"
-	MemoService_SetMemoRelations_FullMethodName   = "/memos.api.v1.MemoService/SetMemoRelations"
-	MemoService_ListMemoRelations_FullMethodName  = "/memos.api.v1.MemoService/ListMemoRelations"
-	MemoService_CreateMemoComment_FullMethodName  = "/memos.api.v1.MemoService/CreateMemoComment"
-	MemoService_ListMemoComments_FullMethodName   = "/memos.api.v1.MemoService/ListMemoComments"
-	MemoService_ListMemoReactions_FullMethodName  = "/memos.api.v1.MemoService/ListMemoReactions"
-	MemoService_UpsertMemoReaction_FullMethodName = "/memos.api.v1.MemoService/UpsertMemoReaction"
-	MemoService_DeleteMemoReaction_FullMethodName = "/memos.api.v1.MemoService/DeleteMemoReaction"
+	MemoService_CreateMemo_FullMethodName            = "/memos.api.v1.MemoService/CreateMemo"
+	MemoService_ListMemos_FullMethodName             = "/memos.api.v1.MemoService/ListMemos"
+	MemoService_ListMemoLocations_FullMethodName     = "/memos.api.v1.MemoService/ListMemoLocations"
+	MemoService_GetMemo_FullMethodName               = "/memos.api.v1.MemoService/GetMemo"
+	MemoService_GetMemoByUid_FullMethodName          = "/memos.api.v1.MemoService/GetMemoByUid"
+	MemoService_UpdateMemo_FullMethodName            = "/memos.api.v1.MemoService/UpdateMemo"
+	MemoService_DeleteMemo_FullMethodName            = "/memos.api.v1.MemoService/DeleteMemo"
+	MemoService_RenameMemoTag_FullMethodName         = "/memos.api.v1.MemoService/RenameMemoTag"
+	MemoService_DeleteMemoTag_FullMethodName         = "/memos.api.v1.MemoService/DeleteMemoTag"
+	MemoService_SetMemoResources_FullMethodName      = "/memos.api.v1.MemoService/SetMemoResources"
+	MemoService_ListMemoResources_FullMethodName     = "/memos.api.v1.MemoService/ListMemoResources"
+	MemoService_SetMemoRelations_FullMethodName      = "/memos.api.v1.MemoService/SetMemoRelations"
+	MemoService_ListMemoRelations_FullMethodName     = "/memos.api.v1.MemoService/ListMemoRelations"
+	MemoService_GetMemoGraph_FullMethodName          = "/memos.api.v1.MemoService/GetMemoGraph"
+	MemoService_ListMemoBacklinks_FullMethodName     = "/memos.api.v1.MemoService/ListMemoBacklinks"
+	MemoService_ListRelatedMemos_FullMethodName      = "/memos.api.v1.MemoService/ListRelatedMemos"
+	MemoService_ListDuplicateMemos_FullMethodName    = "/memos.api.v1.MemoService/ListDuplicateMemos"
+	MemoService_MergeMemos_FullMethodName            = "/memos.api.v1.MemoService/MergeMemos"
+	MemoService_CreateMemoComment_FullMethodName     = "/memos.api.v1.MemoService/CreateMemoComment"
+	MemoService_ListMemoComments_FullMethodName      = "/memos.api.v1.MemoService/ListMemoComments"
+	MemoService_ListMemoReactions_FullMethodName     = "/memos.api.v1.MemoService/ListMemoReactions"
+	MemoService_UpsertMemoReaction_FullMethodName    = "/memos.api.v1.MemoService/UpsertMemoReaction"
+	MemoService_DeleteMemoReaction_FullMethodName    = "/memos.api.v1.MemoService/DeleteMemoReaction"
+	MemoService_ListMemoRevisions_FullMethodName     = "/memos.api.v1.MemoService/ListMemoRevisions"
+	MemoService_GetMemoRevisionDiff_FullMethodName   = "/memos.api.v1.MemoService/GetMemoRevisionDiff"
+	MemoService_ListTrashedMemos_FullMethodName      = "/memos.api.v1.MemoService/ListTrashedMemos"
+	MemoService_RestoreMemo_FullMethodName           = "/memos.api.v1.MemoService/RestoreMemo"
+	MemoService_EmptyTrash_FullMethodName            = "/memos.api.v1.MemoService/EmptyTrash"
+	MemoService_RestoreMemoRevision_FullMethodName   = "/memos.api.v1.MemoService/RestoreMemoRevision"
+	MemoService_BatchUpdateMemos_FullMethodName      = "/memos.api.v1.MemoService/BatchUpdateMemos"
+	MemoService_BatchDeleteMemos_FullMethodName      = "/memos.api.v1.MemoService/BatchDeleteMemos"
+	MemoService_ImportMemos_FullMethodName           = "/memos.api.v1.MemoService/ImportMemos"
+	MemoService_CreateMemoShareLink_FullMethodName   = "/memos.api.v1.MemoService/CreateMemoShareLink"
+	MemoService_ListMemoShareLinks_FullMethodName    = "/memos.api.v1.MemoService/ListMemoShareLinks"
+	MemoService_RevokeMemoShareLink_FullMethodName   = "/memos.api.v1.MemoService/RevokeMemoShareLink"
+	MemoService_SetMemoCollaborators_FullMethodName  = "/memos.api.v1.MemoService/SetMemoCollaborators"
+	MemoService_ListMemoCollaborators_FullMethodName = "/memos.api.v1.MemoService/ListMemoCollaborators"
+	MemoService_ListTasks_FullMethodName             = "/memos.api.v1.MemoService/ListTasks"
+	MemoService_SetTaskStatus_FullMethodName         = "/memos.api.v1.MemoService/SetTaskStatus"
 )
 
 // MemoServiceClient is the client API for MemoService service.
@@ -47,9 +69,11 @@ type MemoServiceClient interface {
 	CreateMemo(ctx context.Context, in *CreateMemoRequest, opts ...grpc.CallOption) (*Memo, error)
 	// ListMemos lists memos with pagination and filter.
 	ListMemos(ctx context.Context, in *ListMemosRequest, opts ...grpc.CallOption) (*ListMemosResponse, error)
+	// ListMemoLocations lists the locations of memos clustered on a grid for map views.
+	ListMemoLocations(ctx context.Context, in *ListMemoLocationsRequest, opts ...grpc.CallOption) (*ListMemoLocationsResponse, error)
 	// GetMemo gets a memo.
 	GetMemo(ctx context.Context, in *GetMemoRequest, opts ...grpc.CallOption) (*Memo, error)
-	// GetMemoByUid gets a memo by uid
+	// GetMemoByUid gets a memo by uid, or by one of the previous uids of the memo.
 	GetMemoByUid(ctx context.Context, in *GetMemoByUidRequest, opts ...grpc.CallOption) (*Memo, error)
 	// UpdateMemo updates a memo.
 	UpdateMemo(ctx context.Context, in *UpdateMemoRequest, opts ...grpc.CallOption) (*Memo, error)
@@ -67,6 +91,17 @@ type MemoServiceClient interface {
 	SetMemoRelations(ctx context.Context, in *SetMemoRelationsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// ListMemoRelations lists relations for a memo.
 	ListMemoRelations(ctx context.Context, in *ListMemoRelationsRequest, opts ...grpc.CallOption) (*ListMemoRelationsResponse, error)
+	// GetMemoGraph gets the graph of the memos of the current user, or of the public memos if not signed in,
+	// linked by their relations and their tags.
+	GetMemoGraph(ctx context.Context, in *GetMemoGraphRequest, opts ...grpc.CallOption) (*MemoGraph, error)
+	// ListMemoBacklinks lists the memos referencing a memo.
+	ListMemoBacklinks(ctx context.Context, in *ListMemoBacklinksRequest, opts ...grpc.CallOption) (*ListMemoBacklinksResponse, error)
+	// ListRelatedMemos lists the memos most similar to a memo by the terms of their content.
+	ListRelatedMemos(ctx context.Context, in *ListRelatedMemosRequest, opts ...grpc.CallOption) (*ListRelatedMemosResponse, error)
+	// ListDuplicateMemos lists the groups of likely duplicates among the memos of the current user.
+	ListDuplicateMemos(ctx context.Context, in *ListDuplicateMemosRequest, opts ...grpc.CallOption) (*ListDuplicateMemosResponse, error)
+	// MergeMemos merges memos into a memo and archives them.
+	MergeMemos(ctx context.Context, in *MergeMemosRequest, opts ...grpc.CallOption) (*Memo, error)
 	// CreateMemoComment creates a comment for a memo.
 	CreateMemoComment(ctx context.Context, in *CreateMemoCommentRequest, opts ...grpc.CallOption) (*Memo, error)
 	// ListMemoComments lists comments for a memo.
@@ -77,6 +112,38 @@ type MemoServiceClient interface {
 	UpsertMemoReaction(ctx context.Context, in *UpsertMemoReactionRequest, opts ...grpc.CallOption) (*Reaction, error)
 	// DeleteMemoReaction deletes a reaction for a memo.
 	DeleteMemoReaction(ctx context.Context, in *DeleteMemoReactionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListMemoRevisions lists the revisions of a memo.
+	ListMemoRevisions(ctx context.Context, in *ListMemoRevisionsRequest, opts ...grpc.CallOption) (*ListMemoRevisionsResponse, error)
+	// GetMemoRevisionDiff gets the diff between a memo revision and the current memo.
+	GetMemoRevisionDiff(ctx context.Context, in *GetMemoRevisionDiffRequest, opts ...grpc.CallOption) (*GetMemoRevisionDiffResponse, error)
+	// ListTrashedMemos lists the memos in the trash of the current user.
+	ListTrashedMemos(ctx context.Context, in *ListTrashedMemosRequest, opts ...grpc.CallOption) (*ListTrashedMemosResponse, error)
+	// RestoreMemo restores a memo from the trash.
+	RestoreMemo(ctx context.Context, in *RestoreMemoRequest, opts ...grpc.CallOption) (*Memo, error)
+	// EmptyTrash permanently deletes all memos in the trash of the current user.
+	EmptyTrash(ctx context.Context, in *EmptyTrashRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// RestoreMemoRevision restores a memo to the given revision.
+	RestoreMemoRevision(ctx context.Context, in *RestoreMemoRevisionRequest, opts ...grpc.CallOption) (*Memo, error)
+	// BatchUpdateMemos updates the memos selected by names or filter in one transaction.
+	BatchUpdateMemos(ctx context.Context, in *BatchUpdateMemosRequest, opts ...grpc.CallOption) (*BatchUpdateMemosResponse, error)
+	// BatchDeleteMemos deletes the memos selected by names or filter.
+	BatchDeleteMemos(ctx context.Context, in *BatchDeleteMemosRequest, opts ...grpc.CallOption) (*BatchDeleteMemosResponse, error)
+	// ImportMemos imports the notes of a Markdown archive or another note app as memos of the current user.
+	ImportMemos(ctx context.Context, in *ImportMemosRequest, opts ...grpc.CallOption) (*ImportMemosResponse, error)
+	// CreateMemoShareLink creates a link granting read-only access to a memo.
+	CreateMemoShareLink(ctx context.Context, in *CreateMemoShareLinkRequest, opts ...grpc.CallOption) (*MemoShareLink, error)
+	// ListMemoShareLinks lists the share links of a memo.
+	ListMemoShareLinks(ctx context.Context, in *ListMemoShareLinksRequest, opts ...grpc.CallOption) (*ListMemoShareLinksResponse, error)
+	// RevokeMemoShareLink revokes a share link of a memo.
+	RevokeMemoShareLink(ctx context.Context, in *RevokeMemoShareLinkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// SetMemoCollaborators sets the collaborators of a memo.
+	SetMemoCollaborators(ctx context.Context, in *SetMemoCollaboratorsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListMemoCollaborators lists the collaborators of a memo.
+	ListMemoCollaborators(ctx context.Context, in *ListMemoCollaboratorsRequest, opts ...grpc.CallOption) (*ListMemoCollaboratorsResponse, error)
+	// ListTasks lists the items of the task lists in the memos of a user.
+	ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error)
+	// SetTaskStatus checks or unchecks an item of a task list by updating the content of its memo.
+	SetTaskStatus(ctx context.Context, in *SetTaskStatusRequest, opts ...grpc.CallOption) (*Memo, error)
 }
 
 type memoServiceClient struct {
@@ -107,6 +174,16 @@ func (c *memoServiceClient) ListMemos(ctx context.Context, in *ListMemosRequest,
 	return out, nil
 }
 
+func (c *memoServiceClient) ListMemoLocations(ctx context.Context, in *ListMemoLocationsRequest, opts ...grpc.CallOption) (*ListMemoLocationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemoLocationsResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListMemoLocations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *memoServiceClient) GetMemo(ctx context.Context, in *GetMemoRequest, opts ...grpc.CallOption) (*Memo, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Memo)
@@ -207,6 +284,56 @@ func (c *memoServiceClient) ListMemoRelations(ctx context.Context, in *ListMemoR
 	return out, nil
 }
 
+func (c *memoServiceClient) GetMemoGraph(ctx context.Context, in *GetMemoGraphRequest, opts ...grpc.CallOption) (*MemoGraph, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoGraph)
+	err := c.cc.Invoke(ctx, MemoService_GetMemoGraph_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ListMemoBacklinks(ctx context.Context, in *ListMemoBacklinksRequest, opts ...grpc.CallOption) (*ListMemoBacklinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemoBacklinksResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListMemoBacklinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ListRelatedMemos(ctx context.Context, in *ListRelatedMemosRequest, opts ...grpc.CallOption) (*ListRelatedMemosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRelatedMemosResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListRelatedMemos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ListDuplicateMemos(ctx context.Context, in *ListDuplicateMemosRequest, opts ...grpc.CallOption) (*ListDuplicateMemosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDuplicateMemosResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListDuplicateMemos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) MergeMemos(ctx context.Context, in *MergeMemosRequest, opts ...grpc.CallOption) (*Memo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Memo)
+	err := c.cc.Invoke(ctx, MemoService_MergeMemos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *memoServiceClient) CreateMemoComment(ctx context.Context, in *CreateMemoCommentRequest, opts ...grpc.CallOption) (*Memo, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Memo)
@@ -257,6 +384,166 @@ func (c *memoServiceClient) DeleteMemoReaction(ctx context.Context, in *DeleteMe
 	return out, nil
 }
 
+func (c *memoServiceClient) ListMemoRevisions(ctx context.Context, in *ListMemoRevisionsRequest, opts ...grpc.CallOption) (*ListMemoRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemoRevisionsResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListMemoRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) GetMemoRevisionDiff(ctx context.Context, in *GetMemoRevisionDiffRequest, opts ...grpc.CallOption) (*GetMemoRevisionDiffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMemoRevisionDiffResponse)
+	err := c.cc.Invoke(ctx, MemoService_GetMemoRevisionDiff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ListTrashedMemos(ctx context.Context, in *ListTrashedMemosRequest, opts ...grpc.CallOption) (*ListTrashedMemosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrashedMemosResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListTrashedMemos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) RestoreMemo(ctx context.Context, in *RestoreMemoRequest, opts ...grpc.CallOption) (*Memo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Memo)
+	err := c.cc.Invoke(ctx, MemoService_RestoreMemo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) EmptyTrash(ctx context.Context, in *EmptyTrashRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MemoService_EmptyTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) RestoreMemoRevision(ctx context.Context, in *RestoreMemoRevisionRequest, opts ...grpc.CallOption) (*Memo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Memo)
+	err := c.cc.Invoke(ctx, MemoService_RestoreMemoRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) BatchUpdateMemos(ctx context.Context, in *BatchUpdateMemosRequest, opts ...grpc.CallOption) (*BatchUpdateMemosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchUpdateMemosResponse)
+	err := c.cc.Invoke(ctx, MemoService_BatchUpdateMemos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) BatchDeleteMemos(ctx context.Context, in *BatchDeleteMemosRequest, opts ...grpc.CallOption) (*BatchDeleteMemosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchDeleteMemosResponse)
+	err := c.cc.Invoke(ctx, MemoService_BatchDeleteMemos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ImportMemos(ctx context.Context, in *ImportMemosRequest, opts ...grpc.CallOption) (*ImportMemosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportMemosResponse)
+	err := c.cc.Invoke(ctx, MemoService_ImportMemos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) CreateMemoShareLink(ctx context.Context, in *CreateMemoShareLinkRequest, opts ...grpc.CallOption) (*MemoShareLink, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoShareLink)
+	err := c.cc.Invoke(ctx, MemoService_CreateMemoShareLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ListMemoShareLinks(ctx context.Context, in *ListMemoShareLinksRequest, opts ...grpc.CallOption) (*ListMemoShareLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemoShareLinksResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListMemoShareLinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) RevokeMemoShareLink(ctx context.Context, in *RevokeMemoShareLinkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MemoService_RevokeMemoShareLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) SetMemoCollaborators(ctx context.Context, in *SetMemoCollaboratorsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MemoService_SetMemoCollaborators_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ListMemoCollaborators(ctx context.Context, in *ListMemoCollaboratorsRequest, opts ...grpc.CallOption) (*ListMemoCollaboratorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemoCollaboratorsResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListMemoCollaborators_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTasksResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) SetTaskStatus(ctx context.Context, in *SetTaskStatusRequest, opts ...grpc.CallOption) (*Memo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Memo)
+	err := c.cc.Invoke(ctx, MemoService_SetTaskStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MemoServiceServer is the server API for MemoService service.
 // All implementations must embed UnimplementedMemoServiceServer
 // for forward compatibility.
@@ -265,9 +552,11 @@ type MemoServiceServer interface {
 	CreateMemo(context.Context, *CreateMemoRequest) (*Memo, error)
 	// ListMemos lists memos with pagination and filter.
 	ListMemos(context.Context, *ListMemosRequest) (*ListMemosResponse, error)
+	// ListMemoLocations lists the locations of memos clustered on a grid for map views.
+	ListMemoLocations(context.Context, *ListMemoLocationsRequest) (*ListMemoLocationsResponse, error)
 	// GetMemo gets a memo.
 	GetMemo(context.Context, *GetMemoRequest) (*Memo, error)
-	// GetMemoByUid gets a memo by uid
+	// GetMemoByUid gets a memo by uid, or by one of the previous uids of the memo.
 	GetMemoByUid(context.Context, *GetMemoByUidRequest) (*Memo, error)
 	// UpdateMemo updates a memo.
 	UpdateMemo(context.Context, *UpdateMemoRequest) (*Memo, error)
@@ -285,6 +574,17 @@ type MemoServiceServer interface {
 	SetMemoRelations(context.Context, *SetMemoRelationsRequest) (*emptypb.Empty, error)
 	// ListMemoRelations lists relations for a memo.
 	ListMemoRelations(context.Context, *ListMemoRelationsRequest) (*ListMemoRelationsResponse, error)
+	// GetMemoGraph gets the graph of the memos of the current user, or of the public memos if not signed in,
+	// linked by their relations and their tags.
+	GetMemoGraph(context.Context, *GetMemoGraphRequest) (*MemoGraph, error)
+	// ListMemoBacklinks lists the memos referencing a memo.
+	ListMemoBacklinks(context.Context, *ListMemoBacklinksRequest) (*ListMemoBacklinksResponse, error)
+	// ListRelatedMemos lists the memos most similar to a memo by the terms of their content.
+	ListRelatedMemos(context.Context, *ListRelatedMemosRequest) (*ListRelatedMemosResponse, error)
+	// ListDuplicateMemos lists the groups of likely duplicates among the memos of the current user.
+	ListDuplicateMemos(context.Context, *ListDuplicateMemosRequest) (*ListDuplicateMemosResponse, error)
+	// MergeMemos merges memos into a memo and archives them.
+	MergeMemos(context.Context, *MergeMemosRequest) (*Memo, error)
 	// CreateMemoComment creates a comment for a memo.
 	CreateMemoComment(context.Context, *CreateMemoCommentRequest) (*Memo, error)
 	// ListMemoComments lists comments for a memo.
@@ -295,6 +595,38 @@ type MemoServiceServer interface {
 	UpsertMemoReaction(context.Context, *UpsertMemoReactionRequest) (*Reaction, error)
 	// DeleteMemoReaction deletes a reaction for a memo.
 	DeleteMemoReaction(context.Context, *DeleteMemoReactionRequest) (*emptypb.Empty, error)
+	// ListMemoRevisions lists the revisions of a memo.
+	ListMemoRevisions(context.Context, *ListMemoRevisionsRequest) (*ListMemoRevisionsResponse, error)
+	// GetMemoRevisionDiff gets the diff between a memo revision and the current memo.
+	GetMemoRevisionDiff(context.Context, *GetMemoRevisionDiffRequest) (*GetMemoRevisionDiffResponse, error)
+	// ListTrashedMemos lists the memos in the trash of the current user.
+	ListTrashedMemos(context.Context, *ListTrashedMemosRequest) (*ListTrashedMemosResponse, error)
+	// RestoreMemo restores a memo from the trash.
+	RestoreMemo(context.Context, *RestoreMemoRequest) (*Memo, error)
+	// EmptyTrash permanently deletes all memos in the trash of the current user.
+	EmptyTrash(context.Context, *EmptyTrashRequest) (*emptypb.Empty, error)
+	// RestoreMemoRevision restores a memo to the given revision.
+	RestoreMemoRevision(context.Context, *RestoreMemoRevisionRequest) (*Memo, error)
+	// BatchUpdateMemos updates the memos selected by names or filter in one transaction.
+	BatchUpdateMemos(context.Context, *BatchUpdateMemosRequest) (*BatchUpdateMemosResponse, error)
+	// BatchDeleteMemos deletes the memos selected by names or filter.
+	BatchDeleteMemos(context.Context, *BatchDeleteMemosRequest) (*BatchDeleteMemosResponse, error)
+	// ImportMemos imports the notes of a Markdown archive or another note app as memos of the current user.
+	ImportMemos(context.Context, *ImportMemosRequest) (*ImportMemosResponse, error)
+	// CreateMemoShareLink creates a link granting read-only access to a memo.
+	CreateMemoShareLink(context.Context, *CreateMemoShareLinkRequest) (*MemoShareLink, error)
+	// ListMemoShareLinks lists the share links of a memo.
+	ListMemoShareLinks(context.Context, *ListMemoShareLinksRequest) (*ListMemoShareLinksResponse, error)
+	// RevokeMemoShareLink revokes a share link of a memo.
+	RevokeMemoShareLink(context.Context, *RevokeMemoShareLinkRequest) (*emptypb.Empty, error)
+	// SetMemoCollaborators sets the collaborators of a memo.
+	SetMemoCollaborators(context.Context, *SetMemoCollaboratorsRequest) (*emptypb.Empty, error)
+	// ListMemoCollaborators lists the collaborators of a memo.
+	ListMemoCollaborators(context.Context, *ListMemoCollaboratorsRequest) (*ListMemoCollaboratorsResponse, error)
+	// ListTasks lists the items of the task lists in the memos of a user.
+	ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error)
+	// SetTaskStatus checks or unchecks an item of a task list by updating the content of its memo.
+	SetTaskStatus(context.Context, *SetTaskStatusRequest) (*Memo, error)
 	mustEmbedUnimplementedMemoServiceServer()
 }
 
@@ -311,6 +643,9 @@ func (UnimplementedMemoServiceServer) CreateMemo(context.Context, *CreateMemoReq
 func (UnimplementedMemoServiceServer) ListMemos(context.Context, *ListMemosRequest) (*ListMemosResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListMemos not implemented")
 }
+func (UnimplementedMemoServiceServer) ListMemoLocations(context.Context, *ListMemoLocationsRequest) (*ListMemoLocationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMemoLocations not implemented")
+}
 func (UnimplementedMemoServiceServer) GetMemo(context.Context, *GetMemoRequest) (*Memo, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetMemo not implemented")
 }
@@ -341,6 +676,21 @@ func (UnimplementedMemoServiceServer) SetMemoRelations(context.Context, *SetMemo
 func (UnimplementedMemoServiceServer) ListMemoRelations(context.Context, *ListMemoRelationsRequest) (*ListMemoRelationsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListMemoRelations not implemented")
 }
+func (UnimplementedMemoServiceServer) GetMemoGraph(context.Context, *GetMemoGraphRequest) (*MemoGraph, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMemoGraph not implemented")
+}
+func (UnimplementedMemoServiceServer) ListMemoBacklinks(context.Context, *ListMemoBacklinksRequest) (*ListMemoBacklinksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMemoBacklinks not implemented")
+}
+func (UnimplementedMemoServiceServer) ListRelatedMemos(context.Context, *ListRelatedMemosRequest) (*ListRelatedMemosResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRelatedMemos not implemented")
+}
+func (UnimplementedMemoServiceServer) ListDuplicateMemos(context.Context, *ListDuplicateMemosRequest) (*ListDuplicateMemosResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDuplicateMemos not implemented")
+}
+func (UnimplementedMemoServiceServer) MergeMemos(context.Context, *MergeMemosRequest) (*Memo, error) {
+	return nil, status.Error(codes.Unimplemented, "method MergeMemos not implemented")
+}
 func (UnimplementedMemoServiceServer) CreateMemoComment(context.Context, *CreateMemoCommentRequest) (*Memo, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateMemoComment not implemented")
 }
@@ -356,6 +706,54 @@ func (UnimplementedMemoServiceServer) UpsertMemoReaction(context.Context, *Upser
 func (UnimplementedMemoServiceServer) DeleteMemoReaction(context.Context, *DeleteMemoReactionRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteMemoReaction not implemented")
 }
+func (UnimplementedMemoServiceServer) ListMemoRevisions(context.Context, *ListMemoRevisionsRequest) (*ListMemoRevisionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMemoRevisions not implemented")
+}
+func (UnimplementedMemoServiceServer) GetMemoRevisionDiff(context.Context, *GetMemoRevisionDiffRequest) (*GetMemoRevisionDiffResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMemoRevisionDiff not implemented")
+}
+func (UnimplementedMemoServiceServer) ListTrashedMemos(context.Context, *ListTrashedMemosRequest) (*ListTrashedMemosResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTrashedMemos not implemented")
+}
+func (UnimplementedMemoServiceServer) RestoreMemo(context.Context, *RestoreMemoRequest) (*Memo, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreMemo not implemented")
+}
+func (UnimplementedMemoServiceServer) EmptyTrash(context.Context, *EmptyTrashRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method EmptyTrash not implemented")
+}
+func (UnimplementedMemoServiceServer) RestoreMemoRevision(context.Context, *RestoreMemoRevisionRequest) (*Memo, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreMemoRevision not implemented")
+}
+func (UnimplementedMemoServiceServer) BatchUpdateMemos(context.Context, *BatchUpdateMemosRequest) (*BatchUpdateMemosResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchUpdateMemos not implemented")
+}
+func (UnimplementedMemoServiceServer) BatchDeleteMemos(context.Context, *BatchDeleteMemosRequest) (*BatchDeleteMemosResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchDeleteMemos not implemented")
+}
+func (UnimplementedMemoServiceServer) ImportMemos(context.Context, *ImportMemosRequest) (*ImportMemosResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportMemos not implemented")
+}
+func (UnimplementedMemoServiceServer) CreateMemoShareLink(context.Context, *CreateMemoShareLinkRequest) (*MemoShareLink, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateMemoShareLink not implemented")
+}
+func (UnimplementedMemoServiceServer) ListMemoShareLinks(context.Context, *ListMemoShareLinksRequest) (*ListMemoShareLinksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMemoShareLinks not implemented")
+}
+func (UnimplementedMemoServiceServer) RevokeMemoShareLink(context.Context, *RevokeMemoShareLinkRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeMemoShareLink not implemented")
+}
+func (UnimplementedMemoServiceServer) SetMemoCollaborators(context.Context, *SetMemoCollaboratorsRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetMemoCollaborators not implemented")
+}
+func (UnimplementedMemoServiceServer) ListMemoCollaborators(context.Context, *ListMemoCollaboratorsRequest) (*ListMemoCollaboratorsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMemoCollaborators not implemented")
+}
+func (UnimplementedMemoServiceServer) ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTasks not implemented")
+}
+func (UnimplementedMemoServiceServer) SetTaskStatus(context.Context, *SetTaskStatusRequest) (*Memo, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetTaskStatus not implemented")
+}
 func (UnimplementedMemoServiceServer) mustEmbedUnimplementedMemoServiceServer() {}
 func (UnimplementedMemoServiceServer) testEmbeddedByValue()                     {}
 
@@ -413,6 +811,24 @@ func _MemoService_ListMemos_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MemoService_ListMemoLocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoLocationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListMemoLocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListMemoLocations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListMemoLocations(ctx, req.(*ListMemoLocationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MemoService_GetMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetMemoRequest)
 	if err := dec(in); err != nil {
@@ -593,131 +1009,513 @@ func _MemoService_ListMemoRelations_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MemoService_CreateMemoComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateMemoCommentRequest)
+func _MemoService_GetMemoGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemoGraphRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MemoServiceServer).CreateMemoComment(ctx, in)
+		return srv.(MemoServiceServer).GetMemoGraph(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MemoService_CreateMemoComment_FullMethodName,
+		FullMethod: MemoService_GetMemoGraph_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MemoServiceServer).CreateMemoComment(ctx, req.(*CreateMemoCommentRequest))
+		return srv.(MemoServiceServer).GetMemoGraph(ctx, req.(*GetMemoGraphRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MemoService_ListMemoComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListMemoCommentsRequest)
+func _MemoService_ListMemoBacklinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoBacklinksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MemoServiceServer).ListMemoComments(ctx, in)
+		return srv.(MemoServiceServer).ListMemoBacklinks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MemoService_ListMemoComments_FullMethodName,
+		FullMethod: MemoService_ListMemoBacklinks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MemoServiceServer).ListMemoComments(ctx, req.(*ListMemoCommentsRequest))
+		return srv.(MemoServiceServer).ListMemoBacklinks(ctx, req.(*ListMemoBacklinksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MemoService_ListMemoReactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListMemoReactionsRequest)
+func _MemoService_ListRelatedMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRelatedMemosRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MemoServiceServer).ListMemoReactions(ctx, in)
+		return srv.(MemoServiceServer).ListRelatedMemos(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MemoService_ListMemoReactions_FullMethodName,
+		FullMethod: MemoService_ListRelatedMemos_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MemoServiceServer).ListMemoReactions(ctx, req.(*ListMemoReactionsRequest))
+		return srv.(MemoServiceServer).ListRelatedMemos(ctx, req.(*ListRelatedMemosRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MemoService_UpsertMemoReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpsertMemoReactionRequest)
+func _MemoService_ListDuplicateMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDuplicateMemosRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MemoServiceServer).UpsertMemoReaction(ctx, in)
+		return srv.(MemoServiceServer).ListDuplicateMemos(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MemoService_UpsertMemoReaction_FullMethodName,
+		FullMethod: MemoService_ListDuplicateMemos_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MemoServiceServer).UpsertMemoReaction(ctx, req.(*UpsertMemoReactionRequest))
+		return srv.(MemoServiceServer).ListDuplicateMemos(ctx, req.(*ListDuplicateMemosRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MemoService_DeleteMemoReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteMemoReactionRequest)
+func _MemoService_MergeMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeMemosRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MemoServiceServer).DeleteMemoReaction(ctx, in)
+		return srv.(MemoServiceServer).MergeMemos(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MemoService_DeleteMemoReaction_FullMethodName,
+		FullMethod: MemoService_MergeMemos_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MemoServiceServer).DeleteMemoReaction(ctx, req.(*DeleteMemoReactionRequest))
+		return srv.(MemoServiceServer).MergeMemos(ctx, req.(*MergeMemosRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// MemoService_ServiceDesc is the grpc.ServiceDesc for MemoService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var MemoService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "memos.api.v1.MemoService",
-	HandlerType: (*MemoServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateMemo",
-			Handler:    _MemoService_CreateMemo_Handler,
-		},
-		{
-			MethodName: "ListMemos",
-			Handler:    _MemoService_ListMemos_Handler,
-		},
-		{
-			MethodName: "GetMemo",
-			Handler:    _MemoService_GetMemo_Handler,
-		},
-		{
-			MethodName: "GetMemoByUid",
-			Handler:    _MemoService_GetMemoByUid_Handler,
-		},
-		{
-			MethodName: "UpdateMemo",
-			Handler:    _MemoService_UpdateMemo_Handler,
-		},
-		{
-			MethodName: "DeleteMemo",
-			Handler:    _MemoService_DeleteMemo_Handler,
-		},
-		{
-			MethodName: "RenameMemoTag",
-			Handler:    _MemoService_RenameMemoTag_Handler,
-		},
+func _MemoService_CreateMemoComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMemoCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).CreateMemoComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_CreateMemoComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).CreateMemoComment(ctx, req.(*CreateMemoCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ListMemoComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListMemoComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListMemoComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListMemoComments(ctx, req.(*ListMemoCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ListMemoReactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoReactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListMemoReactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListMemoReactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListMemoReactions(ctx, req.(*ListMemoReactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_UpsertMemoReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertMemoReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).UpsertMemoReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_UpsertMemoReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).UpsertMemoReaction(ctx, req.(*UpsertMemoReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_DeleteMemoReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMemoReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).DeleteMemoReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_DeleteMemoReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).DeleteMemoReaction(ctx, req.(*DeleteMemoReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ListMemoRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListMemoRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListMemoRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListMemoRevisions(ctx, req.(*ListMemoRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_GetMemoRevisionDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemoRevisionDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).GetMemoRevisionDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_GetMemoRevisionDiff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).GetMemoRevisionDiff(ctx, req.(*GetMemoRevisionDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ListTrashedMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashedMemosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListTrashedMemos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListTrashedMemos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListTrashedMemos(ctx, req.(*ListTrashedMemosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_RestoreMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).RestoreMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_RestoreMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).RestoreMemo(ctx, req.(*RestoreMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_EmptyTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).EmptyTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_EmptyTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).EmptyTrash(ctx, req.(*EmptyTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_RestoreMemoRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreMemoRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).RestoreMemoRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_RestoreMemoRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).RestoreMemoRevision(ctx, req.(*RestoreMemoRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_BatchUpdateMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateMemosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).BatchUpdateMemos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_BatchUpdateMemos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).BatchUpdateMemos(ctx, req.(*BatchUpdateMemosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_BatchDeleteMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteMemosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).BatchDeleteMemos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_BatchDeleteMemos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).BatchDeleteMemos(ctx, req.(*BatchDeleteMemosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ImportMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportMemosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ImportMemos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ImportMemos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ImportMemos(ctx, req.(*ImportMemosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_CreateMemoShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMemoShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).CreateMemoShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_CreateMemoShareLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).CreateMemoShareLink(ctx, req.(*CreateMemoShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ListMemoShareLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoShareLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListMemoShareLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListMemoShareLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListMemoShareLinks(ctx, req.(*ListMemoShareLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_RevokeMemoShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeMemoShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).RevokeMemoShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_RevokeMemoShareLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).RevokeMemoShareLink(ctx, req.(*RevokeMemoShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_SetMemoCollaborators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMemoCollaboratorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).SetMemoCollaborators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_SetMemoCollaborators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).SetMemoCollaborators(ctx, req.(*SetMemoCollaboratorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ListMemoCollaborators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoCollaboratorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListMemoCollaborators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListMemoCollaborators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListMemoCollaborators(ctx, req.(*ListMemoCollaboratorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ListTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListTasks(ctx, req.(*ListTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_SetTaskStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTaskStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).SetTaskStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_SetTaskStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).SetTaskStatus(ctx, req.(*SetTaskStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MemoService_ServiceDesc is the grpc.ServiceDesc for MemoService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MemoService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "memos.api.v1.MemoService",
+	HandlerType: (*MemoServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateMemo",
+			Handler:    _MemoService_CreateMemo_Handler,
+		},
+		{
+			MethodName: "ListMemos",
+			Handler:    _MemoService_ListMemos_Handler,
+		},
+		{
+			MethodName: "ListMemoLocations",
+			Handler:    _MemoService_ListMemoLocations_Handler,
+		},
+		{
+			MethodName: "GetMemo",
+			Handler:    _MemoService_GetMemo_Handler,
+		},
+		{
+			MethodName: "GetMemoByUid",
+			Handler:    _MemoService_GetMemoByUid_Handler,
+		},
+		{
+			MethodName: "UpdateMemo",
+			Handler:    _MemoService_UpdateMemo_Handler,
+		},
+		{
+			MethodName: "DeleteMemo",
+			Handler:    _MemoService_DeleteMemo_Handler,
+		},
+		{
+			MethodName: "RenameMemoTag",
+			Handler:    _MemoService_RenameMemoTag_Handler,
+		},
 		{
 			MethodName: "DeleteMemoTag",
 			Handler:    _MemoService_DeleteMemoTag_Handler,
@@ -738,6 +1536,26 @@ var MemoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMemoRelations",
 			Handler:    _MemoService_ListMemoRelations_Handler,
 		},
+		{
+			MethodName: "GetMemoGraph",
+			Handler:    _MemoService_GetMemoGraph_Handler,
+		},
+		{
+			MethodName: "ListMemoBacklinks",
+			Handler:    _MemoService_ListMemoBacklinks_Handler,
+		},
+		{
+			MethodName: "ListRelatedMemos",
+			Handler:    _MemoService_ListRelatedMemos_Handler,
+		},
+		{
+			MethodName: "ListDuplicateMemos",
+			Handler:    _MemoService_ListDuplicateMemos_Handler,
+		},
+		{
+			MethodName: "MergeMemos",
+			Handler:    _MemoService_MergeMemos_Handler,
+		},
 		{
 			MethodName: "CreateMemoComment",
 			Handler:    _MemoService_CreateMemoComment_Handler,
@@ -758,6 +1576,70 @@ var MemoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteMemoReaction",
 			Handler:    _MemoService_DeleteMemoReaction_Handler,
 		},
+		{
+			MethodName: "ListMemoRevisions",
+			Handler:    _MemoService_ListMemoRevisions_Handler,
+		},
+		{
+			MethodName: "GetMemoRevisionDiff",
+			Handler:    _MemoService_GetMemoRevisionDiff_Handler,
+		},
+		{
+			MethodName: "ListTrashedMemos",
+			Handler:    _MemoService_ListTrashedMemos_Handler,
+		},
+		{
+			MethodName: "RestoreMemo",
+			Handler:    _MemoService_RestoreMemo_Handler,
+		},
+		{
+			MethodName: "EmptyTrash",
+			Handler:    _MemoService_EmptyTrash_Handler,
+		},
+		{
+			MethodName: "RestoreMemoRevision",
+			Handler:    _MemoService_RestoreMemoRevision_Handler,
+		},
+		{
+			MethodName: "BatchUpdateMemos",
+			Handler:    _MemoService_BatchUpdateMemos_Handler,
+		},
+		{
+			MethodName: "BatchDeleteMemos",
+			Handler:    _MemoService_BatchDeleteMemos_Handler,
+		},
+		{
+			MethodName: "ImportMemos",
+			Handler:    _MemoService_ImportMemos_Handler,
+		},
+		{
+			MethodName: "CreateMemoShareLink",
+			Handler:    _MemoService_CreateMemoShareLink_Handler,
+		},
+		{
+			MethodName: "ListMemoShareLinks",
+			Handler:    _MemoService_ListMemoShareLinks_Handler,
+		},
+		{
+			MethodName: "RevokeMemoShareLink",
+			Handler:    _MemoService_RevokeMemoShareLink_Handler,
+		},
+		{
+			MethodName: "SetMemoCollaborators",
+			Handler:    _MemoService_SetMemoCollaborators_Handler,
+		},
+		{
+			MethodName: "ListMemoCollaborators",
+			Handler:    _MemoService_ListMemoCollaborators_Handler,
+		},
+		{
+			MethodName: "ListTasks",
+			Handler:    _MemoService_ListTasks_Handler,
+		},
+		{
+			MethodName: "SetTaskStatus",
+			Handler:    _MemoService_SetTaskStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/memo_service.proto",
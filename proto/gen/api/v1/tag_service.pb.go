@@ -291,6 +291,198 @@ func (x *ListTagsWithEmojiResponse) GetTags() []*Tag {
 	return nil
 }
 
+type ListTagTreeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagTreeRequest) Reset() {
+	*x = ListTagTreeRequest{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagTreeRequest) ProtoMessage() {}
+
+func (x *ListTagTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagTreeRequest.ProtoReflect.Descriptor instead.
+func (*ListTagTreeRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{5}
+}
+
+type ListTagTreeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The top-level tags, ordered by name.
+	Nodes         []*TagTreeNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagTreeResponse) Reset() {
+	*x = ListTagTreeResponse{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagTreeResponse) ProtoMessage() {}
+
+func (x *ListTagTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagTreeResponse.ProtoReflect.Descriptor instead.
+func (*ListTagTreeResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListTagTreeResponse) GetNodes() []*TagTreeNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+// TagTreeNode is a segment of the tag paths, e.g. "projects" in "work/projects/alpha".
+type TagTreeNode struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The last segment of the tag.
+	// e.g. "projects"
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The full tag, which can be the parent of other tags without being used itself.
+	// e.g. "work/projects"
+	Tag string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	// The number of memos with the tag itself.
+	DirectMemoCount int32 `protobuf:"varint,3,opt,name=direct_memo_count,json=directMemoCount,proto3" json:"direct_memo_count,omitempty"`
+	// The number of memos with the tag or any of its descendants.
+	MemoCount int32 `protobuf:"varint,4,opt,name=memo_count,json=memoCount,proto3" json:"memo_count,omitempty"`
+	// The last update time of the memos with the tag or any of its descendants.
+	LastUsedTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_used_time,json=lastUsedTime,proto3" json:"last_used_time,omitempty"`
+	// The emoji for the tag, if any.
+	Emoji string `protobuf:"bytes,6,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	// The pinned timestamp. If set, the tag is pinned.
+	PinnedTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=pinned_time,json=pinnedTime,proto3,oneof" json:"pinned_time,omitempty"`
+	// The child tags, ordered by name.
+	Children      []*TagTreeNode `protobuf:"bytes,8,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagTreeNode) Reset() {
+	*x = TagTreeNode{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagTreeNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagTreeNode) ProtoMessage() {}
+
+func (x *TagTreeNode) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagTreeNode.ProtoReflect.Descriptor instead.
+func (*TagTreeNode) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TagTreeNode) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TagTreeNode) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *TagTreeNode) GetDirectMemoCount() int32 {
+	if x != nil {
+		return x.DirectMemoCount
+	}
+	return 0
+}
+
+func (x *TagTreeNode) GetMemoCount() int32 {
+	if x != nil {
+		return x.MemoCount
+	}
+	return 0
+}
+
+func (x *TagTreeNode) GetLastUsedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedTime
+	}
+	return nil
+}
+
+func (x *TagTreeNode) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *TagTreeNode) GetPinnedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PinnedTime
+	}
+	return nil
+}
+
+func (x *TagTreeNode) GetChildren() []*TagTreeNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
 type UpdateTagRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The tag name to update.
@@ -305,7 +497,7 @@ type UpdateTagRequest struct {
 
 func (x *UpdateTagRequest) Reset() {
 	*x = UpdateTagRequest{}
-	mi := &file_api_v1_tag_service_proto_msgTypes[5]
+	mi := &file_api_v1_tag_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -317,7 +509,7 @@ func (x *UpdateTagRequest) String() string {
 func (*UpdateTagRequest) ProtoMessage() {}
 
 func (x *UpdateTagRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tag_service_proto_msgTypes[5]
+	mi := &file_api_v1_tag_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -330,7 +522,7 @@ func (x *UpdateTagRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTagRequest.ProtoReflect.Descriptor instead.
 func (*UpdateTagRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{5}
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UpdateTagRequest) GetTagName() string {
@@ -377,17 +569,33 @@ const file_api_v1_tag_service_proto_rawDesc = "" +
 	"\x04tags\x18\x01 \x03(\v2\x11.memos.api.v1.TagR\x04tags\"\x1a\n" +
 	"\x18ListTagsWithEmojiRequest\"B\n" +
 	"\x19ListTagsWithEmojiResponse\x12%\n" +
-	"\x04tags\x18\x01 \x03(\v2\x11.memos.api.v1.TagR\x04tags\"z\n" +
+	"\x04tags\x18\x01 \x03(\v2\x11.memos.api.v1.TagR\x04tags\"\x14\n" +
+	"\x12ListTagTreeRequest\"F\n" +
+	"\x13ListTagTreeResponse\x12/\n" +
+	"\x05nodes\x18\x01 \x03(\v2\x19.memos.api.v1.TagTreeNodeR\x05nodes\"\xdf\x02\n" +
+	"\vTagTreeNode\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03tag\x18\x02 \x01(\tR\x03tag\x12*\n" +
+	"\x11direct_memo_count\x18\x03 \x01(\x05R\x0fdirectMemoCount\x12\x1d\n" +
+	"\n" +
+	"memo_count\x18\x04 \x01(\x05R\tmemoCount\x12@\n" +
+	"\x0elast_used_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\flastUsedTime\x12\x14\n" +
+	"\x05emoji\x18\x06 \x01(\tR\x05emoji\x12@\n" +
+	"\vpinned_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"pinnedTime\x88\x01\x01\x125\n" +
+	"\bchildren\x18\b \x03(\v2\x19.memos.api.v1.TagTreeNodeR\bchildrenB\x0e\n" +
+	"\f_pinned_time\"z\n" +
 	"\x10UpdateTagRequest\x12\x19\n" +
 	"\btag_name\x18\x01 \x01(\tR\atagName\x12\x19\n" +
 	"\x05emoji\x18\x02 \x01(\tH\x00R\x05emoji\x88\x01\x01\x12\x1b\n" +
 	"\x06pinned\x18\x03 \x01(\bH\x01R\x06pinned\x88\x01\x01B\b\n" +
 	"\x06_emojiB\t\n" +
-	"\a_pinned2\xed\x02\n" +
+	"\a_pinned2\xdc\x03\n" +
 	"\n" +
 	"TagService\x12x\n" +
 	"\x0eListPinnedTags\x12#.memos.api.v1.ListPinnedTagsRequest\x1a$.memos.api.v1.ListPinnedTagsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/tags:pinned\x12\x80\x01\n" +
-	"\x11ListTagsWithEmoji\x12&.memos.api.v1.ListTagsWithEmojiRequest\x1a'.memos.api.v1.ListTagsWithEmojiResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/tags:emoji\x12b\n" +
+	"\x11ListTagsWithEmoji\x12&.memos.api.v1.ListTagsWithEmojiRequest\x1a'.memos.api.v1.ListTagsWithEmojiResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/tags:emoji\x12m\n" +
+	"\vListTagTree\x12 .memos.api.v1.ListTagTreeRequest\x1a!.memos.api.v1.ListTagTreeResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/tags:tree\x12b\n" +
 	"\tUpdateTag\x12\x1e.memos.api.v1.UpdateTagRequest\x1a\x11.memos.api.v1.Tag\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*2\x17/api/v1/tags/{tag_name}B\xa7\x01\n" +
 	"\x10com.memos.api.v1B\x0fTagServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
 
@@ -403,33 +611,42 @@ func file_api_v1_tag_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_tag_service_proto_rawDescData
 }
 
-var file_api_v1_tag_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_api_v1_tag_service_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_api_v1_tag_service_proto_goTypes = []any{
 	(*Tag)(nil),                       // 0: memos.api.v1.Tag
 	(*ListPinnedTagsRequest)(nil),     // 1: memos.api.v1.ListPinnedTagsRequest
 	(*ListPinnedTagsResponse)(nil),    // 2: memos.api.v1.ListPinnedTagsResponse
 	(*ListTagsWithEmojiRequest)(nil),  // 3: memos.api.v1.ListTagsWithEmojiRequest
 	(*ListTagsWithEmojiResponse)(nil), // 4: memos.api.v1.ListTagsWithEmojiResponse
-	(*UpdateTagRequest)(nil),          // 5: memos.api.v1.UpdateTagRequest
-	(*timestamppb.Timestamp)(nil),     // 6: google.protobuf.Timestamp
+	(*ListTagTreeRequest)(nil),        // 5: memos.api.v1.ListTagTreeRequest
+	(*ListTagTreeResponse)(nil),       // 6: memos.api.v1.ListTagTreeResponse
+	(*TagTreeNode)(nil),               // 7: memos.api.v1.TagTreeNode
+	(*UpdateTagRequest)(nil),          // 8: memos.api.v1.UpdateTagRequest
+	(*timestamppb.Timestamp)(nil),     // 9: google.protobuf.Timestamp
 }
 var file_api_v1_tag_service_proto_depIdxs = []int32{
-	6, // 0: memos.api.v1.Tag.create_time:type_name -> google.protobuf.Timestamp
-	6, // 1: memos.api.v1.Tag.update_time:type_name -> google.protobuf.Timestamp
-	6, // 2: memos.api.v1.Tag.pinned_time:type_name -> google.protobuf.Timestamp
-	0, // 3: memos.api.v1.ListPinnedTagsResponse.tags:type_name -> memos.api.v1.Tag
-	0, // 4: memos.api.v1.ListTagsWithEmojiResponse.tags:type_name -> memos.api.v1.Tag
-	1, // 5: memos.api.v1.TagService.ListPinnedTags:input_type -> memos.api.v1.ListPinnedTagsRequest
-	3, // 6: memos.api.v1.TagService.ListTagsWithEmoji:input_type -> memos.api.v1.ListTagsWithEmojiRequest
-	5, // 7: memos.api.v1.TagService.UpdateTag:input_type -> memos.api.v1.UpdateTagRequest
-	2, // 8: memos.api.v1.TagService.ListPinnedTags:output_type -> memos.api.v1.ListPinnedTagsResponse
-	4, // 9: memos.api.v1.TagService.ListTagsWithEmoji:output_type -> memos.api.v1.ListTagsWithEmojiResponse
-	0, // 10: memos.api.v1.TagService.UpdateTag:output_type -> memos.api.v1.Tag
-	8, // [8:11] is the sub-list for method output_type
-	5, // [5:8] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	9,  // 0: memos.api.v1.Tag.create_time:type_name -> google.protobuf.Timestamp
+	9,  // 1: memos.api.v1.Tag.update_time:type_name -> google.protobuf.Timestamp
+	9,  // 2: memos.api.v1.Tag.pinned_time:type_name -> google.protobuf.Timestamp
+	0,  // 3: memos.api.v1.ListPinnedTagsResponse.tags:type_name -> memos.api.v1.Tag
+	0,  // 4: memos.api.v1.ListTagsWithEmojiResponse.tags:type_name -> memos.api.v1.Tag
+	7,  // 5: memos.api.v1.ListTagTreeResponse.nodes:type_name -> memos.api.v1.TagTreeNode
+	9,  // 6: memos.api.v1.TagTreeNode.last_used_time:type_name -> google.protobuf.Timestamp
+	9,  // 7: memos.api.v1.TagTreeNode.pinned_time:type_name -> google.protobuf.Timestamp
+	7,  // 8: memos.api.v1.TagTreeNode.children:type_name -> memos.api.v1.TagTreeNode
+	1,  // 9: memos.api.v1.TagService.ListPinnedTags:input_type -> memos.api.v1.ListPinnedTagsRequest
+	3,  // 10: memos.api.v1.TagService.ListTagsWithEmoji:input_type -> memos.api.v1.ListTagsWithEmojiRequest
+	5,  // 11: memos.api.v1.TagService.ListTagTree:input_type -> memos.api.v1.ListTagTreeRequest
+	8,  // 12: memos.api.v1.TagService.UpdateTag:input_type -> memos.api.v1.UpdateTagRequest
+	2,  // 13: memos.api.v1.TagService.ListPinnedTags:output_type -> memos.api.v1.ListPinnedTagsResponse
+	4,  // 14: memos.api.v1.TagService.ListTagsWithEmoji:output_type -> memos.api.v1.ListTagsWithEmojiResponse
+	6,  // 15: memos.api.v1.TagService.ListTagTree:output_type -> memos.api.v1.ListTagTreeResponse
+	0,  // 16: memos.api.v1.TagService.UpdateTag:output_type -> memos.api.v1.Tag
+	13, // [13:17] is the sub-list for method output_type
+	9,  // [9:13] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_tag_service_proto_init() }
@@ -438,14 +655,15 @@ func file_api_v1_tag_service_proto_init() {
 		return
 	}
 	file_api_v1_tag_service_proto_msgTypes[0].OneofWrappers = []any{}
-	file_api_v1_tag_service_proto_msgTypes[5].OneofWrappers = []any{}
+	file_api_v1_tag_service_proto_msgTypes[7].OneofWrappers = []any{}
+	file_api_v1_tag_service_proto_msgTypes[8].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_tag_service_proto_rawDesc), len(file_api_v1_tag_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
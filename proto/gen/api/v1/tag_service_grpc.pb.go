@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	TagService_ListPinnedTags_FullMethodName    = "/memos.api.v1.TagService/ListPinnedTags"
 	TagService_ListTagsWithEmoji_FullMethodName = "/memos.api.v1.TagService/ListTagsWithEmoji"
+	TagService_ListTagTree_FullMethodName       = "/memos.api.v1.TagService/ListTagTree"
 	TagService_UpdateTag_FullMethodName         = "/memos.api.v1.TagService/UpdateTag"
 )
 
@@ -32,6 +33,8 @@ type TagServiceClient interface {
 	ListPinnedTags(ctx context.Context, in *ListPinnedTagsRequest, opts ...grpc.CallOption) (*ListPinnedTagsResponse, error)
 	// ListTagsWithEmoji lists all tags with emoji for the current user.
 	ListTagsWithEmoji(ctx context.Context, in *ListTagsWithEmojiRequest, opts ...grpc.CallOption) (*ListTagsWithEmojiResponse, error)
+	// ListTagTree lists the tags of the memos of the current user as a tree of their path segments.
+	ListTagTree(ctx context.Context, in *ListTagTreeRequest, opts ...grpc.CallOption) (*ListTagTreeResponse, error)
 	// UpdateTag updates tag metadata (emoji, pinned status, etc.).
 	UpdateTag(ctx context.Context, in *UpdateTagRequest, opts ...grpc.CallOption) (*Tag, error)
 }
@@ -64,6 +67,16 @@ func (c *tagServiceClient) ListTagsWithEmoji(ctx context.Context, in *ListTagsWi
 	return out, nil
 }
 
+func (c *tagServiceClient) ListTagTree(ctx context.Context, in *ListTagTreeRequest, opts ...grpc.CallOption) (*ListTagTreeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTagTreeResponse)
+	err := c.cc.Invoke(ctx, TagService_ListTagTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tagServiceClient) UpdateTag(ctx context.Context, in *UpdateTagRequest, opts ...grpc.CallOption) (*Tag, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Tag)
@@ -82,6 +95,8 @@ type TagServiceServer interface {
 	ListPinnedTags(context.Context, *ListPinnedTagsRequest) (*ListPinnedTagsResponse, error)
 	// ListTagsWithEmoji lists all tags with emoji for the current user.
 	ListTagsWithEmoji(context.Context, *ListTagsWithEmojiRequest) (*ListTagsWithEmojiResponse, error)
+	// ListTagTree lists the tags of the memos of the current user as a tree of their path segments.
+	ListTagTree(context.Context, *ListTagTreeRequest) (*ListTagTreeResponse, error)
 	// UpdateTag updates tag metadata (emoji, pinned status, etc.).
 	UpdateTag(context.Context, *UpdateTagRequest) (*Tag, error)
 	mustEmbedUnimplementedTagServiceServer()
@@ -100,6 +115,9 @@ func (UnimplementedTagServiceServer) ListPinnedTags(context.Context, *ListPinned
 func (UnimplementedTagServiceServer) ListTagsWithEmoji(context.Context, *ListTagsWithEmojiRequest) (*ListTagsWithEmojiResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListTagsWithEmoji not implemented")
 }
+func (UnimplementedTagServiceServer) ListTagTree(context.Context, *ListTagTreeRequest) (*ListTagTreeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTagTree not implemented")
+}
 func (UnimplementedTagServiceServer) UpdateTag(context.Context, *UpdateTagRequest) (*Tag, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateTag not implemented")
 }
@@ -160,6 +178,24 @@ func _TagService_ListTagsWithEmoji_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TagService_ListTagTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).ListTagTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_ListTagTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).ListTagTree(ctx, req.(*ListTagTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TagService_UpdateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateTagRequest)
 	if err := dec(in); err != nil {
@@ -193,6 +229,10 @@ var TagService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListTagsWithEmoji",
 			Handler:    _TagService_ListTagsWithEmoji_Handler,
 		},
+		{
+			MethodName: "ListTagTree",
+			Handler:    _TagService_ListTagTree_Handler,
+		},
 		{
 			MethodName: "UpdateTag",
 			Handler:    _TagService_UpdateTag_Handler,
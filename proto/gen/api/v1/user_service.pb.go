@@ -78,6 +78,62 @@ func (User_Role) EnumDescriptor() ([]byte, []int) {
 	return file_api_v1_user_service_proto_rawDescGZIP(), []int{0, 0}
 }
 
+type GetUserMemoStatsRequest_Period int32
+
+const (
+	GetUserMemoStatsRequest_PERIOD_UNSPECIFIED GetUserMemoStatsRequest_Period = 0
+	GetUserMemoStatsRequest_DAY                GetUserMemoStatsRequest_Period = 1
+	// Weeks start on Monday.
+	GetUserMemoStatsRequest_WEEK  GetUserMemoStatsRequest_Period = 2
+	GetUserMemoStatsRequest_MONTH GetUserMemoStatsRequest_Period = 3
+	GetUserMemoStatsRequest_YEAR  GetUserMemoStatsRequest_Period = 4
+)
+
+// Enum value maps for GetUserMemoStatsRequest_Period.
+var (
+	GetUserMemoStatsRequest_Period_name = map[int32]string{
+		0: "PERIOD_UNSPECIFIED",
+		1: "DAY",
+		2: "WEEK",
+		3: "MONTH",
+		4: "YEAR",
+	}
+	GetUserMemoStatsRequest_Period_value = map[string]int32{
+		"PERIOD_UNSPECIFIED": 0,
+		"DAY":                1,
+		"WEEK":               2,
+		"MONTH":              3,
+		"YEAR":               4,
+	}
+)
+
+func (x GetUserMemoStatsRequest_Period) Enum() *GetUserMemoStatsRequest_Period {
+	p := new(GetUserMemoStatsRequest_Period)
+	*p = x
+	return p
+}
+
+func (x GetUserMemoStatsRequest_Period) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetUserMemoStatsRequest_Period) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_user_service_proto_enumTypes[1].Descriptor()
+}
+
+func (GetUserMemoStatsRequest_Period) Type() protoreflect.EnumType {
+	return &file_api_v1_user_service_proto_enumTypes[1]
+}
+
+func (x GetUserMemoStatsRequest_Period) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetUserMemoStatsRequest_Period.Descriptor instead.
+func (GetUserMemoStatsRequest_Period) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{8, 0}
+}
+
 type User struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The name of the user.
@@ -484,6 +540,247 @@ func (x *GetUserAvatarBinaryRequest) GetHttpBody() *httpbody.HttpBody {
 	return nil
 }
 
+type ExportUserDataRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the user.
+	// Format: users/{id}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataRequest) Reset() {
+	*x = ExportUserDataRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataRequest) ProtoMessage() {}
+
+func (x *ExportUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ExportUserDataRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetUserMemoStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the user.
+	// Format: users/{id}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The IANA time zone the days are counted in, e.g. "Asia/Shanghai".
+	// Defaults to UTC.
+	Timezone string `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// The period the written words are counted by.
+	// Defaults to DAY.
+	WordCountPeriod GetUserMemoStatsRequest_Period `protobuf:"varint,3,opt,name=word_count_period,json=wordCountPeriod,proto3,enum=memos.api.v1.GetUserMemoStatsRequest_Period" json:"word_count_period,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetUserMemoStatsRequest) Reset() {
+	*x = GetUserMemoStatsRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserMemoStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserMemoStatsRequest) ProtoMessage() {}
+
+func (x *GetUserMemoStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserMemoStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserMemoStatsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetUserMemoStatsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetUserMemoStatsRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetUserMemoStatsRequest) GetWordCountPeriod() GetUserMemoStatsRequest_Period {
+	if x != nil {
+		return x.WordCountPeriod
+	}
+	return GetUserMemoStatsRequest_PERIOD_UNSPECIFIED
+}
+
+type UserMemoStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the user.
+	// Format: users/{id}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The total number of memos.
+	MemoCount int32 `protobuf:"varint,2,opt,name=memo_count,json=memoCount,proto3" json:"memo_count,omitempty"`
+	// The number of memos created on each day with memos.
+	// The key is the date in the format YYYY-MM-DD.
+	DailyMemoCounts map[string]int32 `protobuf:"bytes,3,rep,name=daily_memo_counts,json=dailyMemoCounts,proto3" json:"daily_memo_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// The number of memos with each tag.
+	TagCounts map[string]int32 `protobuf:"bytes,4,rep,name=tag_counts,json=tagCounts,proto3" json:"tag_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// The number of memos with links.
+	LinkCount int32 `protobuf:"varint,5,opt,name=link_count,json=linkCount,proto3" json:"link_count,omitempty"`
+	// The number of memos with code.
+	CodeCount int32 `protobuf:"varint,6,opt,name=code_count,json=codeCount,proto3" json:"code_count,omitempty"`
+	// The number of memos with task lists.
+	TaskListCount int32 `protobuf:"varint,7,opt,name=task_list_count,json=taskListCount,proto3" json:"task_list_count,omitempty"`
+	// The number of memos with images.
+	ImageCount int32 `protobuf:"varint,8,opt,name=image_count,json=imageCount,proto3" json:"image_count,omitempty"`
+	// The number of incomplete tasks in all the memos.
+	IncompleteTaskCount int32 `protobuf:"varint,9,opt,name=incomplete_task_count,json=incompleteTaskCount,proto3" json:"incomplete_task_count,omitempty"`
+	// The number of words written in each period with memos.
+	// The key is the first date of the period in the format YYYY-MM-DD.
+	WordCounts    map[string]int64 `protobuf:"bytes,10,rep,name=word_counts,json=wordCounts,proto3" json:"word_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserMemoStats) Reset() {
+	*x = UserMemoStats{}
+	mi := &file_api_v1_user_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserMemoStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserMemoStats) ProtoMessage() {}
+
+func (x *UserMemoStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserMemoStats.ProtoReflect.Descriptor instead.
+func (*UserMemoStats) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UserMemoStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserMemoStats) GetMemoCount() int32 {
+	if x != nil {
+		return x.MemoCount
+	}
+	return 0
+}
+
+func (x *UserMemoStats) GetDailyMemoCounts() map[string]int32 {
+	if x != nil {
+		return x.DailyMemoCounts
+	}
+	return nil
+}
+
+func (x *UserMemoStats) GetTagCounts() map[string]int32 {
+	if x != nil {
+		return x.TagCounts
+	}
+	return nil
+}
+
+func (x *UserMemoStats) GetLinkCount() int32 {
+	if x != nil {
+		return x.LinkCount
+	}
+	return 0
+}
+
+func (x *UserMemoStats) GetCodeCount() int32 {
+	if x != nil {
+		return x.CodeCount
+	}
+	return 0
+}
+
+func (x *UserMemoStats) GetTaskListCount() int32 {
+	if x != nil {
+		return x.TaskListCount
+	}
+	return 0
+}
+
+func (x *UserMemoStats) GetImageCount() int32 {
+	if x != nil {
+		return x.ImageCount
+	}
+	return 0
+}
+
+func (x *UserMemoStats) GetIncompleteTaskCount() int32 {
+	if x != nil {
+		return x.IncompleteTaskCount
+	}
+	return 0
+}
+
+func (x *UserMemoStats) GetWordCounts() map[string]int64 {
+	if x != nil {
+		return x.WordCounts
+	}
+	return nil
+}
+
 type CreateUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
@@ -493,7 +790,7 @@ type CreateUserRequest struct {
 
 func (x *CreateUserRequest) Reset() {
 	*x = CreateUserRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[7]
+	mi := &file_api_v1_user_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -505,7 +802,7 @@ func (x *CreateUserRequest) String() string {
 func (*CreateUserRequest) ProtoMessage() {}
 
 func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[7]
+	mi := &file_api_v1_user_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -518,7 +815,7 @@ func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
 func (*CreateUserRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{7}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *CreateUserRequest) GetUser() *User {
@@ -538,7 +835,7 @@ type UpdateUserRequest struct {
 
 func (x *UpdateUserRequest) Reset() {
 	*x = UpdateUserRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[8]
+	mi := &file_api_v1_user_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -550,7 +847,7 @@ func (x *UpdateUserRequest) String() string {
 func (*UpdateUserRequest) ProtoMessage() {}
 
 func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[8]
+	mi := &file_api_v1_user_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -563,7 +860,7 @@ func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{8}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UpdateUserRequest) GetUser() *User {
@@ -591,7 +888,7 @@ type DeleteUserRequest struct {
 
 func (x *DeleteUserRequest) Reset() {
 	*x = DeleteUserRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[9]
+	mi := &file_api_v1_user_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -603,7 +900,7 @@ func (x *DeleteUserRequest) String() string {
 func (*DeleteUserRequest) ProtoMessage() {}
 
 func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[9]
+	mi := &file_api_v1_user_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -616,7 +913,7 @@ func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
 func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{9}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DeleteUserRequest) GetName() string {
@@ -639,13 +936,15 @@ type UserSetting struct {
 	MemoVisibility string `protobuf:"bytes,4,opt,name=memo_visibility,json=memoVisibility,proto3" json:"memo_visibility,omitempty"`
 	// The review settings of the user.
 	ReviewSetting *ReviewUserSetting `protobuf:"bytes,5,opt,name=review_setting,json=reviewSetting,proto3" json:"review_setting,omitempty"`
+	// Whether the uids of new memos are readable slugs generated from their first heading.
+	MemoSlug      bool `protobuf:"varint,6,opt,name=memo_slug,json=memoSlug,proto3" json:"memo_slug,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UserSetting) Reset() {
 	*x = UserSetting{}
-	mi := &file_api_v1_user_service_proto_msgTypes[10]
+	mi := &file_api_v1_user_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -657,7 +956,7 @@ func (x *UserSetting) String() string {
 func (*UserSetting) ProtoMessage() {}
 
 func (x *UserSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[10]
+	mi := &file_api_v1_user_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -670,7 +969,7 @@ func (x *UserSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserSetting.ProtoReflect.Descriptor instead.
 func (*UserSetting) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{10}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UserSetting) GetName() string {
@@ -708,6 +1007,13 @@ func (x *UserSetting) GetReviewSetting() *ReviewUserSetting {
 	return nil
 }
 
+func (x *UserSetting) GetMemoSlug() bool {
+	if x != nil {
+		return x.MemoSlug
+	}
+	return false
+}
+
 type ReviewUserSetting struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionSize   int32                  `protobuf:"varint,1,opt,name=session_size,json=sessionSize,proto3" json:"session_size,omitempty"`
@@ -719,7 +1025,7 @@ type ReviewUserSetting struct {
 
 func (x *ReviewUserSetting) Reset() {
 	*x = ReviewUserSetting{}
-	mi := &file_api_v1_user_service_proto_msgTypes[11]
+	mi := &file_api_v1_user_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -731,7 +1037,7 @@ func (x *ReviewUserSetting) String() string {
 func (*ReviewUserSetting) ProtoMessage() {}
 
 func (x *ReviewUserSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[11]
+	mi := &file_api_v1_user_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -744,7 +1050,7 @@ func (x *ReviewUserSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReviewUserSetting.ProtoReflect.Descriptor instead.
 func (*ReviewUserSetting) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{11}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ReviewUserSetting) GetSessionSize() int32 {
@@ -779,7 +1085,7 @@ type GetUserSettingRequest struct {
 
 func (x *GetUserSettingRequest) Reset() {
 	*x = GetUserSettingRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[12]
+	mi := &file_api_v1_user_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -791,7 +1097,7 @@ func (x *GetUserSettingRequest) String() string {
 func (*GetUserSettingRequest) ProtoMessage() {}
 
 func (x *GetUserSettingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[12]
+	mi := &file_api_v1_user_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -804,7 +1110,7 @@ func (x *GetUserSettingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserSettingRequest.ProtoReflect.Descriptor instead.
 func (*GetUserSettingRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{12}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetUserSettingRequest) GetName() string {
@@ -824,7 +1130,7 @@ type UpdateUserSettingRequest struct {
 
 func (x *UpdateUserSettingRequest) Reset() {
 	*x = UpdateUserSettingRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[13]
+	mi := &file_api_v1_user_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -836,7 +1142,7 @@ func (x *UpdateUserSettingRequest) String() string {
 func (*UpdateUserSettingRequest) ProtoMessage() {}
 
 func (x *UpdateUserSettingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[13]
+	mi := &file_api_v1_user_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -849,7 +1155,7 @@ func (x *UpdateUserSettingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserSettingRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserSettingRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{13}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *UpdateUserSettingRequest) GetSetting() *UserSetting {
@@ -878,7 +1184,7 @@ type UserAccessToken struct {
 
 func (x *UserAccessToken) Reset() {
 	*x = UserAccessToken{}
-	mi := &file_api_v1_user_service_proto_msgTypes[14]
+	mi := &file_api_v1_user_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -890,7 +1196,7 @@ func (x *UserAccessToken) String() string {
 func (*UserAccessToken) ProtoMessage() {}
 
 func (x *UserAccessToken) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[14]
+	mi := &file_api_v1_user_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -903,7 +1209,7 @@ func (x *UserAccessToken) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserAccessToken.ProtoReflect.Descriptor instead.
 func (*UserAccessToken) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{14}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *UserAccessToken) GetAccessToken() string {
@@ -945,7 +1251,7 @@ type ListUserAccessTokensRequest struct {
 
 func (x *ListUserAccessTokensRequest) Reset() {
 	*x = ListUserAccessTokensRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[15]
+	mi := &file_api_v1_user_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -957,7 +1263,7 @@ func (x *ListUserAccessTokensRequest) String() string {
 func (*ListUserAccessTokensRequest) ProtoMessage() {}
 
 func (x *ListUserAccessTokensRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[15]
+	mi := &file_api_v1_user_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -970,7 +1276,7 @@ func (x *ListUserAccessTokensRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserAccessTokensRequest.ProtoReflect.Descriptor instead.
 func (*ListUserAccessTokensRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{15}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ListUserAccessTokensRequest) GetName() string {
@@ -989,7 +1295,7 @@ type ListUserAccessTokensResponse struct {
 
 func (x *ListUserAccessTokensResponse) Reset() {
 	*x = ListUserAccessTokensResponse{}
-	mi := &file_api_v1_user_service_proto_msgTypes[16]
+	mi := &file_api_v1_user_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1001,7 +1307,7 @@ func (x *ListUserAccessTokensResponse) String() string {
 func (*ListUserAccessTokensResponse) ProtoMessage() {}
 
 func (x *ListUserAccessTokensResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[16]
+	mi := &file_api_v1_user_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1014,7 +1320,7 @@ func (x *ListUserAccessTokensResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserAccessTokensResponse.ProtoReflect.Descriptor instead.
 func (*ListUserAccessTokensResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{16}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ListUserAccessTokensResponse) GetAccessTokens() []*UserAccessToken {
@@ -1037,7 +1343,7 @@ type CreateUserAccessTokenRequest struct {
 
 func (x *CreateUserAccessTokenRequest) Reset() {
 	*x = CreateUserAccessTokenRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[17]
+	mi := &file_api_v1_user_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1049,7 +1355,7 @@ func (x *CreateUserAccessTokenRequest) String() string {
 func (*CreateUserAccessTokenRequest) ProtoMessage() {}
 
 func (x *CreateUserAccessTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[17]
+	mi := &file_api_v1_user_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1062,7 +1368,7 @@ func (x *CreateUserAccessTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUserAccessTokenRequest.ProtoReflect.Descriptor instead.
 func (*CreateUserAccessTokenRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{17}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *CreateUserAccessTokenRequest) GetName() string {
@@ -1099,7 +1405,7 @@ type DeleteUserAccessTokenRequest struct {
 
 func (x *DeleteUserAccessTokenRequest) Reset() {
 	*x = DeleteUserAccessTokenRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[18]
+	mi := &file_api_v1_user_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1111,7 +1417,7 @@ func (x *DeleteUserAccessTokenRequest) String() string {
 func (*DeleteUserAccessTokenRequest) ProtoMessage() {}
 
 func (x *DeleteUserAccessTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[18]
+	mi := &file_api_v1_user_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1124,7 +1430,7 @@ func (x *DeleteUserAccessTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserAccessTokenRequest.ProtoReflect.Descriptor instead.
 func (*DeleteUserAccessTokenRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{18}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *DeleteUserAccessTokenRequest) GetName() string {
@@ -1180,7 +1486,46 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\x04name\x18\x01 \x01(\tR\x04name\"c\n" +
 	"\x1aGetUserAvatarBinaryRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x121\n" +
-	"\thttp_body\x18\x02 \x01(\v2\x14.google.api.HttpBodyR\bhttpBody\";\n" +
+	"\thttp_body\x18\x02 \x01(\v2\x14.google.api.HttpBodyR\bhttpBody\"+\n" +
+	"\x15ExportUserDataRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\xed\x01\n" +
+	"\x17GetUserMemoStatsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\x12X\n" +
+	"\x11word_count_period\x18\x03 \x01(\x0e2,.memos.api.v1.GetUserMemoStatsRequest.PeriodR\x0fwordCountPeriod\"H\n" +
+	"\x06Period\x12\x16\n" +
+	"\x12PERIOD_UNSPECIFIED\x10\x00\x12\a\n" +
+	"\x03DAY\x10\x01\x12\b\n" +
+	"\x04WEEK\x10\x02\x12\t\n" +
+	"\x05MONTH\x10\x03\x12\b\n" +
+	"\x04YEAR\x10\x04\"\xb5\x05\n" +
+	"\rUserMemoStats\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"memo_count\x18\x02 \x01(\x05R\tmemoCount\x12\\\n" +
+	"\x11daily_memo_counts\x18\x03 \x03(\v20.memos.api.v1.UserMemoStats.DailyMemoCountsEntryR\x0fdailyMemoCounts\x12I\n" +
+	"\n" +
+	"tag_counts\x18\x04 \x03(\v2*.memos.api.v1.UserMemoStats.TagCountsEntryR\ttagCounts\x12\x1d\n" +
+	"\n" +
+	"link_count\x18\x05 \x01(\x05R\tlinkCount\x12\x1d\n" +
+	"\n" +
+	"code_count\x18\x06 \x01(\x05R\tcodeCount\x12&\n" +
+	"\x0ftask_list_count\x18\a \x01(\x05R\rtaskListCount\x12\x1f\n" +
+	"\vimage_count\x18\b \x01(\x05R\n" +
+	"imageCount\x122\n" +
+	"\x15incomplete_task_count\x18\t \x01(\x05R\x13incompleteTaskCount\x12L\n" +
+	"\vword_counts\x18\n" +
+	" \x03(\v2+.memos.api.v1.UserMemoStats.WordCountsEntryR\n" +
+	"wordCounts\x1aB\n" +
+	"\x14DailyMemoCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1a<\n" +
+	"\x0eTagCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1a=\n" +
+	"\x0fWordCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\";\n" +
 	"\x11CreateUserRequest\x12&\n" +
 	"\x04user\x18\x01 \x01(\v2\x12.memos.api.v1.UserR\x04user\"~\n" +
 	"\x11UpdateUserRequest\x12,\n" +
@@ -1188,7 +1533,7 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
 	"updateMask\"'\n" +
 	"\x11DeleteUserRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"\xca\x01\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\xe7\x01\n" +
 	"\vUserSetting\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
 	"\x06locale\x18\x02 \x01(\tR\x06locale\x12\x1e\n" +
@@ -1196,7 +1541,8 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"appearance\x18\x03 \x01(\tR\n" +
 	"appearance\x12'\n" +
 	"\x0fmemo_visibility\x18\x04 \x01(\tR\x0ememoVisibility\x12F\n" +
-	"\x0ereview_setting\x18\x05 \x01(\v2\x1f.memos.api.v1.ReviewUserSettingR\rreviewSetting\"|\n" +
+	"\x0ereview_setting\x18\x05 \x01(\v2\x1f.memos.api.v1.ReviewUserSettingR\rreviewSetting\x12\x1b\n" +
+	"\tmemo_slug\x18\x06 \x01(\bR\bmemoSlug\"|\n" +
 	"\x11ReviewUserSetting\x12!\n" +
 	"\fsession_size\x18\x01 \x01(\x05R\vsessionSize\x12!\n" +
 	"\finclude_tags\x18\x02 \x03(\tR\vincludeTags\x12!\n" +
@@ -1225,12 +1571,14 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\v_expires_at\"U\n" +
 	"\x1cDeleteUserAccessTokenRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
-	"\faccess_token\x18\x02 \x01(\tR\vaccessToken2\xbc\f\n" +
+	"\faccess_token\x18\x02 \x01(\tR\vaccessToken2\xc1\x0e\n" +
 	"\vUserService\x12c\n" +
 	"\tListUsers\x12\x1e.memos.api.v1.ListUsersRequest\x1a\x1f.memos.api.v1.ListUsersResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/users\x12p\n" +
 	"\vSearchUsers\x12 .memos.api.v1.SearchUsersRequest\x1a!.memos.api.v1.SearchUsersResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/users:search\x12b\n" +
 	"\aGetUser\x12\x1c.memos.api.v1.GetUserRequest\x1a\x12.memos.api.v1.User\"%\xdaA\x04name\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/{name=users/*}\x12\x81\x01\n" +
-	"\x13GetUserAvatarBinary\x12(.memos.api.v1.GetUserAvatarBinaryRequest\x1a\x14.google.api.HttpBody\"*\xdaA\x04name\x82\xd3\xe4\x93\x02\x1d\x12\x1b/file/{name=users/*}/avatar\x12e\n" +
+	"\x13GetUserAvatarBinary\x12(.memos.api.v1.GetUserAvatarBinaryRequest\x1a\x14.google.api.HttpBody\"*\xdaA\x04name\x82\xd3\xe4\x93\x02\x1d\x12\x1b/file/{name=users/*}/avatar\x12y\n" +
+	"\x0eExportUserData\x12#.memos.api.v1.ExportUserDataRequest\x1a\x14.google.api.HttpBody\"*\xdaA\x04name\x82\xd3\xe4\x93\x02\x1d\x12\x1b/file/{name=users/*}/export0\x01\x12\x87\x01\n" +
+	"\x10GetUserMemoStats\x12%.memos.api.v1.GetUserMemoStatsRequest\x1a\x1b.memos.api.v1.UserMemoStats\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"\x12 /api/v1/{name=users/*}/memoStats\x12e\n" +
 	"\n" +
 	"CreateUser\x12\x1f.memos.api.v1.CreateUserRequest\x1a\x12.memos.api.v1.User\"\"\xdaA\x04user\x82\xd3\xe4\x93\x02\x15:\x04user\"\r/api/v1/users\x12\x7f\n" +
 	"\n" +
@@ -1256,82 +1604,97 @@ func file_api_v1_user_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_user_service_proto_rawDescData
 }
 
-var file_api_v1_user_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_v1_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_api_v1_user_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_api_v1_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_api_v1_user_service_proto_goTypes = []any{
 	(User_Role)(0),                       // 0: memos.api.v1.User.Role
-	(*User)(nil),                         // 1: memos.api.v1.User
-	(*ListUsersRequest)(nil),             // 2: memos.api.v1.ListUsersRequest
-	(*ListUsersResponse)(nil),            // 3: memos.api.v1.ListUsersResponse
-	(*SearchUsersRequest)(nil),           // 4: memos.api.v1.SearchUsersRequest
-	(*SearchUsersResponse)(nil),          // 5: memos.api.v1.SearchUsersResponse
-	(*GetUserRequest)(nil),               // 6: memos.api.v1.GetUserRequest
-	(*GetUserAvatarBinaryRequest)(nil),   // 7: memos.api.v1.GetUserAvatarBinaryRequest
-	(*CreateUserRequest)(nil),            // 8: memos.api.v1.CreateUserRequest
-	(*UpdateUserRequest)(nil),            // 9: memos.api.v1.UpdateUserRequest
-	(*DeleteUserRequest)(nil),            // 10: memos.api.v1.DeleteUserRequest
-	(*UserSetting)(nil),                  // 11: memos.api.v1.UserSetting
-	(*ReviewUserSetting)(nil),            // 12: memos.api.v1.ReviewUserSetting
-	(*GetUserSettingRequest)(nil),        // 13: memos.api.v1.GetUserSettingRequest
-	(*UpdateUserSettingRequest)(nil),     // 14: memos.api.v1.UpdateUserSettingRequest
-	(*UserAccessToken)(nil),              // 15: memos.api.v1.UserAccessToken
-	(*ListUserAccessTokensRequest)(nil),  // 16: memos.api.v1.ListUserAccessTokensRequest
-	(*ListUserAccessTokensResponse)(nil), // 17: memos.api.v1.ListUserAccessTokensResponse
-	(*CreateUserAccessTokenRequest)(nil), // 18: memos.api.v1.CreateUserAccessTokenRequest
-	(*DeleteUserAccessTokenRequest)(nil), // 19: memos.api.v1.DeleteUserAccessTokenRequest
-	(RowStatus)(0),                       // 20: memos.api.v1.RowStatus
-	(*timestamppb.Timestamp)(nil),        // 21: google.protobuf.Timestamp
-	(*httpbody.HttpBody)(nil),            // 22: google.api.HttpBody
-	(*fieldmaskpb.FieldMask)(nil),        // 23: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),                // 24: google.protobuf.Empty
+	(GetUserMemoStatsRequest_Period)(0),  // 1: memos.api.v1.GetUserMemoStatsRequest.Period
+	(*User)(nil),                         // 2: memos.api.v1.User
+	(*ListUsersRequest)(nil),             // 3: memos.api.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),            // 4: memos.api.v1.ListUsersResponse
+	(*SearchUsersRequest)(nil),           // 5: memos.api.v1.SearchUsersRequest
+	(*SearchUsersResponse)(nil),          // 6: memos.api.v1.SearchUsersResponse
+	(*GetUserRequest)(nil),               // 7: memos.api.v1.GetUserRequest
+	(*GetUserAvatarBinaryRequest)(nil),   // 8: memos.api.v1.GetUserAvatarBinaryRequest
+	(*ExportUserDataRequest)(nil),        // 9: memos.api.v1.ExportUserDataRequest
+	(*GetUserMemoStatsRequest)(nil),      // 10: memos.api.v1.GetUserMemoStatsRequest
+	(*UserMemoStats)(nil),                // 11: memos.api.v1.UserMemoStats
+	(*CreateUserRequest)(nil),            // 12: memos.api.v1.CreateUserRequest
+	(*UpdateUserRequest)(nil),            // 13: memos.api.v1.UpdateUserRequest
+	(*DeleteUserRequest)(nil),            // 14: memos.api.v1.DeleteUserRequest
+	(*UserSetting)(nil),                  // 15: memos.api.v1.UserSetting
+	(*ReviewUserSetting)(nil),            // 16: memos.api.v1.ReviewUserSetting
+	(*GetUserSettingRequest)(nil),        // 17: memos.api.v1.GetUserSettingRequest
+	(*UpdateUserSettingRequest)(nil),     // 18: memos.api.v1.UpdateUserSettingRequest
+	(*UserAccessToken)(nil),              // 19: memos.api.v1.UserAccessToken
+	(*ListUserAccessTokensRequest)(nil),  // 20: memos.api.v1.ListUserAccessTokensRequest
+	(*ListUserAccessTokensResponse)(nil), // 21: memos.api.v1.ListUserAccessTokensResponse
+	(*CreateUserAccessTokenRequest)(nil), // 22: memos.api.v1.CreateUserAccessTokenRequest
+	(*DeleteUserAccessTokenRequest)(nil), // 23: memos.api.v1.DeleteUserAccessTokenRequest
+	nil,                                  // 24: memos.api.v1.UserMemoStats.DailyMemoCountsEntry
+	nil,                                  // 25: memos.api.v1.UserMemoStats.TagCountsEntry
+	nil,                                  // 26: memos.api.v1.UserMemoStats.WordCountsEntry
+	(RowStatus)(0),                       // 27: memos.api.v1.RowStatus
+	(*timestamppb.Timestamp)(nil),        // 28: google.protobuf.Timestamp
+	(*httpbody.HttpBody)(nil),            // 29: google.api.HttpBody
+	(*fieldmaskpb.FieldMask)(nil),        // 30: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                // 31: google.protobuf.Empty
 }
 var file_api_v1_user_service_proto_depIdxs = []int32{
 	0,  // 0: memos.api.v1.User.role:type_name -> memos.api.v1.User.Role
-	20, // 1: memos.api.v1.User.row_status:type_name -> memos.api.v1.RowStatus
-	21, // 2: memos.api.v1.User.create_time:type_name -> google.protobuf.Timestamp
-	21, // 3: memos.api.v1.User.update_time:type_name -> google.protobuf.Timestamp
-	1,  // 4: memos.api.v1.ListUsersResponse.users:type_name -> memos.api.v1.User
-	1,  // 5: memos.api.v1.SearchUsersResponse.users:type_name -> memos.api.v1.User
-	22, // 6: memos.api.v1.GetUserAvatarBinaryRequest.http_body:type_name -> google.api.HttpBody
-	1,  // 7: memos.api.v1.CreateUserRequest.user:type_name -> memos.api.v1.User
-	1,  // 8: memos.api.v1.UpdateUserRequest.user:type_name -> memos.api.v1.User
-	23, // 9: memos.api.v1.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
-	12, // 10: memos.api.v1.UserSetting.review_setting:type_name -> memos.api.v1.ReviewUserSetting
-	11, // 11: memos.api.v1.UpdateUserSettingRequest.setting:type_name -> memos.api.v1.UserSetting
-	23, // 12: memos.api.v1.UpdateUserSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
-	21, // 13: memos.api.v1.UserAccessToken.issued_at:type_name -> google.protobuf.Timestamp
-	21, // 14: memos.api.v1.UserAccessToken.expires_at:type_name -> google.protobuf.Timestamp
-	15, // 15: memos.api.v1.ListUserAccessTokensResponse.access_tokens:type_name -> memos.api.v1.UserAccessToken
-	21, // 16: memos.api.v1.CreateUserAccessTokenRequest.expires_at:type_name -> google.protobuf.Timestamp
-	2,  // 17: memos.api.v1.UserService.ListUsers:input_type -> memos.api.v1.ListUsersRequest
-	4,  // 18: memos.api.v1.UserService.SearchUsers:input_type -> memos.api.v1.SearchUsersRequest
-	6,  // 19: memos.api.v1.UserService.GetUser:input_type -> memos.api.v1.GetUserRequest
-	7,  // 20: memos.api.v1.UserService.GetUserAvatarBinary:input_type -> memos.api.v1.GetUserAvatarBinaryRequest
-	8,  // 21: memos.api.v1.UserService.CreateUser:input_type -> memos.api.v1.CreateUserRequest
-	9,  // 22: memos.api.v1.UserService.UpdateUser:input_type -> memos.api.v1.UpdateUserRequest
-	10, // 23: memos.api.v1.UserService.DeleteUser:input_type -> memos.api.v1.DeleteUserRequest
-	13, // 24: memos.api.v1.UserService.GetUserSetting:input_type -> memos.api.v1.GetUserSettingRequest
-	14, // 25: memos.api.v1.UserService.UpdateUserSetting:input_type -> memos.api.v1.UpdateUserSettingRequest
-	16, // 26: memos.api.v1.UserService.ListUserAccessTokens:input_type -> memos.api.v1.ListUserAccessTokensRequest
-	18, // 27: memos.api.v1.UserService.CreateUserAccessToken:input_type -> memos.api.v1.CreateUserAccessTokenRequest
-	19, // 28: memos.api.v1.UserService.DeleteUserAccessToken:input_type -> memos.api.v1.DeleteUserAccessTokenRequest
-	3,  // 29: memos.api.v1.UserService.ListUsers:output_type -> memos.api.v1.ListUsersResponse
-	5,  // 30: memos.api.v1.UserService.SearchUsers:output_type -> memos.api.v1.SearchUsersResponse
-	1,  // 31: memos.api.v1.UserService.GetUser:output_type -> memos.api.v1.User
-	22, // 32: memos.api.v1.UserService.GetUserAvatarBinary:output_type -> google.api.HttpBody
-	1,  // 33: memos.api.v1.UserService.CreateUser:output_type -> memos.api.v1.User
-	1,  // 34: memos.api.v1.UserService.UpdateUser:output_type -> memos.api.v1.User
-	24, // 35: memos.api.v1.UserService.DeleteUser:output_type -> google.protobuf.Empty
-	11, // 36: memos.api.v1.UserService.GetUserSetting:output_type -> memos.api.v1.UserSetting
-	11, // 37: memos.api.v1.UserService.UpdateUserSetting:output_type -> memos.api.v1.UserSetting
-	17, // 38: memos.api.v1.UserService.ListUserAccessTokens:output_type -> memos.api.v1.ListUserAccessTokensResponse
-	15, // 39: memos.api.v1.UserService.CreateUserAccessToken:output_type -> memos.api.v1.UserAccessToken
-	24, // 40: memos.api.v1.UserService.DeleteUserAccessToken:output_type -> google.protobuf.Empty
-	29, // [29:41] is the sub-list for method output_type
-	17, // [17:29] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	27, // 1: memos.api.v1.User.row_status:type_name -> memos.api.v1.RowStatus
+	28, // 2: memos.api.v1.User.create_time:type_name -> google.protobuf.Timestamp
+	28, // 3: memos.api.v1.User.update_time:type_name -> google.protobuf.Timestamp
+	2,  // 4: memos.api.v1.ListUsersResponse.users:type_name -> memos.api.v1.User
+	2,  // 5: memos.api.v1.SearchUsersResponse.users:type_name -> memos.api.v1.User
+	29, // 6: memos.api.v1.GetUserAvatarBinaryRequest.http_body:type_name -> google.api.HttpBody
+	1,  // 7: memos.api.v1.GetUserMemoStatsRequest.word_count_period:type_name -> memos.api.v1.GetUserMemoStatsRequest.Period
+	24, // 8: memos.api.v1.UserMemoStats.daily_memo_counts:type_name -> memos.api.v1.UserMemoStats.DailyMemoCountsEntry
+	25, // 9: memos.api.v1.UserMemoStats.tag_counts:type_name -> memos.api.v1.UserMemoStats.TagCountsEntry
+	26, // 10: memos.api.v1.UserMemoStats.word_counts:type_name -> memos.api.v1.UserMemoStats.WordCountsEntry
+	2,  // 11: memos.api.v1.CreateUserRequest.user:type_name -> memos.api.v1.User
+	2,  // 12: memos.api.v1.UpdateUserRequest.user:type_name -> memos.api.v1.User
+	30, // 13: memos.api.v1.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
+	16, // 14: memos.api.v1.UserSetting.review_setting:type_name -> memos.api.v1.ReviewUserSetting
+	15, // 15: memos.api.v1.UpdateUserSettingRequest.setting:type_name -> memos.api.v1.UserSetting
+	30, // 16: memos.api.v1.UpdateUserSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
+	28, // 17: memos.api.v1.UserAccessToken.issued_at:type_name -> google.protobuf.Timestamp
+	28, // 18: memos.api.v1.UserAccessToken.expires_at:type_name -> google.protobuf.Timestamp
+	19, // 19: memos.api.v1.ListUserAccessTokensResponse.access_tokens:type_name -> memos.api.v1.UserAccessToken
+	28, // 20: memos.api.v1.CreateUserAccessTokenRequest.expires_at:type_name -> google.protobuf.Timestamp
+	3,  // 21: memos.api.v1.UserService.ListUsers:input_type -> memos.api.v1.ListUsersRequest
+	5,  // 22: memos.api.v1.UserService.SearchUsers:input_type -> memos.api.v1.SearchUsersRequest
+	7,  // 23: memos.api.v1.UserService.GetUser:input_type -> memos.api.v1.GetUserRequest
+	8,  // 24: memos.api.v1.UserService.GetUserAvatarBinary:input_type -> memos.api.v1.GetUserAvatarBinaryRequest
+	9,  // 25: memos.api.v1.UserService.ExportUserData:input_type -> memos.api.v1.ExportUserDataRequest
+	10, // 26: memos.api.v1.UserService.GetUserMemoStats:input_type -> memos.api.v1.GetUserMemoStatsRequest
+	12, // 27: memos.api.v1.UserService.CreateUser:input_type -> memos.api.v1.CreateUserRequest
+	13, // 28: memos.api.v1.UserService.UpdateUser:input_type -> memos.api.v1.UpdateUserRequest
+	14, // 29: memos.api.v1.UserService.DeleteUser:input_type -> memos.api.v1.DeleteUserRequest
+	17, // 30: memos.api.v1.UserService.GetUserSetting:input_type -> memos.api.v1.GetUserSettingRequest
+	18, // 31: memos.api.v1.UserService.UpdateUserSetting:input_type -> memos.api.v1.UpdateUserSettingRequest
+	20, // 32: memos.api.v1.UserService.ListUserAccessTokens:input_type -> memos.api.v1.ListUserAccessTokensRequest
+	22, // 33: memos.api.v1.UserService.CreateUserAccessToken:input_type -> memos.api.v1.CreateUserAccessTokenRequest
+	23, // 34: memos.api.v1.UserService.DeleteUserAccessToken:input_type -> memos.api.v1.DeleteUserAccessTokenRequest
+	4,  // 35: memos.api.v1.UserService.ListUsers:output_type -> memos.api.v1.ListUsersResponse
+	6,  // 36: memos.api.v1.UserService.SearchUsers:output_type -> memos.api.v1.SearchUsersResponse
+	2,  // 37: memos.api.v1.UserService.GetUser:output_type -> memos.api.v1.User
+	29, // 38: memos.api.v1.UserService.GetUserAvatarBinary:output_type -> google.api.HttpBody
+	29, // 39: memos.api.v1.UserService.ExportUserData:output_type -> google.api.HttpBody
+	11, // 40: memos.api.v1.UserService.GetUserMemoStats:output_type -> memos.api.v1.UserMemoStats
+	2,  // 41: memos.api.v1.UserService.CreateUser:output_type -> memos.api.v1.User
+	2,  // 42: memos.api.v1.UserService.UpdateUser:output_type -> memos.api.v1.User
+	31, // 43: memos.api.v1.UserService.DeleteUser:output_type -> google.protobuf.Empty
+	15, // 44: memos.api.v1.UserService.GetUserSetting:output_type -> memos.api.v1.UserSetting
+	15, // 45: memos.api.v1.UserService.UpdateUserSetting:output_type -> memos.api.v1.UserSetting
+	21, // 46: memos.api.v1.UserService.ListUserAccessTokens:output_type -> memos.api.v1.ListUserAccessTokensResponse
+	19, // 47: memos.api.v1.UserService.CreateUserAccessToken:output_type -> memos.api.v1.UserAccessToken
+	31, // 48: memos.api.v1.UserService.DeleteUserAccessToken:output_type -> google.protobuf.Empty
+	35, // [35:49] is the sub-list for method output_type
+	21, // [21:35] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_user_service_proto_init() }
@@ -1340,14 +1703,14 @@ func file_api_v1_user_service_proto_init() {
 		return
 	}
 	file_api_v1_common_proto_init()
-	file_api_v1_user_service_proto_msgTypes[17].OneofWrappers = []any{}
+	file_api_v1_user_service_proto_msgTypes[20].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_user_service_proto_rawDesc), len(file_api_v1_user_service_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   19,
+			NumEnums:      2,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
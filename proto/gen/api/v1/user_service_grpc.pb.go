@@ -25,6 +25,8 @@ const (
 	UserService_SearchUsers_FullMethodName           = "/memos.api.v1.UserService/SearchUsers"
 	UserService_GetUser_FullMethodName               = "/memos.api.v1.UserService/GetUser"
 	UserService_GetUserAvatarBinary_FullMethodName   = "/memos.api.v1.UserService/GetUserAvatarBinary"
+	UserService_ExportUserData_FullMethodName        = "/memos.api.v1.UserService/ExportUserData"
+	UserService_GetUserMemoStats_FullMethodName      = "/memos.api.v1.UserService/GetUserMemoStats"
 	UserService_CreateUser_FullMethodName            = "/memos.api.v1.UserService/CreateUser"
 	UserService_UpdateUser_FullMethodName            = "/memos.api.v1.UserService/UpdateUser"
 	UserService_DeleteUser_FullMethodName            = "/memos.api.v1.UserService/DeleteUser"
@@ -47,6 +49,10 @@ type UserServiceClient interface {
 	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*User, error)
 	// GetUserAvatarBinary gets the avatar of a user.
 	GetUserAvatarBinary(ctx context.Context, in *GetUserAvatarBinaryRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// ExportUserData streams a zip archive of the memos and resources of a user.
+	ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[httpbody.HttpBody], error)
+	// GetUserMemoStats returns the statistics of the memos of a user.
+	GetUserMemoStats(ctx context.Context, in *GetUserMemoStatsRequest, opts ...grpc.CallOption) (*UserMemoStats, error)
 	// CreateUser creates a new user.
 	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*User, error)
 	// UpdateUser updates a user.
@@ -113,6 +119,35 @@ func (c *userServiceClient) GetUserAvatarBinary(ctx context.Context, in *GetUser
 	return out, nil
 }
 
+func (c *userServiceClient) ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[httpbody.HttpBody], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UserService_ServiceDesc.Streams[0], UserService_ExportUserData_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportUserDataRequest, httpbody.HttpBody]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UserService_ExportUserDataClient = grpc.ServerStreamingClient[httpbody.HttpBody]
+
+func (c *userServiceClient) GetUserMemoStats(ctx context.Context, in *GetUserMemoStatsRequest, opts ...grpc.CallOption) (*UserMemoStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserMemoStats)
+	err := c.cc.Invoke(ctx, UserService_GetUserMemoStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*User, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(User)
@@ -205,6 +240,10 @@ type UserServiceServer interface {
 	GetUser(context.Context, *GetUserRequest) (*User, error)
 	// GetUserAvatarBinary gets the avatar of a user.
 	GetUserAvatarBinary(context.Context, *GetUserAvatarBinaryRequest) (*httpbody.HttpBody, error)
+	// ExportUserData streams a zip archive of the memos and resources of a user.
+	ExportUserData(*ExportUserDataRequest, grpc.ServerStreamingServer[httpbody.HttpBody]) error
+	// GetUserMemoStats returns the statistics of the memos of a user.
+	GetUserMemoStats(context.Context, *GetUserMemoStatsRequest) (*UserMemoStats, error)
 	// CreateUser creates a new user.
 	CreateUser(context.Context, *CreateUserRequest) (*User, error)
 	// UpdateUser updates a user.
@@ -243,6 +282,12 @@ func (UnimplementedUserServiceServer) GetUser(context.Context, *GetUserRequest)
 func (UnimplementedUserServiceServer) GetUserAvatarBinary(context.Context, *GetUserAvatarBinaryRequest) (*httpbody.HttpBody, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetUserAvatarBinary not implemented")
 }
+func (UnimplementedUserServiceServer) ExportUserData(*ExportUserDataRequest, grpc.ServerStreamingServer[httpbody.HttpBody]) error {
+	return status.Error(codes.Unimplemented, "method ExportUserData not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserMemoStats(context.Context, *GetUserMemoStatsRequest) (*UserMemoStats, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserMemoStats not implemented")
+}
 func (UnimplementedUserServiceServer) CreateUser(context.Context, *CreateUserRequest) (*User, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateUser not implemented")
 }
@@ -360,6 +405,35 @@ func _UserService_GetUserAvatarBinary_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ExportUserData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportUserDataRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UserServiceServer).ExportUserData(m, &grpc.GenericServerStream[ExportUserDataRequest, httpbody.HttpBody]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UserService_ExportUserDataServer = grpc.ServerStreamingServer[httpbody.HttpBody]
+
+func _UserService_GetUserMemoStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserMemoStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserMemoStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserMemoStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserMemoStats(ctx, req.(*GetUserMemoStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateUserRequest)
 	if err := dec(in); err != nil {
@@ -527,6 +601,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserAvatarBinary",
 			Handler:    _UserService_GetUserAvatarBinary_Handler,
 		},
+		{
+			MethodName: "GetUserMemoStats",
+			Handler:    _UserService_GetUserMemoStats_Handler,
+		},
 		{
 			MethodName: "CreateUser",
 			Handler:    _UserService_CreateUser_Handler,
@@ -560,6 +638,12 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _UserService_DeleteUserAccessToken_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportUserData",
+			Handler:       _UserService_ExportUserData_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "api/v1/user_service.proto",
 }
@@ -117,17 +117,115 @@ func (x *ActivityVersionUpdatePayload) GetVersion() string {
 	return ""
 }
 
-type ActivityPayload struct {
-	state         protoimpl.MessageState        `protogen:"open.v1"`
-	MemoComment   *ActivityMemoCommentPayload   `protobuf:"bytes,1,opt,name=memo_comment,json=memoComment,proto3" json:"memo_comment,omitempty"`
-	VersionUpdate *ActivityVersionUpdatePayload `protobuf:"bytes,2,opt,name=version_update,json=versionUpdate,proto3" json:"version_update,omitempty"`
+type ActivityMemoCollaboratorPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemoId        int32                  `protobuf:"varint,1,opt,name=memo_id,json=memoId,proto3" json:"memo_id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityMemoCollaboratorPayload) Reset() {
+	*x = ActivityMemoCollaboratorPayload{}
+	mi := &file_store_activity_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityMemoCollaboratorPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityMemoCollaboratorPayload) ProtoMessage() {}
+
+func (x *ActivityMemoCollaboratorPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_activity_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityMemoCollaboratorPayload.ProtoReflect.Descriptor instead.
+func (*ActivityMemoCollaboratorPayload) Descriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ActivityMemoCollaboratorPayload) GetMemoId() int32 {
+	if x != nil {
+		return x.MemoId
+	}
+	return 0
+}
+
+func (x *ActivityMemoCollaboratorPayload) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type ActivityMemoReminderPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemoId        int32                  `protobuf:"varint,1,opt,name=memo_id,json=memoId,proto3" json:"memo_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *ActivityMemoReminderPayload) Reset() {
+	*x = ActivityMemoReminderPayload{}
+	mi := &file_store_activity_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityMemoReminderPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityMemoReminderPayload) ProtoMessage() {}
+
+func (x *ActivityMemoReminderPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_activity_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityMemoReminderPayload.ProtoReflect.Descriptor instead.
+func (*ActivityMemoReminderPayload) Descriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ActivityMemoReminderPayload) GetMemoId() int32 {
+	if x != nil {
+		return x.MemoId
+	}
+	return 0
+}
+
+type ActivityPayload struct {
+	state            protoimpl.MessageState           `protogen:"open.v1"`
+	MemoComment      *ActivityMemoCommentPayload      `protobuf:"bytes,1,opt,name=memo_comment,json=memoComment,proto3" json:"memo_comment,omitempty"`
+	VersionUpdate    *ActivityVersionUpdatePayload    `protobuf:"bytes,2,opt,name=version_update,json=versionUpdate,proto3" json:"version_update,omitempty"`
+	MemoCollaborator *ActivityMemoCollaboratorPayload `protobuf:"bytes,3,opt,name=memo_collaborator,json=memoCollaborator,proto3" json:"memo_collaborator,omitempty"`
+	MemoReminder     *ActivityMemoReminderPayload     `protobuf:"bytes,4,opt,name=memo_reminder,json=memoReminder,proto3" json:"memo_reminder,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
 func (x *ActivityPayload) Reset() {
 	*x = ActivityPayload{}
-	mi := &file_store_activity_proto_msgTypes[2]
+	mi := &file_store_activity_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -139,7 +237,7 @@ func (x *ActivityPayload) String() string {
 func (*ActivityPayload) ProtoMessage() {}
 
 func (x *ActivityPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_store_activity_proto_msgTypes[2]
+	mi := &file_store_activity_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -152,7 +250,7 @@ func (x *ActivityPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActivityPayload.ProtoReflect.Descriptor instead.
 func (*ActivityPayload) Descriptor() ([]byte, []int) {
-	return file_store_activity_proto_rawDescGZIP(), []int{2}
+	return file_store_activity_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ActivityPayload) GetMemoComment() *ActivityMemoCommentPayload {
@@ -169,6 +267,20 @@ func (x *ActivityPayload) GetVersionUpdate() *ActivityVersionUpdatePayload {
 	return nil
 }
 
+func (x *ActivityPayload) GetMemoCollaborator() *ActivityMemoCollaboratorPayload {
+	if x != nil {
+		return x.MemoCollaborator
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetMemoReminder() *ActivityMemoReminderPayload {
+	if x != nil {
+		return x.MemoReminder
+	}
+	return nil
+}
+
 var File_store_activity_proto protoreflect.FileDescriptor
 
 const file_store_activity_proto_rawDesc = "" +
@@ -178,10 +290,17 @@ const file_store_activity_proto_rawDesc = "" +
 	"\amemo_id\x18\x01 \x01(\x05R\x06memoId\x12&\n" +
 	"\x0frelated_memo_id\x18\x02 \x01(\x05R\rrelatedMemoId\"8\n" +
 	"\x1cActivityVersionUpdatePayload\x12\x18\n" +
-	"\aversion\x18\x01 \x01(\tR\aversion\"\xaf\x01\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\"N\n" +
+	"\x1fActivityMemoCollaboratorPayload\x12\x17\n" +
+	"\amemo_id\x18\x01 \x01(\x05R\x06memoId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\"6\n" +
+	"\x1bActivityMemoReminderPayload\x12\x17\n" +
+	"\amemo_id\x18\x01 \x01(\x05R\x06memoId\"\xd9\x02\n" +
 	"\x0fActivityPayload\x12J\n" +
 	"\fmemo_comment\x18\x01 \x01(\v2'.memos.store.ActivityMemoCommentPayloadR\vmemoComment\x12P\n" +
-	"\x0eversion_update\x18\x02 \x01(\v2).memos.store.ActivityVersionUpdatePayloadR\rversionUpdateB\x98\x01\n" +
+	"\x0eversion_update\x18\x02 \x01(\v2).memos.store.ActivityVersionUpdatePayloadR\rversionUpdate\x12Y\n" +
+	"\x11memo_collaborator\x18\x03 \x01(\v2,.memos.store.ActivityMemoCollaboratorPayloadR\x10memoCollaborator\x12M\n" +
+	"\rmemo_reminder\x18\x04 \x01(\v2(.memos.store.ActivityMemoReminderPayloadR\fmemoReminderB\x98\x01\n" +
 	"\x0fcom.memos.storeB\rActivityProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
 
 var (
@@ -196,20 +315,24 @@ func file_store_activity_proto_rawDescGZIP() []byte {
 	return file_store_activity_proto_rawDescData
 }
 
-var file_store_activity_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_store_activity_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_store_activity_proto_goTypes = []any{
-	(*ActivityMemoCommentPayload)(nil),   // 0: memos.store.ActivityMemoCommentPayload
-	(*ActivityVersionUpdatePayload)(nil), // 1: memos.store.ActivityVersionUpdatePayload
-	(*ActivityPayload)(nil),              // 2: memos.store.ActivityPayload
+	(*ActivityMemoCommentPayload)(nil),      // 0: memos.store.ActivityMemoCommentPayload
+	(*ActivityVersionUpdatePayload)(nil),    // 1: memos.store.ActivityVersionUpdatePayload
+	(*ActivityMemoCollaboratorPayload)(nil), // 2: memos.store.ActivityMemoCollaboratorPayload
+	(*ActivityMemoReminderPayload)(nil),     // 3: memos.store.ActivityMemoReminderPayload
+	(*ActivityPayload)(nil),                 // 4: memos.store.ActivityPayload
 }
 var file_store_activity_proto_depIdxs = []int32{
 	0, // 0: memos.store.ActivityPayload.memo_comment:type_name -> memos.store.ActivityMemoCommentPayload
 	1, // 1: memos.store.ActivityPayload.version_update:type_name -> memos.store.ActivityVersionUpdatePayload
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	2, // 2: memos.store.ActivityPayload.memo_collaborator:type_name -> memos.store.ActivityMemoCollaboratorPayload
+	3, // 3: memos.store.ActivityPayload.memo_reminder:type_name -> memos.store.ActivityMemoReminderPayload
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_store_activity_proto_init() }
@@ -223,7 +346,7 @@ func file_store_activity_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_activity_proto_rawDesc), len(file_store_activity_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -24,9 +24,11 @@ const (
 type InboxMessage_Type int32
 
 const (
-	InboxMessage_TYPE_UNSPECIFIED InboxMessage_Type = 0
-	InboxMessage_MEMO_COMMENT     InboxMessage_Type = 1
-	InboxMessage_VERSION_UPDATE   InboxMessage_Type = 2
+	InboxMessage_TYPE_UNSPECIFIED  InboxMessage_Type = 0
+	InboxMessage_MEMO_COMMENT      InboxMessage_Type = 1
+	InboxMessage_VERSION_UPDATE    InboxMessage_Type = 2
+	InboxMessage_MEMO_COLLABORATOR InboxMessage_Type = 3
+	InboxMessage_REMINDER          InboxMessage_Type = 4
 )
 
 // Enum value maps for InboxMessage_Type.
@@ -35,11 +37,15 @@ var (
 		0: "TYPE_UNSPECIFIED",
 		1: "MEMO_COMMENT",
 		2: "VERSION_UPDATE",
+		3: "MEMO_COLLABORATOR",
+		4: "REMINDER",
 	}
 	InboxMessage_Type_value = map[string]int32{
-		"TYPE_UNSPECIFIED": 0,
-		"MEMO_COMMENT":     1,
-		"VERSION_UPDATE":   2,
+		"TYPE_UNSPECIFIED":  0,
+		"MEMO_COMMENT":      1,
+		"VERSION_UPDATE":    2,
+		"MEMO_COLLABORATOR": 3,
+		"REMINDER":          4,
 	}
 )
 
@@ -126,15 +132,17 @@ var File_store_inbox_proto protoreflect.FileDescriptor
 
 const file_store_inbox_proto_rawDesc = "" +
 	"\n" +
-	"\x11store/inbox.proto\x12\vmemos.store\"\xbc\x01\n" +
+	"\x11store/inbox.proto\x12\vmemos.store\"\xe1\x01\n" +
 	"\fInboxMessage\x122\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x1e.memos.store.InboxMessage.TypeR\x04type\x12$\n" +
 	"\vactivity_id\x18\x02 \x01(\x05H\x00R\n" +
-	"activityId\x88\x01\x01\"B\n" +
+	"activityId\x88\x01\x01\"g\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
 	"\fMEMO_COMMENT\x10\x01\x12\x12\n" +
-	"\x0eVERSION_UPDATE\x10\x02B\x0e\n" +
+	"\x0eVERSION_UPDATE\x10\x02\x12\x15\n" +
+	"\x11MEMO_COLLABORATOR\x10\x03\x12\f\n" +
+	"\bREMINDER\x10\x04B\x0e\n" +
 	"\f_activity_idB\x95\x01\n" +
 	"\x0fcom.memos.storeB\n" +
 	"InboxProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
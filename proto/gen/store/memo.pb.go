@@ -26,10 +26,14 @@ type MemoPayload struct {
 	Property *MemoPayload_Property  `protobuf:"bytes,1,opt,name=property,proto3" json:"property,omitempty"`
 	Location *MemoPayload_Location  `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
 	Tags     []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
-	// The references of the memo. Should be a list of uuid.
-	References    []string `protobuf:"bytes,4,rep,name=references,proto3" json:"references,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// The references of the memo to other memos, either memo names in the format memos/{id} or memo uids.
+	References []string `protobuf:"bytes,4,rep,name=references,proto3" json:"references,omitempty"`
+	// The time the creator asked to be reminded of the memo, 0 if none.
+	RemindTs int64 `protobuf:"varint,5,opt,name=remind_ts,json=remindTs,proto3" json:"remind_ts,omitempty"`
+	// The ids of the webhooks of the memo rules the memo is sent to once it is published, while it is scheduled.
+	PublishWebhookIds []int32 `protobuf:"varint,6,rep,packed,name=publish_webhook_ids,json=publishWebhookIds,proto3" json:"publish_webhook_ids,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *MemoPayload) Reset() {
@@ -90,15 +94,36 @@ func (x *MemoPayload) GetReferences() []string {
 	return nil
 }
 
+func (x *MemoPayload) GetRemindTs() int64 {
+	if x != nil {
+		return x.RemindTs
+	}
+	return 0
+}
+
+func (x *MemoPayload) GetPublishWebhookIds() []int32 {
+	if x != nil {
+		return x.PublishWebhookIds
+	}
+	return nil
+}
+
 type MemoPayload_Property struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	HasLink            bool                   `protobuf:"varint,1,opt,name=has_link,json=hasLink,proto3" json:"has_link,omitempty"`
-	HasTaskList        bool                   `protobuf:"varint,2,opt,name=has_task_list,json=hasTaskList,proto3" json:"has_task_list,omitempty"`
-	HasCode            bool                   `protobuf:"varint,3,opt,name=has_code,json=hasCode,proto3" json:"has_code,omitempty"`
-	HasIncompleteTasks bool                   `protobuf:"varint,4,opt,name=has_incomplete_tasks,json=hasIncompleteTasks,proto3" json:"has_incomplete_tasks,omitempty"`
-	HasImage           bool                   `protobuf:"varint,5,opt,name=has_image,json=hasImage,proto3" json:"has_image,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	HasLink             bool                   `protobuf:"varint,1,opt,name=has_link,json=hasLink,proto3" json:"has_link,omitempty"`
+	HasTaskList         bool                   `protobuf:"varint,2,opt,name=has_task_list,json=hasTaskList,proto3" json:"has_task_list,omitempty"`
+	HasCode             bool                   `protobuf:"varint,3,opt,name=has_code,json=hasCode,proto3" json:"has_code,omitempty"`
+	HasIncompleteTasks  bool                   `protobuf:"varint,4,opt,name=has_incomplete_tasks,json=hasIncompleteTasks,proto3" json:"has_incomplete_tasks,omitempty"`
+	HasImage            bool                   `protobuf:"varint,5,opt,name=has_image,json=hasImage,proto3" json:"has_image,omitempty"`
+	IncompleteTaskCount int32                  `protobuf:"varint,6,opt,name=incomplete_task_count,json=incompleteTaskCount,proto3" json:"incomplete_task_count,omitempty"`
+	WordCount           int32                  `protobuf:"varint,7,opt,name=word_count,json=wordCount,proto3" json:"word_count,omitempty"`
+	// The due times of the incomplete tasks, at the start of their due dates in UTC.
+	TaskDueTs []int64 `protobuf:"varint,8,rep,packed,name=task_due_ts,json=taskDueTs,proto3" json:"task_due_ts,omitempty"`
+	// The SimHash fingerprint of the terms of the content, 0 if it has no terms.
+	// Near-duplicate memos have fingerprints differing in few bits.
+	Simhash       uint64 `protobuf:"varint,9,opt,name=simhash,proto3" json:"simhash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MemoPayload_Property) Reset() {
@@ -166,6 +191,34 @@ func (x *MemoPayload_Property) GetHasImage() bool {
 	return false
 }
 
+func (x *MemoPayload_Property) GetIncompleteTaskCount() int32 {
+	if x != nil {
+		return x.IncompleteTaskCount
+	}
+	return 0
+}
+
+func (x *MemoPayload_Property) GetWordCount() int32 {
+	if x != nil {
+		return x.WordCount
+	}
+	return 0
+}
+
+func (x *MemoPayload_Property) GetTaskDueTs() []int64 {
+	if x != nil {
+		return x.TaskDueTs
+	}
+	return nil
+}
+
+func (x *MemoPayload_Property) GetSimhash() uint64 {
+	if x != nil {
+		return x.Simhash
+	}
+	return 0
+}
+
 type MemoPayload_Location struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Placeholder   string                 `protobuf:"bytes,1,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
@@ -230,20 +283,27 @@ var File_store_memo_proto protoreflect.FileDescriptor
 
 const file_store_memo_proto_rawDesc = "" +
 	"\n" +
-	"\x10store/memo.proto\x12\vmemos.store\"\xdd\x03\n" +
+	"\x10store/memo.proto\x12\vmemos.store\"\xb7\x05\n" +
 	"\vMemoPayload\x12=\n" +
 	"\bproperty\x18\x01 \x01(\v2!.memos.store.MemoPayload.PropertyR\bproperty\x12=\n" +
 	"\blocation\x18\x02 \x01(\v2!.memos.store.MemoPayload.LocationR\blocation\x12\x12\n" +
 	"\x04tags\x18\x03 \x03(\tR\x04tags\x12\x1e\n" +
 	"\n" +
 	"references\x18\x04 \x03(\tR\n" +
-	"references\x1a\xb3\x01\n" +
+	"references\x12\x1b\n" +
+	"\tremind_ts\x18\x05 \x01(\x03R\bremindTs\x12.\n" +
+	"\x13publish_webhook_ids\x18\x06 \x03(\x05R\x11publishWebhookIds\x1a\xc0\x02\n" +
 	"\bProperty\x12\x19\n" +
 	"\bhas_link\x18\x01 \x01(\bR\ahasLink\x12\"\n" +
 	"\rhas_task_list\x18\x02 \x01(\bR\vhasTaskList\x12\x19\n" +
 	"\bhas_code\x18\x03 \x01(\bR\ahasCode\x120\n" +
 	"\x14has_incomplete_tasks\x18\x04 \x01(\bR\x12hasIncompleteTasks\x12\x1b\n" +
-	"\thas_image\x18\x05 \x01(\bR\bhasImage\x1af\n" +
+	"\thas_image\x18\x05 \x01(\bR\bhasImage\x122\n" +
+	"\x15incomplete_task_count\x18\x06 \x01(\x05R\x13incompleteTaskCount\x12\x1d\n" +
+	"\n" +
+	"word_count\x18\a \x01(\x05R\twordCount\x12\x1e\n" +
+	"\vtask_due_ts\x18\b \x03(\x03R\ttaskDueTs\x12\x18\n" +
+	"\asimhash\x18\t \x01(\x04R\asimhash\x1af\n" +
 	"\bLocation\x12 \n" +
 	"\vplaceholder\x18\x01 \x01(\tR\vplaceholder\x12\x1a\n" +
 	"\blatitude\x18\x02 \x01(\x01R\blatitude\x12\x1c\n" +
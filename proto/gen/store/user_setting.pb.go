@@ -35,6 +35,12 @@ const (
 	UserSettingKey_MEMO_VISIBILITY UserSettingKey = 4
 	// The review settings of the user.
 	UserSettingKey_REVIEW_SETTING UserSettingKey = 5
+	// The saved searches of the user.
+	UserSettingKey_SHORTCUTS UserSettingKey = 6
+	// The rules applied to the memos of the user when they are saved.
+	UserSettingKey_MEMO_RULES UserSettingKey = 7
+	// Whether the uids of new memos are slugs of their first heading.
+	UserSettingKey_MEMO_SLUG UserSettingKey = 8
 )
 
 // Enum value maps for UserSettingKey.
@@ -46,6 +52,9 @@ var (
 		3: "APPEARANCE",
 		4: "MEMO_VISIBILITY",
 		5: "REVIEW_SETTING",
+		6: "SHORTCUTS",
+		7: "MEMO_RULES",
+		8: "MEMO_SLUG",
 	}
 	UserSettingKey_value = map[string]int32{
 		"USER_SETTING_KEY_UNSPECIFIED": 0,
@@ -54,6 +63,9 @@ var (
 		"APPEARANCE":                   3,
 		"MEMO_VISIBILITY":              4,
 		"REVIEW_SETTING":               5,
+		"SHORTCUTS":                    6,
+		"MEMO_RULES":                   7,
+		"MEMO_SLUG":                    8,
 	}
 )
 
@@ -95,6 +107,9 @@ type UserSetting struct {
 	//	*UserSetting_Appearance
 	//	*UserSetting_MemoVisibility
 	//	*UserSetting_ReviewSetting
+	//	*UserSetting_Shortcuts
+	//	*UserSetting_MemoRules
+	//	*UserSetting_MemoSlug
 	Value         isUserSetting_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -196,6 +211,33 @@ func (x *UserSetting) GetReviewSetting() *ReviewUserSetting {
 	return nil
 }
 
+func (x *UserSetting) GetShortcuts() *ShortcutsUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_Shortcuts); ok {
+			return x.Shortcuts
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetMemoRules() *MemoRulesUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_MemoRules); ok {
+			return x.MemoRules
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetMemoSlug() bool {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_MemoSlug); ok {
+			return x.MemoSlug
+		}
+	}
+	return false
+}
+
 type isUserSetting_Value interface {
 	isUserSetting_Value()
 }
@@ -220,6 +262,18 @@ type UserSetting_ReviewSetting struct {
 	ReviewSetting *ReviewUserSetting `protobuf:"bytes,7,opt,name=review_setting,json=reviewSetting,proto3,oneof"`
 }
 
+type UserSetting_Shortcuts struct {
+	Shortcuts *ShortcutsUserSetting `protobuf:"bytes,8,opt,name=shortcuts,proto3,oneof"`
+}
+
+type UserSetting_MemoRules struct {
+	MemoRules *MemoRulesUserSetting `protobuf:"bytes,9,opt,name=memo_rules,json=memoRules,proto3,oneof"`
+}
+
+type UserSetting_MemoSlug struct {
+	MemoSlug bool `protobuf:"varint,10,opt,name=memo_slug,json=memoSlug,proto3,oneof"`
+}
+
 func (*UserSetting_AccessTokens) isUserSetting_Value() {}
 
 func (*UserSetting_Locale) isUserSetting_Value() {}
@@ -230,6 +284,12 @@ func (*UserSetting_MemoVisibility) isUserSetting_Value() {}
 
 func (*UserSetting_ReviewSetting) isUserSetting_Value() {}
 
+func (*UserSetting_Shortcuts) isUserSetting_Value() {}
+
+func (*UserSetting_MemoRules) isUserSetting_Value() {}
+
+func (*UserSetting_MemoSlug) isUserSetting_Value() {}
+
 type ReviewUserSetting struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionSize   int32                  `protobuf:"varint,1,opt,name=session_size,json=sessionSize,proto3" json:"session_size,omitempty"`
@@ -290,6 +350,96 @@ func (x *ReviewUserSetting) GetExcludeTags() []string {
 	return nil
 }
 
+type ShortcutsUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The shortcuts in the order chosen by the user.
+	Shortcuts     []*ShortcutsUserSetting_Shortcut `protobuf:"bytes,1,rep,name=shortcuts,proto3" json:"shortcuts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShortcutsUserSetting) Reset() {
+	*x = ShortcutsUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortcutsUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortcutsUserSetting) ProtoMessage() {}
+
+func (x *ShortcutsUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortcutsUserSetting.ProtoReflect.Descriptor instead.
+func (*ShortcutsUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ShortcutsUserSetting) GetShortcuts() []*ShortcutsUserSetting_Shortcut {
+	if x != nil {
+		return x.Shortcuts
+	}
+	return nil
+}
+
+type MemoRulesUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The rules in the order they are applied.
+	Rules         []*MemoRulesUserSetting_Rule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoRulesUserSetting) Reset() {
+	*x = MemoRulesUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoRulesUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoRulesUserSetting) ProtoMessage() {}
+
+func (x *MemoRulesUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoRulesUserSetting.ProtoReflect.Descriptor instead.
+func (*MemoRulesUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MemoRulesUserSetting) GetRules() []*MemoRulesUserSetting_Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
 type AccessTokensUserSetting struct {
 	state         protoimpl.MessageState                 `protogen:"open.v1"`
 	AccessTokens  []*AccessTokensUserSetting_AccessToken `protobuf:"bytes,1,rep,name=access_tokens,json=accessTokens,proto3" json:"access_tokens,omitempty"`
@@ -299,7 +449,7 @@ type AccessTokensUserSetting struct {
 
 func (x *AccessTokensUserSetting) Reset() {
 	*x = AccessTokensUserSetting{}
-	mi := &file_store_user_setting_proto_msgTypes[2]
+	mi := &file_store_user_setting_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -311,7 +461,7 @@ func (x *AccessTokensUserSetting) String() string {
 func (*AccessTokensUserSetting) ProtoMessage() {}
 
 func (x *AccessTokensUserSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[2]
+	mi := &file_store_user_setting_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -324,7 +474,7 @@ func (x *AccessTokensUserSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AccessTokensUserSetting.ProtoReflect.Descriptor instead.
 func (*AccessTokensUserSetting) Descriptor() ([]byte, []int) {
-	return file_store_user_setting_proto_rawDescGZIP(), []int{2}
+	return file_store_user_setting_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *AccessTokensUserSetting) GetAccessTokens() []*AccessTokensUserSetting_AccessToken {
@@ -334,6 +484,343 @@ func (x *AccessTokensUserSetting) GetAccessTokens() []*AccessTokensUserSetting_A
 	return nil
 }
 
+type ShortcutsUserSetting_Shortcut struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The unique identifier of the shortcut among the shortcuts of the user.
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// The memo filter, in the same format as the filter of ListMemos.
+	Filter        string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShortcutsUserSetting_Shortcut) Reset() {
+	*x = ShortcutsUserSetting_Shortcut{}
+	mi := &file_store_user_setting_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortcutsUserSetting_Shortcut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortcutsUserSetting_Shortcut) ProtoMessage() {}
+
+func (x *ShortcutsUserSetting_Shortcut) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortcutsUserSetting_Shortcut.ProtoReflect.Descriptor instead.
+func (*ShortcutsUserSetting_Shortcut) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *ShortcutsUserSetting_Shortcut) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ShortcutsUserSetting_Shortcut) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ShortcutsUserSetting_Shortcut) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+type MemoRulesUserSetting_Rule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The unique identifier of the rule among the rules of the user.
+	Id            string                          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                          `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Condition     *MemoRulesUserSetting_Condition `protobuf:"bytes,3,opt,name=condition,proto3" json:"condition,omitempty"`
+	Actions       []*MemoRulesUserSetting_Action  `protobuf:"bytes,4,rep,name=actions,proto3" json:"actions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoRulesUserSetting_Rule) Reset() {
+	*x = MemoRulesUserSetting_Rule{}
+	mi := &file_store_user_setting_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoRulesUserSetting_Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoRulesUserSetting_Rule) ProtoMessage() {}
+
+func (x *MemoRulesUserSetting_Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoRulesUserSetting_Rule.ProtoReflect.Descriptor instead.
+func (*MemoRulesUserSetting_Rule) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *MemoRulesUserSetting_Rule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MemoRulesUserSetting_Rule) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *MemoRulesUserSetting_Rule) GetCondition() *MemoRulesUserSetting_Condition {
+	if x != nil {
+		return x.Condition
+	}
+	return nil
+}
+
+func (x *MemoRulesUserSetting_Rule) GetActions() []*MemoRulesUserSetting_Action {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+// The condition a memo must meet for the actions of a rule to apply.
+// All the set fields must match, and an empty condition matches every memo.
+type MemoRulesUserSetting_Condition struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The RE2 regular expression the content must match.
+	ContentPattern string `protobuf:"bytes,1,opt,name=content_pattern,json=contentPattern,proto3" json:"content_pattern,omitempty"`
+	HasLink        bool   `protobuf:"varint,2,opt,name=has_link,json=hasLink,proto3" json:"has_link,omitempty"`
+	HasCode        bool   `protobuf:"varint,3,opt,name=has_code,json=hasCode,proto3" json:"has_code,omitempty"`
+	// The client the memo must be sent from, as in the source of the memo requests.
+	Source        string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoRulesUserSetting_Condition) Reset() {
+	*x = MemoRulesUserSetting_Condition{}
+	mi := &file_store_user_setting_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoRulesUserSetting_Condition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoRulesUserSetting_Condition) ProtoMessage() {}
+
+func (x *MemoRulesUserSetting_Condition) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoRulesUserSetting_Condition.ProtoReflect.Descriptor instead.
+func (*MemoRulesUserSetting_Condition) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{3, 1}
+}
+
+func (x *MemoRulesUserSetting_Condition) GetContentPattern() string {
+	if x != nil {
+		return x.ContentPattern
+	}
+	return ""
+}
+
+func (x *MemoRulesUserSetting_Condition) GetHasLink() bool {
+	if x != nil {
+		return x.HasLink
+	}
+	return false
+}
+
+func (x *MemoRulesUserSetting_Condition) GetHasCode() bool {
+	if x != nil {
+		return x.HasCode
+	}
+	return false
+}
+
+func (x *MemoRulesUserSetting_Condition) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type MemoRulesUserSetting_Action struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Action:
+	//
+	//	*MemoRulesUserSetting_Action_AddTag
+	//	*MemoRulesUserSetting_Action_SetVisibility
+	//	*MemoRulesUserSetting_Action_Pin
+	//	*MemoRulesUserSetting_Action_AddRelation
+	//	*MemoRulesUserSetting_Action_DispatchWebhook
+	Action        isMemoRulesUserSetting_Action_Action `protobuf_oneof:"action"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoRulesUserSetting_Action) Reset() {
+	*x = MemoRulesUserSetting_Action{}
+	mi := &file_store_user_setting_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoRulesUserSetting_Action) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoRulesUserSetting_Action) ProtoMessage() {}
+
+func (x *MemoRulesUserSetting_Action) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoRulesUserSetting_Action.ProtoReflect.Descriptor instead.
+func (*MemoRulesUserSetting_Action) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{3, 2}
+}
+
+func (x *MemoRulesUserSetting_Action) GetAction() isMemoRulesUserSetting_Action_Action {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+func (x *MemoRulesUserSetting_Action) GetAddTag() string {
+	if x != nil {
+		if x, ok := x.Action.(*MemoRulesUserSetting_Action_AddTag); ok {
+			return x.AddTag
+		}
+	}
+	return ""
+}
+
+func (x *MemoRulesUserSetting_Action) GetSetVisibility() string {
+	if x != nil {
+		if x, ok := x.Action.(*MemoRulesUserSetting_Action_SetVisibility); ok {
+			return x.SetVisibility
+		}
+	}
+	return ""
+}
+
+func (x *MemoRulesUserSetting_Action) GetPin() bool {
+	if x != nil {
+		if x, ok := x.Action.(*MemoRulesUserSetting_Action_Pin); ok {
+			return x.Pin
+		}
+	}
+	return false
+}
+
+func (x *MemoRulesUserSetting_Action) GetAddRelation() int32 {
+	if x != nil {
+		if x, ok := x.Action.(*MemoRulesUserSetting_Action_AddRelation); ok {
+			return x.AddRelation
+		}
+	}
+	return 0
+}
+
+func (x *MemoRulesUserSetting_Action) GetDispatchWebhook() int32 {
+	if x != nil {
+		if x, ok := x.Action.(*MemoRulesUserSetting_Action_DispatchWebhook); ok {
+			return x.DispatchWebhook
+		}
+	}
+	return 0
+}
+
+type isMemoRulesUserSetting_Action_Action interface {
+	isMemoRulesUserSetting_Action_Action()
+}
+
+type MemoRulesUserSetting_Action_AddTag struct {
+	// The tag added to the content, without the leading #.
+	AddTag string `protobuf:"bytes,1,opt,name=add_tag,json=addTag,proto3,oneof"`
+}
+
+type MemoRulesUserSetting_Action_SetVisibility struct {
+	// The visibility of the memo, as in the visibility of the memo store.
+	SetVisibility string `protobuf:"bytes,2,opt,name=set_visibility,json=setVisibility,proto3,oneof"`
+}
+
+type MemoRulesUserSetting_Action_Pin struct {
+	Pin bool `protobuf:"varint,3,opt,name=pin,proto3,oneof"`
+}
+
+type MemoRulesUserSetting_Action_AddRelation struct {
+	// The ID of the memo the memo references.
+	AddRelation int32 `protobuf:"varint,4,opt,name=add_relation,json=addRelation,proto3,oneof"`
+}
+
+type MemoRulesUserSetting_Action_DispatchWebhook struct {
+	// The ID of the webhook the memo is sent to.
+	DispatchWebhook int32 `protobuf:"varint,5,opt,name=dispatch_webhook,json=dispatchWebhook,proto3,oneof"`
+}
+
+func (*MemoRulesUserSetting_Action_AddTag) isMemoRulesUserSetting_Action_Action() {}
+
+func (*MemoRulesUserSetting_Action_SetVisibility) isMemoRulesUserSetting_Action_Action() {}
+
+func (*MemoRulesUserSetting_Action_Pin) isMemoRulesUserSetting_Action_Action() {}
+
+func (*MemoRulesUserSetting_Action_AddRelation) isMemoRulesUserSetting_Action_Action() {}
+
+func (*MemoRulesUserSetting_Action_DispatchWebhook) isMemoRulesUserSetting_Action_Action() {}
+
 type AccessTokensUserSetting_AccessToken struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The access token is a JWT token.
@@ -347,7 +834,7 @@ type AccessTokensUserSetting_AccessToken struct {
 
 func (x *AccessTokensUserSetting_AccessToken) Reset() {
 	*x = AccessTokensUserSetting_AccessToken{}
-	mi := &file_store_user_setting_proto_msgTypes[3]
+	mi := &file_store_user_setting_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -359,7 +846,7 @@ func (x *AccessTokensUserSetting_AccessToken) String() string {
 func (*AccessTokensUserSetting_AccessToken) ProtoMessage() {}
 
 func (x *AccessTokensUserSetting_AccessToken) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[3]
+	mi := &file_store_user_setting_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -372,7 +859,7 @@ func (x *AccessTokensUserSetting_AccessToken) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use AccessTokensUserSetting_AccessToken.ProtoReflect.Descriptor instead.
 func (*AccessTokensUserSetting_AccessToken) Descriptor() ([]byte, []int) {
-	return file_store_user_setting_proto_rawDescGZIP(), []int{2, 0}
+	return file_store_user_setting_proto_rawDescGZIP(), []int{4, 0}
 }
 
 func (x *AccessTokensUserSetting_AccessToken) GetAccessToken() string {
@@ -393,7 +880,7 @@ var File_store_user_setting_proto protoreflect.FileDescriptor
 
 const file_store_user_setting_proto_rawDesc = "" +
 	"\n" +
-	"\x18store/user_setting.proto\x12\vmemos.store\"\xdb\x02\n" +
+	"\x18store/user_setting.proto\x12\vmemos.store\"\x81\x04\n" +
 	"\vUserSetting\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12-\n" +
 	"\x03key\x18\x02 \x01(\x0e2\x1b.memos.store.UserSettingKeyR\x03key\x12K\n" +
@@ -403,17 +890,47 @@ const file_store_user_setting_proto_rawDesc = "" +
 	"appearance\x18\x05 \x01(\tH\x00R\n" +
 	"appearance\x12)\n" +
 	"\x0fmemo_visibility\x18\x06 \x01(\tH\x00R\x0ememoVisibility\x12G\n" +
-	"\x0ereview_setting\x18\a \x01(\v2\x1e.memos.store.ReviewUserSettingH\x00R\rreviewSettingB\a\n" +
+	"\x0ereview_setting\x18\a \x01(\v2\x1e.memos.store.ReviewUserSettingH\x00R\rreviewSetting\x12A\n" +
+	"\tshortcuts\x18\b \x01(\v2!.memos.store.ShortcutsUserSettingH\x00R\tshortcuts\x12B\n" +
+	"\n" +
+	"memo_rules\x18\t \x01(\v2!.memos.store.MemoRulesUserSettingH\x00R\tmemoRules\x12\x1d\n" +
+	"\tmemo_slug\x18\n" +
+	" \x01(\bH\x00R\bmemoSlugB\a\n" +
 	"\x05value\"|\n" +
 	"\x11ReviewUserSetting\x12!\n" +
 	"\fsession_size\x18\x01 \x01(\x05R\vsessionSize\x12!\n" +
 	"\finclude_tags\x18\x02 \x03(\tR\vincludeTags\x12!\n" +
-	"\fexclude_tags\x18\x03 \x03(\tR\vexcludeTags\"\xc4\x01\n" +
+	"\fexclude_tags\x18\x03 \x03(\tR\vexcludeTags\"\xaa\x01\n" +
+	"\x14ShortcutsUserSetting\x12H\n" +
+	"\tshortcuts\x18\x01 \x03(\v2*.memos.store.ShortcutsUserSetting.ShortcutR\tshortcuts\x1aH\n" +
+	"\bShortcut\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x16\n" +
+	"\x06filter\x18\x03 \x01(\tR\x06filter\"\xd6\x04\n" +
+	"\x14MemoRulesUserSetting\x12<\n" +
+	"\x05rules\x18\x01 \x03(\v2&.memos.store.MemoRulesUserSetting.RuleR\x05rules\x1a\xbb\x01\n" +
+	"\x04Rule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12I\n" +
+	"\tcondition\x18\x03 \x01(\v2+.memos.store.MemoRulesUserSetting.ConditionR\tcondition\x12B\n" +
+	"\aactions\x18\x04 \x03(\v2(.memos.store.MemoRulesUserSetting.ActionR\aactions\x1a\x82\x01\n" +
+	"\tCondition\x12'\n" +
+	"\x0fcontent_pattern\x18\x01 \x01(\tR\x0econtentPattern\x12\x19\n" +
+	"\bhas_link\x18\x02 \x01(\bR\ahasLink\x12\x19\n" +
+	"\bhas_code\x18\x03 \x01(\bR\ahasCode\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x1a\xbc\x01\n" +
+	"\x06Action\x12\x19\n" +
+	"\aadd_tag\x18\x01 \x01(\tH\x00R\x06addTag\x12'\n" +
+	"\x0eset_visibility\x18\x02 \x01(\tH\x00R\rsetVisibility\x12\x12\n" +
+	"\x03pin\x18\x03 \x01(\bH\x00R\x03pin\x12#\n" +
+	"\fadd_relation\x18\x04 \x01(\x05H\x00R\vaddRelation\x12+\n" +
+	"\x10dispatch_webhook\x18\x05 \x01(\x05H\x00R\x0fdispatchWebhookB\b\n" +
+	"\x06action\"\xc4\x01\n" +
 	"\x17AccessTokensUserSetting\x12U\n" +
 	"\raccess_tokens\x18\x01 \x03(\v20.memos.store.AccessTokensUserSetting.AccessTokenR\faccessTokens\x1aR\n" +
 	"\vAccessToken\x12!\n" +
 	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription*\x8a\x01\n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription*\xb8\x01\n" +
 	"\x0eUserSettingKey\x12 \n" +
 	"\x1cUSER_SETTING_KEY_UNSPECIFIED\x10\x00\x12\x11\n" +
 	"\rACCESS_TOKENS\x10\x01\x12\n" +
@@ -422,7 +939,11 @@ const file_store_user_setting_proto_rawDesc = "" +
 	"\n" +
 	"APPEARANCE\x10\x03\x12\x13\n" +
 	"\x0fMEMO_VISIBILITY\x10\x04\x12\x12\n" +
-	"\x0eREVIEW_SETTING\x10\x05B\x9b\x01\n" +
+	"\x0eREVIEW_SETTING\x10\x05\x12\r\n" +
+	"\tSHORTCUTS\x10\x06\x12\x0e\n" +
+	"\n" +
+	"MEMO_RULES\x10\a\x12\r\n" +
+	"\tMEMO_SLUG\x10\bB\x9b\x01\n" +
 	"\x0fcom.memos.storeB\x10UserSettingProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
 
 var (
@@ -438,24 +959,36 @@ func file_store_user_setting_proto_rawDescGZIP() []byte {
 }
 
 var file_store_user_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_store_user_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_store_user_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_store_user_setting_proto_goTypes = []any{
 	(UserSettingKey)(0),                         // 0: memos.store.UserSettingKey
 	(*UserSetting)(nil),                         // 1: memos.store.UserSetting
 	(*ReviewUserSetting)(nil),                   // 2: memos.store.ReviewUserSetting
-	(*AccessTokensUserSetting)(nil),             // 3: memos.store.AccessTokensUserSetting
-	(*AccessTokensUserSetting_AccessToken)(nil), // 4: memos.store.AccessTokensUserSetting.AccessToken
+	(*ShortcutsUserSetting)(nil),                // 3: memos.store.ShortcutsUserSetting
+	(*MemoRulesUserSetting)(nil),                // 4: memos.store.MemoRulesUserSetting
+	(*AccessTokensUserSetting)(nil),             // 5: memos.store.AccessTokensUserSetting
+	(*ShortcutsUserSetting_Shortcut)(nil),       // 6: memos.store.ShortcutsUserSetting.Shortcut
+	(*MemoRulesUserSetting_Rule)(nil),           // 7: memos.store.MemoRulesUserSetting.Rule
+	(*MemoRulesUserSetting_Condition)(nil),      // 8: memos.store.MemoRulesUserSetting.Condition
+	(*MemoRulesUserSetting_Action)(nil),         // 9: memos.store.MemoRulesUserSetting.Action
+	(*AccessTokensUserSetting_AccessToken)(nil), // 10: memos.store.AccessTokensUserSetting.AccessToken
 }
 var file_store_user_setting_proto_depIdxs = []int32{
-	0, // 0: memos.store.UserSetting.key:type_name -> memos.store.UserSettingKey
-	3, // 1: memos.store.UserSetting.access_tokens:type_name -> memos.store.AccessTokensUserSetting
-	2, // 2: memos.store.UserSetting.review_setting:type_name -> memos.store.ReviewUserSetting
-	4, // 3: memos.store.AccessTokensUserSetting.access_tokens:type_name -> memos.store.AccessTokensUserSetting.AccessToken
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	0,  // 0: memos.store.UserSetting.key:type_name -> memos.store.UserSettingKey
+	5,  // 1: memos.store.UserSetting.access_tokens:type_name -> memos.store.AccessTokensUserSetting
+	2,  // 2: memos.store.UserSetting.review_setting:type_name -> memos.store.ReviewUserSetting
+	3,  // 3: memos.store.UserSetting.shortcuts:type_name -> memos.store.ShortcutsUserSetting
+	4,  // 4: memos.store.UserSetting.memo_rules:type_name -> memos.store.MemoRulesUserSetting
+	6,  // 5: memos.store.ShortcutsUserSetting.shortcuts:type_name -> memos.store.ShortcutsUserSetting.Shortcut
+	7,  // 6: memos.store.MemoRulesUserSetting.rules:type_name -> memos.store.MemoRulesUserSetting.Rule
+	10, // 7: memos.store.AccessTokensUserSetting.access_tokens:type_name -> memos.store.AccessTokensUserSetting.AccessToken
+	8,  // 8: memos.store.MemoRulesUserSetting.Rule.condition:type_name -> memos.store.MemoRulesUserSetting.Condition
+	9,  // 9: memos.store.MemoRulesUserSetting.Rule.actions:type_name -> memos.store.MemoRulesUserSetting.Action
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_store_user_setting_proto_init() }
@@ -469,6 +1002,16 @@ func file_store_user_setting_proto_init() {
 		(*UserSetting_Appearance)(nil),
 		(*UserSetting_MemoVisibility)(nil),
 		(*UserSetting_ReviewSetting)(nil),
+		(*UserSetting_Shortcuts)(nil),
+		(*UserSetting_MemoRules)(nil),
+		(*UserSetting_MemoSlug)(nil),
+	}
+	file_store_user_setting_proto_msgTypes[8].OneofWrappers = []any{
+		(*MemoRulesUserSetting_Action_AddTag)(nil),
+		(*MemoRulesUserSetting_Action_SetVisibility)(nil),
+		(*MemoRulesUserSetting_Action_Pin)(nil),
+		(*MemoRulesUserSetting_Action_AddRelation)(nil),
+		(*MemoRulesUserSetting_Action_DispatchWebhook)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -476,7 +1019,7 @@ func file_store_user_setting_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_user_setting_proto_rawDesc), len(file_store_user_setting_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   4,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -697,6 +697,10 @@ type WorkspaceMemoRelatedSetting struct {
 	DisableReactions bool `protobuf:"varint,13,opt,name=disable_reactions,json=disableReactions,proto3" json:"disable_reactions,omitempty"`
 	// disable_save_as_image disables the save as image feature.
 	DisableSaveAsImage bool `protobuf:"varint,14,opt,name=disable_save_as_image,json=disableSaveAsImage,proto3" json:"disable_save_as_image,omitempty"`
+	// memo_revision_limit is the max number of revisions kept for each memo.
+	MemoRevisionLimit int32 `protobuf:"varint,15,opt,name=memo_revision_limit,json=memoRevisionLimit,proto3" json:"memo_revision_limit,omitempty"`
+	// trash_retention_days is the number of days before trashed memos are deleted permanently.
+	TrashRetentionDays int32 `protobuf:"varint,16,opt,name=trash_retention_days,json=trashRetentionDays,proto3" json:"trash_retention_days,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -829,6 +833,20 @@ func (x *WorkspaceMemoRelatedSetting) GetDisableSaveAsImage() bool {
 	return false
 }
 
+func (x *WorkspaceMemoRelatedSetting) GetMemoRevisionLimit() int32 {
+	if x != nil {
+		return x.MemoRevisionLimit
+	}
+	return 0
+}
+
+func (x *WorkspaceMemoRelatedSetting) GetTrashRetentionDays() int32 {
+	if x != nil {
+		return x.TrashRetentionDays
+	}
+	return 0
+}
+
 type WorkspacePublicCommentSetting struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
@@ -970,7 +988,7 @@ const file_store_workspace_setting_proto_rawDesc = "" +
 	"\x11access_key_secret\x18\x02 \x01(\tR\x0faccessKeySecret\x12\x1a\n" +
 	"\bendpoint\x18\x03 \x01(\tR\bendpoint\x12\x16\n" +
 	"\x06region\x18\x04 \x01(\tR\x06region\x12\x16\n" +
-	"\x06bucket\x18\x05 \x01(\tR\x06bucket\"\xc5\x05\n" +
+	"\x06bucket\x18\x05 \x01(\tR\x06bucket\"\xa7\x06\n" +
 	"\x1bWorkspaceMemoRelatedSetting\x12<\n" +
 	"\x1adisallow_public_visibility\x18\x01 \x01(\bR\x18disallowPublicVisibility\x127\n" +
 	"\x18display_with_update_time\x18\x02 \x01(\bR\x15displayWithUpdateTime\x120\n" +
@@ -986,7 +1004,9 @@ const file_store_workspace_setting_proto_rawDesc = "" +
 	"\x1adisable_markdown_shortcuts\x18\v \x01(\bR\x18disableMarkdownShortcuts\x12)\n" +
 	"\x11enable_share_to_x\x18\f \x01(\bR\x0eenableShareToX\x12+\n" +
 	"\x11disable_reactions\x18\r \x01(\bR\x10disableReactions\x121\n" +
-	"\x15disable_save_as_image\x18\x0e \x01(\bR\x12disableSaveAsImage\"\xcd\x01\n" +
+	"\x15disable_save_as_image\x18\x0e \x01(\bR\x12disableSaveAsImage\x12.\n" +
+	"\x13memo_revision_limit\x18\x0f \x01(\x05R\x11memoRevisionLimit\x120\n" +
+	"\x14trash_retention_days\x18\x10 \x01(\x05R\x12trashRetentionDays\"\xcd\x01\n" +
 	"\x1dWorkspacePublicCommentSetting\x12\x18\n" +
 	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x12\n" +
 	"\x04repo\x18\x02 \x01(\tR\x04repo\x12\x17\n" +
@@ -268,8 +268,135 @@ CREATE TABLE IF NOT EXISTS memo_review_session_cache (
   memo_ids TEXT NOT NULL DEFAULT '[]',
   total_count INTEGER NOT NULL DEFAULT 0
 );
+
+-- [fork migration 0.25/03__memo_revision.sql] Memo revision history
+CREATE TABLE IF NOT EXISTS memo_revision (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  memo_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  content TEXT NOT NULL DEFAULT '',
+  visibility TEXT NOT NULL CHECK (visibility IN ('PUBLIC', 'PROTECTED', 'PRIVATE')) DEFAULT 'PRIVATE'
+);
+CREATE INDEX IF NOT EXISTS idx_memo_revision_memo_id ON memo_revision(memo_id, created_ts);
+
+-- [fork migration 0.25/04__memo_fts.sql] Memo full-text index
+CREATE VIRTUAL TABLE IF NOT EXISTS memo_fts USING fts5(
+  content,
+  content = 'memo',
+  content_rowid = 'id',
+  tokenize = 'porter unicode61 remove_diacritics 2'
+);
+CREATE TRIGGER IF NOT EXISTS memo_fts_after_insert AFTER INSERT ON memo BEGIN
+  INSERT INTO memo_fts (rowid, content) VALUES (new.id, new.content);
+END;
+CREATE TRIGGER IF NOT EXISTS memo_fts_after_delete AFTER DELETE ON memo BEGIN
+  INSERT INTO memo_fts (memo_fts, rowid, content) VALUES ('delete', old.id, old.content);
+END;
+CREATE TRIGGER IF NOT EXISTS memo_fts_after_update AFTER UPDATE OF content ON memo BEGIN
+  INSERT INTO memo_fts (memo_fts, rowid, content) VALUES ('delete', old.id, old.content);
+  INSERT INTO memo_fts (rowid, content) VALUES (new.id, new.content);
+END;
+INSERT INTO memo_fts (memo_fts) VALUES ('rebuild');
+
+-- [fork migration 0.25/06__job.sql] Background job schedules and run history
+CREATE TABLE IF NOT EXISTS job (
+  name TEXT NOT NULL PRIMARY KEY,
+  schedule TEXT NOT NULL,
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now'))
+);
+CREATE TABLE IF NOT EXISTS job_run (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  job_name TEXT NOT NULL,
+  started_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  ended_ts BIGINT,
+  error TEXT NOT NULL DEFAULT ''
+);
+CREATE INDEX IF NOT EXISTS idx_job_run_job_name ON job_run(job_name, started_ts);
+
+-- [fork migration 0.25/08__memo_share.sql] Expiring share links of memos
+CREATE TABLE IF NOT EXISTS memo_share (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  memo_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  token TEXT NOT NULL UNIQUE,
+  password_hash TEXT NOT NULL DEFAULT '',
+  expires_ts BIGINT,
+  access_count INTEGER NOT NULL DEFAULT 0,
+  last_accessed_ts BIGINT
+);
+CREATE INDEX IF NOT EXISTS idx_memo_share_memo_id ON memo_share(memo_id);
+
+-- [fork migration 0.25/09__memo_collaborator.sql] Add memo_collaborator table
+CREATE TABLE IF NOT EXISTS memo_collaborator (
+  memo_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  role TEXT NOT NULL CHECK (role IN ('VIEWER', 'EDITOR')) DEFAULT 'VIEWER',
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  UNIQUE(memo_id, user_id)
+);
+CREATE INDEX IF NOT EXISTS idx_memo_collaborator_user_id ON memo_collaborator(user_id);
+
+-- [fork migration 0.25/12__memo_term.sql] Memo term index
+CREATE TABLE IF NOT EXISTS memo_term (
+  memo_id INTEGER NOT NULL,
+  term TEXT NOT NULL,
+  frequency INTEGER NOT NULL,
+  UNIQUE(memo_id, term)
+);
+CREATE INDEX IF NOT EXISTS idx_memo_term_term ON memo_term(term);
+
+-- [fork migration 0.25/13__memo_alias.sql] Memo alias
+CREATE TABLE IF NOT EXISTS memo_alias (
+  uid TEXT NOT NULL UNIQUE,
+  memo_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now'))
+);
+CREATE INDEX IF NOT EXISTS idx_memo_alias_memo_id ON memo_alias(memo_id);
 SQL
 
+  # [fork migration 0.25/05__memo_trash.sql] Memo trash state
+  local has_trashed_ts
+  has_trashed_ts=$(sqlite3 "$db" "SELECT COUNT(*) FROM pragma_table_info('memo') WHERE name='trashed_ts';")
+  if [ "$has_trashed_ts" = "0" ]; then
+    echo "  Adding memo.trashed_ts column..."
+    sqlite3 "$db" "ALTER TABLE memo ADD COLUMN trashed_ts BIGINT;"
+  fi
+  sqlite3 "$db" "CREATE INDEX IF NOT EXISTS idx_memo_trashed_ts ON memo (trashed_ts);"
+
+  # [fork migration 0.25/07__memo_publish.sql] Scheduled memo publishing
+  local has_publish_ts
+  has_publish_ts=$(sqlite3 "$db" "SELECT COUNT(*) FROM pragma_table_info('memo') WHERE name='publish_ts';")
+  if [ "$has_publish_ts" = "0" ]; then
+    echo "  Adding memo.publish_ts column..."
+    sqlite3 "$db" "ALTER TABLE memo ADD COLUMN publish_ts BIGINT;"
+  fi
+  local has_publish_visibility
+  has_publish_visibility=$(sqlite3 "$db" "SELECT COUNT(*) FROM pragma_table_info('memo') WHERE name='publish_visibility';")
+  if [ "$has_publish_visibility" = "0" ]; then
+    echo "  Adding memo.publish_visibility column..."
+    sqlite3 "$db" "ALTER TABLE memo ADD COLUMN publish_visibility TEXT NOT NULL CHECK (publish_visibility IN ('PUBLIC', 'PROTECTED', 'PRIVATE')) DEFAULT 'PRIVATE';"
+  fi
+  sqlite3 "$db" "CREATE INDEX IF NOT EXISTS idx_memo_publish_ts ON memo (publish_ts);"
+
+  # [fork migration 0.25/10__memo_version.sql] Memo version for optimistic concurrency
+  local has_version
+  has_version=$(sqlite3 "$db" "SELECT COUNT(*) FROM pragma_table_info('memo') WHERE name='version';")
+  if [ "$has_version" = "0" ]; then
+    echo "  Adding memo.version column..."
+    sqlite3 "$db" "ALTER TABLE memo ADD COLUMN version INTEGER NOT NULL DEFAULT 0;"
+  fi
+
+  # [fork migration 0.25/11__memo_reminder.sql] Memo reminders
+  local has_remind_ts
+  has_remind_ts=$(sqlite3 "$db" "SELECT COUNT(*) FROM pragma_table_info('memo') WHERE name='remind_ts';")
+  if [ "$has_remind_ts" = "0" ]; then
+    echo "  Adding memo.remind_ts column..."
+    sqlite3 "$db" "ALTER TABLE memo ADD COLUMN remind_ts BIGINT;"
+  fi
+  sqlite3 "$db" "CREATE INDEX IF NOT EXISTS idx_memo_remind_ts ON memo (remind_ts);"
+
   echo "SQLite migration repair complete."
 }
 
@@ -431,6 +558,67 @@ CREATE TABLE IF NOT EXISTS `memo_review_session_cache` (
   `memo_ids` JSON NOT NULL,
   `total_count` INT NOT NULL DEFAULT 0
 );
+
+-- [fork migration 0.25/03__memo_revision.sql] Memo revision history
+CREATE TABLE IF NOT EXISTS `memo_revision` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `memo_id` INT NOT NULL,
+  `creator_id` INT NOT NULL,
+  `created_ts` BIGINT NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  `content` TEXT NOT NULL,
+  `visibility` VARCHAR(256) NOT NULL DEFAULT 'PRIVATE'
+);
+
+-- [fork migration 0.25/06__job.sql] Background job schedules and run history
+CREATE TABLE IF NOT EXISTS `job` (
+  `name` VARCHAR(256) NOT NULL PRIMARY KEY,
+  `schedule` VARCHAR(256) NOT NULL,
+  `updated_ts` BIGINT NOT NULL DEFAULT (UNIX_TIMESTAMP())
+);
+CREATE TABLE IF NOT EXISTS `job_run` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `job_name` VARCHAR(256) NOT NULL,
+  `started_ts` BIGINT NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  `ended_ts` BIGINT,
+  `error` TEXT NOT NULL
+);
+
+-- [fork migration 0.25/08__memo_share.sql] Expiring share links of memos
+CREATE TABLE IF NOT EXISTS `memo_share` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `memo_id` INT NOT NULL,
+  `creator_id` INT NOT NULL,
+  `created_ts` BIGINT NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  `token` VARCHAR(256) NOT NULL UNIQUE,
+  `password_hash` VARCHAR(256) NOT NULL DEFAULT '',
+  `expires_ts` BIGINT,
+  `access_count` INT NOT NULL DEFAULT 0,
+  `last_accessed_ts` BIGINT
+);
+
+-- [fork migration 0.25/09__memo_collaborator.sql] Add memo_collaborator table
+CREATE TABLE IF NOT EXISTS `memo_collaborator` (
+  `memo_id` INT NOT NULL,
+  `user_id` INT NOT NULL,
+  `role` VARCHAR(256) NOT NULL DEFAULT 'VIEWER',
+  `created_ts` BIGINT NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  UNIQUE(`memo_id`,`user_id`)
+);
+
+-- [fork migration 0.25/12__memo_term.sql] Memo term index
+CREATE TABLE IF NOT EXISTS `memo_term` (
+  `memo_id` INT NOT NULL,
+  `term` VARCHAR(256) NOT NULL,
+  `frequency` INT NOT NULL,
+  UNIQUE(`memo_id`,`term`)
+);
+
+-- [fork migration 0.25/13__memo_alias.sql] Memo alias
+CREATE TABLE IF NOT EXISTS `memo_alias` (
+  `uid` VARCHAR(256) NOT NULL UNIQUE,
+  `memo_id` INT NOT NULL,
+  `created_ts` BIGINT NOT NULL DEFAULT (UNIX_TIMESTAMP())
+);
 SQL
 
   # MySQL doesn't support IF NOT EXISTS for indexes; suppress duplicate errors.
@@ -439,8 +627,56 @@ SQL
     CREATE INDEX idx_memo_review_user ON \`memo_review\`(\`user_id\`);
     CREATE INDEX idx_memo_review_user_time ON \`memo_review\`(\`user_id\`, \`reviewed_at\`);
     CREATE INDEX idx_memo_review_user_memo ON \`memo_review\`(\`user_id\`, \`memo_id\`);
+    CREATE INDEX idx_memo_revision_memo_id ON \`memo_revision\`(\`memo_id\`, \`created_ts\`);
+    CREATE FULLTEXT INDEX idx_memo_content_fulltext ON \`memo\`(\`content\`);
+    CREATE INDEX idx_job_run_job_name ON \`job_run\`(\`job_name\`, \`started_ts\`);
+    CREATE INDEX idx_memo_share_memo_id ON \`memo_share\`(\`memo_id\`);
+    CREATE INDEX idx_memo_collaborator_user_id ON \`memo_collaborator\`(\`user_id\`);
+    CREATE INDEX idx_memo_term_term ON \`memo_term\`(\`term\`);
+    CREATE INDEX idx_memo_alias_memo_id ON \`memo_alias\`(\`memo_id\`);
   " 2>/dev/null || true
 
+  # [fork migration 0.25/05__memo_trash.sql] Memo trash state
+  local has_trashed_ts
+  has_trashed_ts=$(run_query "SELECT COUNT(*) FROM information_schema.COLUMNS WHERE TABLE_SCHEMA=DATABASE() AND TABLE_NAME='memo' AND COLUMN_NAME='trashed_ts';")
+  if [ "$has_trashed_ts" = "0" ]; then
+    echo "  Adding memo.trashed_ts column..."
+    run_query "ALTER TABLE \`memo\` ADD COLUMN \`trashed_ts\` BIGINT;"
+  fi
+  run_query "CREATE INDEX idx_memo_trashed_ts ON \`memo\` (\`trashed_ts\`);" 2>/dev/null || true
+
+  # [fork migration 0.25/07__memo_publish.sql] Scheduled memo publishing
+  local has_publish_ts
+  has_publish_ts=$(run_query "SELECT COUNT(*) FROM information_schema.COLUMNS WHERE TABLE_SCHEMA=DATABASE() AND TABLE_NAME='memo' AND COLUMN_NAME='publish_ts';")
+  if [ "$has_publish_ts" = "0" ]; then
+    echo "  Adding memo.publish_ts column..."
+    run_query "ALTER TABLE \`memo\` ADD COLUMN \`publish_ts\` BIGINT;"
+  fi
+  local has_publish_visibility
+  has_publish_visibility=$(run_query "SELECT COUNT(*) FROM information_schema.COLUMNS WHERE TABLE_SCHEMA=DATABASE() AND TABLE_NAME='memo' AND COLUMN_NAME='publish_visibility';")
+  if [ "$has_publish_visibility" = "0" ]; then
+    echo "  Adding memo.publish_visibility column..."
+    run_query "ALTER TABLE \`memo\` ADD COLUMN \`publish_visibility\` VARCHAR(256) NOT NULL DEFAULT 'PRIVATE';"
+  fi
+  run_query "CREATE INDEX idx_memo_publish_ts ON \`memo\` (\`publish_ts\`);" 2>/dev/null || true
+
+  # [fork migration 0.25/10__memo_version.sql] Memo version for optimistic concurrency
+  local has_version
+  has_version=$(run_query "SELECT COUNT(*) FROM information_schema.COLUMNS WHERE TABLE_SCHEMA=DATABASE() AND TABLE_NAME='memo' AND COLUMN_NAME='version';")
+  if [ "$has_version" = "0" ]; then
+    echo "  Adding memo.version column..."
+    run_query "ALTER TABLE \`memo\` ADD COLUMN \`version\` INT NOT NULL DEFAULT 0;"
+  fi
+
+  # [fork migration 0.25/11__memo_reminder.sql] Memo reminders
+  local has_remind_ts
+  has_remind_ts=$(run_query "SELECT COUNT(*) FROM information_schema.COLUMNS WHERE TABLE_SCHEMA=DATABASE() AND TABLE_NAME='memo' AND COLUMN_NAME='remind_ts';")
+  if [ "$has_remind_ts" = "0" ]; then
+    echo "  Adding memo.remind_ts column..."
+    run_query "ALTER TABLE \`memo\` ADD COLUMN \`remind_ts\` BIGINT;"
+  fi
+  run_query "CREATE INDEX idx_memo_remind_ts ON \`memo\` (\`remind_ts\`);" 2>/dev/null || true
+
   echo "MySQL migration repair complete."
 }
 
@@ -597,6 +833,93 @@ CREATE TABLE IF NOT EXISTS memo_review_session_cache (
   memo_ids TEXT NOT NULL DEFAULT '[]',
   total_count INTEGER NOT NULL DEFAULT 0
 );
+
+-- [fork migration 0.25/03__memo_revision.sql] Memo revision history
+CREATE TABLE IF NOT EXISTS memo_revision (
+  id SERIAL PRIMARY KEY,
+  memo_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  content TEXT NOT NULL DEFAULT '',
+  visibility TEXT NOT NULL DEFAULT 'PRIVATE'
+);
+CREATE INDEX IF NOT EXISTS idx_memo_revision_memo_id ON memo_revision(memo_id, created_ts);
+
+-- [fork migration 0.25/04__memo_fts.sql] Memo full-text index
+ALTER TABLE memo ADD COLUMN IF NOT EXISTS content_tsv TSVECTOR GENERATED ALWAYS AS (to_tsvector('english', content)) STORED;
+CREATE INDEX IF NOT EXISTS idx_memo_content_tsv ON memo USING GIN (content_tsv);
+
+-- [fork migration 0.25/05__memo_trash.sql] Memo trash state
+ALTER TABLE memo ADD COLUMN IF NOT EXISTS trashed_ts BIGINT;
+CREATE INDEX IF NOT EXISTS idx_memo_trashed_ts ON memo (trashed_ts);
+
+-- [fork migration 0.25/06__job.sql] Background job schedules and run history
+CREATE TABLE IF NOT EXISTS job (
+  name TEXT NOT NULL PRIMARY KEY,
+  schedule TEXT NOT NULL,
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW())
+);
+CREATE TABLE IF NOT EXISTS job_run (
+  id SERIAL PRIMARY KEY,
+  job_name TEXT NOT NULL,
+  started_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  ended_ts BIGINT,
+  error TEXT NOT NULL DEFAULT ''
+);
+CREATE INDEX IF NOT EXISTS idx_job_run_job_name ON job_run(job_name, started_ts);
+
+-- [fork migration 0.25/07__memo_publish.sql] Scheduled memo publishing
+ALTER TABLE memo ADD COLUMN IF NOT EXISTS publish_ts BIGINT;
+ALTER TABLE memo ADD COLUMN IF NOT EXISTS publish_visibility TEXT NOT NULL DEFAULT 'PRIVATE';
+CREATE INDEX IF NOT EXISTS idx_memo_publish_ts ON memo (publish_ts);
+
+-- [fork migration 0.25/08__memo_share.sql] Expiring share links of memos
+CREATE TABLE IF NOT EXISTS memo_share (
+  id SERIAL PRIMARY KEY,
+  memo_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  token TEXT NOT NULL UNIQUE,
+  password_hash TEXT NOT NULL DEFAULT '',
+  expires_ts BIGINT,
+  access_count INTEGER NOT NULL DEFAULT 0,
+  last_accessed_ts BIGINT
+);
+CREATE INDEX IF NOT EXISTS idx_memo_share_memo_id ON memo_share(memo_id);
+
+-- [fork migration 0.25/09__memo_collaborator.sql] Add memo_collaborator table
+CREATE TABLE IF NOT EXISTS memo_collaborator (
+  memo_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  role TEXT NOT NULL DEFAULT 'VIEWER',
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  UNIQUE(memo_id, user_id)
+);
+CREATE INDEX IF NOT EXISTS idx_memo_collaborator_user_id ON memo_collaborator(user_id);
+
+-- [fork migration 0.25/10__memo_version.sql] Memo version for optimistic concurrency
+ALTER TABLE memo ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 0;
+
+-- [fork migration 0.25/11__memo_reminder.sql] Memo reminders
+ALTER TABLE memo ADD COLUMN IF NOT EXISTS remind_ts BIGINT;
+CREATE INDEX IF NOT EXISTS idx_memo_remind_ts ON memo (remind_ts);
+
+-- [fork migration 0.25/12__memo_term.sql] Memo term index
+CREATE TABLE IF NOT EXISTS memo_term (
+  memo_id INTEGER NOT NULL,
+  term TEXT NOT NULL,
+  frequency INTEGER NOT NULL,
+  UNIQUE(memo_id, term)
+);
+CREATE INDEX IF NOT EXISTS idx_memo_term_term ON memo_term(term);
+
+-- [fork migration 0.25/13__memo_alias.sql] Memo alias
+CREATE TABLE IF NOT EXISTS memo_alias (
+  uid TEXT NOT NULL UNIQUE,
+  memo_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW())
+);
+CREATE INDEX IF NOT EXISTS idx_memo_alias_memo_id ON memo_alias(memo_id);
 SQL
 
   echo "PostgreSQL migration repair complete."
@@ -2,8 +2,10 @@ package v1
 
 import (
 	"context"
+	"log/slog"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/pkg/errors"
@@ -25,6 +27,15 @@ const (
 	// user id is extracted from the jwt token subject field.
 	usernameContextKey ContextKey = iota
 	accessTokenContextKey
+	// The key name used to store the memo share of the share link the request was made with.
+	memoShareContextKey
+)
+
+const (
+	// MemoShareTokenMetadataKey is the metadata key of the token of a share link.
+	MemoShareTokenMetadataKey = "x-memo-share-token"
+	// MemoSharePasswordMetadataKey is the metadata key of the password of a share link.
+	MemoSharePasswordMetadataKey = "x-memo-share-password"
 )
 
 // GRPCAuthInterceptor is the auth interceptor for gRPC server.
@@ -43,6 +54,34 @@ func NewGRPCAuthInterceptor(store *store.Store, secret string) *GRPCAuthIntercep
 
 // AuthenticationInterceptor is the unary interceptor for gRPC API.
 func (in *GRPCAuthInterceptor) AuthenticationInterceptor(ctx context.Context, request any, serverInfo *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, err := in.authenticateContext(ctx, serverInfo.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, request)
+}
+
+// StreamAuthenticationInterceptor is the stream interceptor for gRPC API.
+func (in *GRPCAuthInterceptor) StreamAuthenticationInterceptor(srv any, stream grpc.ServerStream, serverInfo *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := in.authenticateContext(stream.Context(), serverInfo.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authenticatedServerStream{ServerStream: stream, ctx: ctx})
+}
+
+// authenticatedServerStream is a server stream with the context of the authenticated user.
+type authenticatedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// authenticateContext returns the context of the request with the authenticated user.
+func (in *GRPCAuthInterceptor) authenticateContext(ctx context.Context, fullMethod string) (context.Context, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return nil, status.Errorf(codes.Unauthenticated, "failed to parse metadata from incoming context")
@@ -54,8 +93,11 @@ func (in *GRPCAuthInterceptor) AuthenticationInterceptor(ctx context.Context, re
 
 	username, err := in.authenticate(ctx, accessToken)
 	if err != nil {
-		if isUnauthorizeAllowedMethod(serverInfo.FullMethod) {
-			return handler(ctx, request)
+		if isUnauthorizeAllowedMethod(fullMethod) {
+			if isMemoShareAllowedMethod(fullMethod) {
+				return in.authenticateMemoShare(ctx, md, fullMethod)
+			}
+			return ctx, nil
 		}
 		return nil, err
 	}
@@ -71,13 +113,69 @@ func (in *GRPCAuthInterceptor) AuthenticationInterceptor(ctx context.Context, re
 	if user.RowStatus == store.Archived {
 		return nil, errors.Errorf("user %q is archived", username)
 	}
-	if isOnlyForAdminAllowedMethod(serverInfo.FullMethod) && user.Role != store.RoleHost && user.Role != store.RoleAdmin {
+	if isOnlyForAdminAllowedMethod(fullMethod) && user.Role != store.RoleHost && user.Role != store.RoleAdmin {
 		return nil, errors.Errorf("user %q is not admin", username)
 	}
 
 	ctx = context.WithValue(ctx, usernameContextKey, username)
 	ctx = context.WithValue(ctx, accessTokenContextKey, accessToken)
-	return handler(ctx, request)
+	if isMemoShareAllowedMethod(fullMethod) {
+		return in.authenticateMemoShare(ctx, md, fullMethod)
+	}
+	return ctx, nil
+}
+
+// authenticateMemoShare returns the context with the memo share of the share link token in the metadata.
+// Every access through a share link is recorded and logged.
+func (in *GRPCAuthInterceptor) authenticateMemoShare(ctx context.Context, md metadata.MD, fullMethod string) (context.Context, error) {
+	tokens := md.Get(MemoShareTokenMetadataKey)
+	if len(tokens) == 0 || tokens[0] == "" {
+		return ctx, nil
+	}
+	share, err := in.Store.GetMemoShare(ctx, &store.FindMemoShare{Token: &tokens[0]})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get memo share")
+	}
+	if share == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid share link")
+	}
+	password := ""
+	if passwords := md.Get(MemoSharePasswordMetadataKey); len(passwords) > 0 {
+		password = passwords[0]
+	}
+	now := time.Now()
+	if err := validateMemoShare(share, password, now); err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "%v", err)
+	}
+
+	if err := in.Store.RecordMemoShareAccess(ctx, share.ID, now.Unix()); err != nil {
+		return nil, errors.Wrap(err, "failed to record memo share access")
+	}
+	slog.Info("memo share link accessed",
+		slog.Int("share", int(share.ID)),
+		slog.Int("memo", int(share.MemoID)),
+		slog.String("method", fullMethod),
+	)
+	return context.WithValue(ctx, memoShareContextKey, share), nil
+}
+
+// getMemoShareMetadata returns the metadata of the share link of a gateway request,
+// taken from the X-Memo-Share-* headers or the share_token query parameter.
+// The query parameter allows the resources of a shared memo to be linked from its content.
+// The password is only taken from the header, so that it is not kept in logs and browser history.
+func getMemoShareMetadata(request *http.Request) metadata.MD {
+	md := metadata.MD{}
+	token, password := request.Header.Get(MemoShareTokenMetadataKey), request.Header.Get(MemoSharePasswordMetadataKey)
+	if token == "" {
+		token = request.URL.Query().Get("share_token")
+	}
+	if token != "" {
+		md.Set(MemoShareTokenMetadataKey, token)
+	}
+	if password != "" {
+		md.Set(MemoSharePasswordMetadataKey, password)
+	}
+	return md
 }
 
 func (in *GRPCAuthInterceptor) authenticate(ctx context.Context, accessToken string) (string, error) {
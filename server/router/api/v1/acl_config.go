@@ -13,10 +13,12 @@ var authenticationAllowlistMethods = map[string]bool{
 	"/memos.api.v1.AuthService/SignUp":                            true,
 	"/memos.api.v1.UserService/GetUser":                           true,
 	"/memos.api.v1.UserService/GetUserAvatarBinary":               true,
+	"/memos.api.v1.UserService/GetUserMemoStats":                  true,
 	"/memos.api.v1.UserService/SearchUsers":                       true,
 	"/memos.api.v1.MemoService/GetMemo":                           true,
 	"/memos.api.v1.MemoService/GetMemoByUid":                      true,
 	"/memos.api.v1.MemoService/ListMemos":                         true,
+	"/memos.api.v1.MemoService/ListMemoLocations":                 true,
 	"/memos.api.v1.MarkdownService/GetLinkMetadata":               true,
 	"/memos.api.v1.ResourceService/GetResourceBinary":             true,
 	"/memos.api.v1.ResourceService/GetResourceByUid":              true,
@@ -27,9 +29,25 @@ func isUnauthorizeAllowedMethod(fullMethodName string) bool {
 	return authenticationAllowlistMethods[fullMethodName]
 }
 
+// memoShareAllowedMethods are the allowlisted methods that accept the token of a share link.
+var memoShareAllowedMethods = map[string]bool{
+	"/memos.api.v1.MemoService/GetMemo":               true,
+	"/memos.api.v1.ResourceService/GetResourceBinary": true,
+}
+
+// isMemoShareAllowedMethod returns whether the method grants access to the memo of a share link.
+func isMemoShareAllowedMethod(fullMethodName string) bool {
+	return memoShareAllowedMethods[fullMethodName]
+}
+
 var allowedMethodsOnlyForAdmin = map[string]bool{
 	"/memos.api.v1.UserService/CreateUser":                      true,
 	"/memos.api.v1.WorkspaceSettingService/SetWorkspaceSetting": true,
+	"/memos.api.v1.JobService/ListJobs":                         true,
+	"/memos.api.v1.JobService/GetJob":                           true,
+	"/memos.api.v1.JobService/UpdateJob":                        true,
+	"/memos.api.v1.JobService/RunJob":                           true,
+	"/memos.api.v1.JobService/ListJobRuns":                      true,
 }
 
 // isOnlyForAdminAllowedMethod returns true if the method is allowed to be called only by admin.
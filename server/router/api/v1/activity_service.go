@@ -57,5 +57,16 @@ func convertActivityPayloadFromStore(payload *storepb.ActivityPayload) *v1pb.Act
 			Version: payload.VersionUpdate.Version,
 		}
 	}
+	if payload.MemoCollaborator != nil {
+		v2Payload.MemoCollaborator = &v1pb.ActivityMemoCollaboratorPayload{
+			MemoId: payload.MemoCollaborator.MemoId,
+			Role:   payload.MemoCollaborator.Role,
+		}
+	}
+	if payload.MemoReminder != nil {
+		v2Payload.MemoReminder = &v1pb.ActivityMemoReminderPayload{
+			MemoId: payload.MemoReminder.MemoId,
+		}
+	}
 	return v2Payload
 }
@@ -44,6 +44,30 @@ func getPageToken(limit int, offset int) (string, error) {
 	})
 }
 
+// getCursorPageToken returns the page token of the page after the item with the sort key.
+func getCursorPageToken(limit int, cursor *store.Cursor) (string, error) {
+	return marshalPageToken(&v1pb.PageToken{
+		Limit: int32(limit),
+		Cursor: &v1pb.PageCursor{
+			Pinned: cursor.Pinned,
+			Ts:     cursor.Ts,
+			Id:     cursor.ID,
+		},
+	})
+}
+
+// convertPageCursorToStore returns the sort key in the page token, nil if the token has none.
+func convertPageCursorToStore(cursor *v1pb.PageCursor) *store.Cursor {
+	if cursor == nil {
+		return nil
+	}
+	return &store.Cursor{
+		Pinned: cursor.Pinned,
+		Ts:     cursor.Ts,
+		ID:     cursor.Id,
+	}
+}
+
 func marshalPageToken(pageToken *v1pb.PageToken) (string, error) {
 	b, err := proto.Marshal(pageToken)
 	if err != nil {
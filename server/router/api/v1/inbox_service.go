@@ -21,6 +21,7 @@ func (s *APIV1Service) ListInboxes(ctx context.Context, request *v1pb.ListInboxe
 	}
 
 	var limit, offset int
+	var cursor *store.Cursor
 	if request.PageToken != "" {
 		var pageToken v1pb.PageToken
 		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
@@ -28,6 +29,7 @@ func (s *APIV1Service) ListInboxes(ctx context.Context, request *v1pb.ListInboxe
 		}
 		limit = int(pageToken.Limit)
 		offset = int(pageToken.Offset)
+		cursor = convertPageCursorToStore(pageToken.Cursor)
 	} else {
 		limit = int(request.PageSize)
 	}
@@ -40,6 +42,7 @@ func (s *APIV1Service) ListInboxes(ctx context.Context, request *v1pb.ListInboxe
 		ReceiverID: &user.ID,
 		Limit:      &limitPlusOne,
 		Offset:     &offset,
+		Cursor:     cursor,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list inbox: %v", err)
@@ -49,7 +52,11 @@ func (s *APIV1Service) ListInboxes(ctx context.Context, request *v1pb.ListInboxe
 	nextPageToken := ""
 	if len(inboxes) == limitPlusOne {
 		inboxes = inboxes[:limit]
-		nextPageToken, err = getPageToken(limit, offset+limit)
+		lastInbox := inboxes[limit-1]
+		nextPageToken, err = getCursorPageToken(limit, &store.Cursor{
+			Ts: lastInbox.CreatedTs,
+			ID: lastInbox.ID,
+		})
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
 		}
@@ -0,0 +1,185 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/scheduler"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ListJobs(ctx context.Context, _ *v1pb.ListJobsRequest) (*v1pb.ListJobsResponse, error) {
+	response := &v1pb.ListJobsResponse{}
+	for _, jobStatus := range s.Scheduler.ListJobs() {
+		job, err := s.convertJobFromScheduler(ctx, jobStatus)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert job: %v", err)
+		}
+		response.Jobs = append(response.Jobs, job)
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) GetJob(ctx context.Context, request *v1pb.GetJobRequest) (*v1pb.Job, error) {
+	jobName, err := ExtractJobNameFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid job name: %v", err)
+	}
+	jobStatus, err := s.Scheduler.GetJob(jobName)
+	if err != nil {
+		if errors.Is(err, scheduler.ErrJobNotFound) {
+			return nil, status.Errorf(codes.NotFound, "job not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get job: %v", err)
+	}
+	job, err := s.convertJobFromScheduler(ctx, jobStatus)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert job: %v", err)
+	}
+	return job, nil
+}
+
+func (s *APIV1Service) UpdateJob(ctx context.Context, request *v1pb.UpdateJobRequest) (*v1pb.Job, error) {
+	if request.Job == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "job is required")
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+	jobName, err := ExtractJobNameFromName(request.Job.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid job name: %v", err)
+	}
+	if _, err := s.Scheduler.GetJob(jobName); err != nil {
+		if errors.Is(err, scheduler.ErrJobNotFound) {
+			return nil, status.Errorf(codes.NotFound, "job not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get job: %v", err)
+	}
+
+	for _, path := range request.UpdateMask.Paths {
+		if path == "schedule" {
+			if _, err := s.Scheduler.UpdateSchedule(ctx, jobName, request.Job.Schedule); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "failed to update job schedule: %v", err)
+			}
+		} else {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid update path: %s", path)
+		}
+	}
+
+	return s.GetJob(ctx, &v1pb.GetJobRequest{Name: request.Job.Name})
+}
+
+func (s *APIV1Service) RunJob(ctx context.Context, request *v1pb.RunJobRequest) (*v1pb.JobRun, error) {
+	jobName, err := ExtractJobNameFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid job name: %v", err)
+	}
+	jobRun, err := s.Scheduler.Trigger(ctx, jobName)
+	if err != nil {
+		if errors.Is(err, scheduler.ErrJobNotFound) {
+			return nil, status.Errorf(codes.NotFound, "job not found")
+		}
+		if errors.Is(err, scheduler.ErrJobRunning) {
+			return nil, status.Errorf(codes.FailedPrecondition, "job is already running")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to run job: %v", err)
+	}
+	return convertJobRunFromStore(jobRun), nil
+}
+
+func (s *APIV1Service) ListJobRuns(ctx context.Context, request *v1pb.ListJobRunsRequest) (*v1pb.ListJobRunsResponse, error) {
+	jobName, err := ExtractJobNameFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid job name: %v", err)
+	}
+	if _, err := s.Scheduler.GetJob(jobName); err != nil {
+		if errors.Is(err, scheduler.ErrJobNotFound) {
+			return nil, status.Errorf(codes.NotFound, "job not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get job: %v", err)
+	}
+
+	var limit, offset int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		offset = int(pageToken.Offset)
+	} else {
+		limit = int(request.PageSize)
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	limitPlusOne := limit + 1
+	jobRuns, err := s.Store.ListJobRuns(ctx, &store.FindJobRun{
+		JobName: &jobName,
+		Limit:   &limitPlusOne,
+		Offset:  &offset,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list job runs: %v", err)
+	}
+
+	nextPageToken := ""
+	if len(jobRuns) == limitPlusOne {
+		jobRuns = jobRuns[:limit]
+		nextPageToken, err = getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
+	response := &v1pb.ListJobRunsResponse{
+		NextPageToken: nextPageToken,
+	}
+	for _, jobRun := range jobRuns {
+		response.Runs = append(response.Runs, convertJobRunFromStore(jobRun))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) convertJobFromScheduler(ctx context.Context, jobStatus *scheduler.JobStatus) (*v1pb.Job, error) {
+	job := &v1pb.Job{
+		Name:        fmt.Sprintf("%s%s", JobNamePrefix, jobStatus.Job.Name),
+		Description: jobStatus.Job.Description,
+		Schedule:    jobStatus.Schedule,
+		Running:     jobStatus.Running,
+	}
+	if !jobStatus.NextRunTime.IsZero() {
+		job.NextRunTime = timestamppb.New(jobStatus.NextRunTime)
+	}
+	limit := 1
+	lastRun, err := s.Store.GetJobRun(ctx, &store.FindJobRun{
+		JobName: &jobStatus.Job.Name,
+		Limit:   &limit,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get last job run")
+	}
+	if lastRun != nil {
+		job.LastRun = convertJobRunFromStore(lastRun)
+	}
+	return job, nil
+}
+
+func convertJobRunFromStore(jobRun *store.JobRun) *v1pb.JobRun {
+	jobRunMessage := &v1pb.JobRun{
+		Name:      fmt.Sprintf("%s%s/%s%d", JobNamePrefix, jobRun.JobName, JobRunNamePrefix, jobRun.ID),
+		StartTime: timestamppb.New(time.Unix(jobRun.StartedTs, 0)),
+		Error:     jobRun.Error,
+	}
+	if jobRun.EndedTs != nil {
+		jobRunMessage.EndTime = timestamppb.New(time.Unix(*jobRun.EndedTs, 0))
+	}
+	return jobRunMessage
+}
@@ -0,0 +1,136 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/usememos/gomark/ast"
+	"github.com/usememos/gomark/parser"
+	"github.com/usememos/gomark/parser/tokenizer"
+	"github.com/usememos/gomark/renderer"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// maxMemoSlugLength leaves room in the 32 characters of a uid for the suffix of a taken slug.
+	maxMemoSlugLength = 28
+	// maxMemoSlugAttempts is the number of suffixes tried for a taken slug before a random uid is used.
+	maxMemoSlugAttempts = 10
+)
+
+// getMemoByUID returns the memo with the uid, or the memo the uid is a previous uid of.
+func (s *APIV1Service) getMemoByUID(ctx context.Context, uid string) (*store.Memo, error) {
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &uid})
+	if err != nil || memo != nil {
+		return memo, err
+	}
+	alias, err := s.Store.GetMemoAlias(ctx, &store.FindMemoAlias{UID: &uid})
+	if err != nil || alias == nil {
+		return nil, err
+	}
+	return s.Store.GetMemo(ctx, &store.FindMemo{ID: &alias.MemoID})
+}
+
+// isMemoUIDAvailable returns whether the uid is neither the uid nor a previous uid of a memo other than the memo with the ID.
+func (s *APIV1Service) isMemoUIDAvailable(ctx context.Context, uid string, memoID int32) (bool, error) {
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &uid, ExcludeContent: true})
+	if err != nil {
+		return false, err
+	}
+	if memo != nil && memo.ID != memoID {
+		return false, nil
+	}
+	alias, err := s.Store.GetMemoAlias(ctx, &store.FindMemoAlias{UID: &uid})
+	if err != nil {
+		return false, err
+	}
+	return alias == nil || alias.MemoID == memoID, nil
+}
+
+// setMemoAlias records the previous uid of the memo as an alias, and removes the alias of its new uid if it had one.
+func (s *APIV1Service) setMemoAlias(ctx context.Context, memoID int32, previousUID, uid string) error {
+	if err := s.Store.DeleteMemoAlias(ctx, &store.DeleteMemoAlias{UID: &uid}); err != nil {
+		return err
+	}
+	if _, err := s.Store.UpsertMemoAlias(ctx, &store.MemoAlias{
+		UID:    previousUID,
+		MemoID: memoID,
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// getMemoSlugUID returns an available uid made of the slug of the first heading of the content
+// if the creator opted in, or an empty string to keep the generated uid.
+func (s *APIV1Service) getMemoSlugUID(ctx context.Context, creatorID int32, content string) (string, error) {
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &creatorID,
+		Key:    storepb.UserSettingKey_MEMO_SLUG,
+	})
+	if err != nil {
+		return "", err
+	}
+	if !userSetting.GetMemoSlug() {
+		return "", nil
+	}
+	slug, err := getMemoSlug(content)
+	if err != nil || slug == "" {
+		return "", err
+	}
+	for i := 1; i <= maxMemoSlugAttempts; i++ {
+		uid := slug
+		if i > 1 {
+			uid = fmt.Sprintf("%s-%d", slug, i)
+		}
+		available, err := s.isMemoUIDAvailable(ctx, uid, 0)
+		if err != nil {
+			return "", err
+		}
+		if available {
+			return uid, nil
+		}
+	}
+	return "", nil
+}
+
+// getMemoSlug returns the lowercased letters and digits of the first heading of the content, with
+// dashes between the words, or an empty string if the content has no heading with any of them.
+func getMemoSlug(content string) (string, error) {
+	nodes, err := parser.Parse(tokenizer.Tokenize(content))
+	if err != nil {
+		return "", err
+	}
+	var heading *ast.Heading
+	memopayload.TraverseASTNodes(nodes, func(node ast.Node) {
+		if n, ok := node.(*ast.Heading); ok && heading == nil {
+			heading = n
+		}
+	})
+	if heading == nil {
+		return "", nil
+	}
+
+	words := strings.FieldsFunc(strings.ToLower(renderer.NewStringRenderer().Render(heading.Children)), func(r rune) bool {
+		return !('a' <= r && r <= 'z' || '0' <= r && r <= '9')
+	})
+	slug := ""
+	for _, word := range words {
+		if slug == "" {
+			slug = word
+		} else if len(slug)+1+len(word) <= maxMemoSlugLength {
+			slug += "-" + word
+		} else {
+			break
+		}
+	}
+	// A single long word is cut.
+	if len(slug) > maxMemoSlugLength {
+		slug = slug[:maxMemoSlugLength]
+	}
+	return slug, nil
+}
@@ -0,0 +1,32 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/internal/util"
+)
+
+func TestGetMemoSlug(t *testing.T) {
+	tests := []struct {
+		content string
+		slug    string
+	}{
+		{content: "# Trip to Paris\nDay one", slug: "trip-to-paris"},
+		{content: "Some text\n\n## **Go** 1.23 release notes!", slug: "go-1-23-release-notes"},
+		{content: "# A very long heading that does not fit in a uid", slug: "a-very-long-heading-that"},
+		{content: "# Supercalifragilisticexpialidocious-and-more", slug: "supercalifragilisticexpialid"},
+		{content: "# 旅行", slug: ""},
+		{content: "No heading at all", slug: ""},
+		{content: "", slug: ""},
+	}
+	for _, test := range tests {
+		slug, err := getMemoSlug(test.content)
+		require.NoError(t, err)
+		require.Equal(t, test.slug, slug, test.content)
+		if slug != "" {
+			require.True(t, util.UIDMatcher.MatchString(slug+"-10"), slug)
+		}
+	}
+}
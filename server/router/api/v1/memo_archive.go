@@ -0,0 +1,92 @@
+package v1
+
+import (
+	"bytes"
+	"path"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// The Markdown archive holds one Markdown file per memo under memoArchiveMemoDir,
+// and the blobs of the attached resources under memoArchiveResourceDir.
+const (
+	memoArchiveMemoDir     = "memos"
+	memoArchiveResourceDir = "resources"
+)
+
+// memoArchiveFrontMatter is the YAML front matter of a memo in the Markdown archive.
+type memoArchiveFrontMatter struct {
+	UID        string                 `yaml:"uid"`
+	Visibility string                 `yaml:"visibility"`
+	RowStatus  string                 `yaml:"row_status"`
+	Pinned     bool                   `yaml:"pinned,omitempty"`
+	Tags       []string               `yaml:"tags,omitempty"`
+	CreateTime time.Time              `yaml:"create_time"`
+	UpdateTime time.Time              `yaml:"update_time"`
+	Location   *memoArchiveLocation   `yaml:"location,omitempty"`
+	Relations  []*memoArchiveRelation `yaml:"relations,omitempty"`
+	Reactions  []*memoArchiveReaction `yaml:"reactions,omitempty"`
+	Resources  []*memoArchiveResource `yaml:"resources,omitempty"`
+}
+
+type memoArchiveLocation struct {
+	Placeholder string  `yaml:"placeholder,omitempty"`
+	Latitude    float64 `yaml:"latitude"`
+	Longitude   float64 `yaml:"longitude"`
+}
+
+// memoArchiveRelation is a relation from the memo to the memo with the uid.
+type memoArchiveRelation struct {
+	Type string `yaml:"type"`
+	Memo string `yaml:"memo"`
+}
+
+type memoArchiveReaction struct {
+	Reaction string `yaml:"reaction"`
+	// Creator is the username of the creator of the reaction.
+	Creator string `yaml:"creator"`
+}
+
+// memoArchiveResource is a resource attached to the memo.
+// Path is the path of the blob in the archive, and Link is the URL of an external resource.
+type memoArchiveResource struct {
+	Filename string `yaml:"filename"`
+	Type     string `yaml:"type,omitempty"`
+	Path     string `yaml:"path,omitempty"`
+	Link     string `yaml:"link,omitempty"`
+}
+
+// marshalMemoArchiveMarkdown returns the Markdown file of a memo with its front matter.
+func marshalMemoArchiveMarkdown(frontMatter *memoArchiveFrontMatter, content string) ([]byte, error) {
+	var buffer bytes.Buffer
+	buffer.WriteString("---\n")
+	encoder := yaml.NewEncoder(&buffer)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(frontMatter); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	buffer.WriteString("---\n")
+	buffer.WriteString(content)
+	if !strings.HasSuffix(content, "\n") {
+		buffer.WriteString("\n")
+	}
+	return buffer.Bytes(), nil
+}
+
+func getMemoArchiveMemoPath(uid string) string {
+	return path.Join(memoArchiveMemoDir, uid+".md")
+}
+
+func getMemoArchiveResourcePath(uid, filename string) string {
+	// The filename must not escape the directory of the resource.
+	filename = strings.NewReplacer("/", "_", "\\", "_").Replace(filename)
+	if filename == "" || filename == "." || filename == ".." {
+		filename = "resource"
+	}
+	return path.Join(memoArchiveResourceDir, uid, filename)
+}
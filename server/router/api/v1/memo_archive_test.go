@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshalMemoArchiveMarkdown(t *testing.T) {
+	markdown, err := marshalMemoArchiveMarkdown(&memoArchiveFrontMatter{
+		UID:        "memo-1",
+		Visibility: "PRIVATE",
+		RowStatus:  "NORMAL",
+		Tags:       []string{"work"},
+		CreateTime: time.Unix(1700000000, 0).UTC(),
+		UpdateTime: time.Unix(1700000000, 0).UTC(),
+		Relations:  []*memoArchiveRelation{{Type: "REFERENCE", Memo: "memo-2"}},
+	}, "#work")
+	require.NoError(t, err)
+	require.Equal(t, `---
+uid: memo-1
+visibility: PRIVATE
+row_status: NORMAL
+tags:
+  - work
+create_time: 2023-11-14T22:13:20Z
+update_time: 2023-11-14T22:13:20Z
+relations:
+  - type: REFERENCE
+    memo: memo-2
+---
+#work
+`, string(markdown))
+}
+
+func TestGetMemoArchiveResourcePath(t *testing.T) {
+	require.Equal(t, "resources/abc/image.png", getMemoArchiveResourcePath("abc", "image.png"))
+	require.Equal(t, "resources/abc/.._.._etc_passwd", getMemoArchiveResourcePath("abc", "../../etc/passwd"))
+	require.Equal(t, "resources/abc/resource", getMemoArchiveResourcePath("abc", ".."))
+	require.Equal(t, "memos/abc.md", getMemoArchiveMemoPath("abc"))
+}
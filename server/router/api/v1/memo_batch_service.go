@@ -0,0 +1,411 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/usememos/gomark/ast"
+	"github.com/usememos/gomark/parser"
+	"github.com/usememos/gomark/parser/tokenizer"
+	"github.com/usememos/gomark/restore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) BatchUpdateMemos(ctx context.Context, request *v1pb.BatchUpdateMemosRequest) (*v1pb.BatchUpdateMemosResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	paths := []string{}
+	if request.UpdateMask != nil {
+		paths = request.UpdateMask.Paths
+	}
+	if len(paths) == 0 && len(request.AddTags) == 0 && len(request.RemoveTags) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask or tags are required")
+	}
+	if len(paths) != 0 && request.Memo == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "memo is required")
+	}
+	if _, err := updateMemoContentTags("", request.AddTags, nil); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tags: %v", err)
+	}
+	for _, path := range paths {
+		if path == "visibility" {
+			workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace memo related setting")
+			}
+			if workspaceMemoRelatedSetting.DisallowPublicVisibility && convertVisibilityToStore(request.Memo.Visibility) == store.Public {
+				return nil, status.Errorf(codes.PermissionDenied, "disable public memos system setting is enabled")
+			}
+		} else if path != "row_status" && path != "pinned" {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid update path: %s", path)
+		}
+	}
+
+	batchMemos, err := s.listBatchMemos(ctx, user, request.Names, request.Etags, request.Filter, false)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().Unix()
+	updates, organizers, previousMemos := []*store.UpdateMemo{}, []*store.MemoOrganizer{}, map[int32]*store.Memo{}
+	for _, batchMemo := range batchMemos {
+		if batchMemo.memo == nil {
+			continue
+		}
+		memo := batchMemo.memo
+		previousMemos[memo.ID] = &store.Memo{Content: memo.Content, Visibility: memo.Visibility}
+		update := &store.UpdateMemo{
+			ID:        memo.ID,
+			UpdatedTs: &now,
+		}
+		if batchMemo.etag != "" {
+			update.ExpectedVersion = &memo.Version
+		}
+		for _, path := range paths {
+			if path == "visibility" {
+				visibility := convertVisibilityToStore(request.Memo.Visibility)
+				if memo.PublishTs != nil {
+					// A scheduled memo stays private, and the visibility is applied once it is published.
+					update.PublishVisibility = &visibility
+				} else {
+					update.Visibility = &visibility
+				}
+			} else if path == "row_status" {
+				rowStatus := convertRowStatusToStore(request.Memo.RowStatus)
+				update.RowStatus = &rowStatus
+			} else if path == "pinned" {
+				organizers = append(organizers, &store.MemoOrganizer{
+					MemoID: memo.ID,
+					UserID: user.ID,
+					Pinned: request.Memo.Pinned,
+				})
+			}
+		}
+		if len(request.AddTags) != 0 || len(request.RemoveTags) != 0 {
+			content, err := updateMemoContentTags(memo.Content, request.AddTags, request.RemoveTags)
+			if err != nil {
+				batchMemo.err = errors.Wrap(err, "failed to update tags").Error()
+				continue
+			}
+			if content != memo.Content {
+				hasImage := memo.Payload.GetProperty().GetHasImage()
+				memo.Content = content
+				if err := memopayload.RebuildMemoPayload(memo); err != nil {
+					return nil, status.Errorf(codes.Internal, "failed to rebuild memo payload: %v", err)
+				}
+				// The attached images are not part of the content.
+				memo.Payload.Property.HasImage = memo.Payload.Property.HasImage || hasImage
+				update.Content = &memo.Content
+				update.Payload = memo.Payload
+			}
+		}
+		updates = append(updates, update)
+	}
+
+	if err := s.Store.BatchUpdateMemos(ctx, updates, organizers); err != nil {
+		if errors.Is(err, store.ErrMemoVersionMismatch) {
+			return nil, status.Errorf(codes.FailedPrecondition, "memos were updated since the etags")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update memos: %v", err)
+	}
+
+	for _, update := range updates {
+		previous := previousMemos[update.ID]
+		if (update.Content != nil && *update.Content != previous.Content) || (update.Visibility != nil && *update.Visibility != previous.Visibility) {
+			if err := s.createMemoRevision(ctx, &store.MemoRevision{
+				MemoID:     update.ID,
+				CreatorID:  user.ID,
+				Content:    previous.Content,
+				Visibility: previous.Visibility,
+			}); err != nil {
+				slog.Warn("Failed to create memo revision", slog.Any("err", err))
+			}
+		}
+
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &update.ID})
+		if err != nil || memo == nil {
+			slog.Warn("Failed to get updated memo", slog.Any("err", err))
+			continue
+		}
+		memoMessage, err := s.convertMemoFromStore(ctx, memo, v1pb.MemoView_MEMO_VIEW_FULL)
+		if err != nil {
+			slog.Warn("Failed to convert memo", slog.Any("err", err))
+			continue
+		}
+		// Try to dispatch webhook when memo is updated.
+		if err := s.DispatchMemoUpdatedWebhook(ctx, memoMessage); err != nil {
+			slog.Warn("Failed to dispatch memo updated webhook", slog.Any("err", err))
+		}
+	}
+
+	return &v1pb.BatchUpdateMemosResponse{
+		Results: convertBatchMemoResults(batchMemos),
+	}, nil
+}
+
+func (s *APIV1Service) BatchDeleteMemos(ctx context.Context, request *v1pb.BatchDeleteMemosRequest) (*v1pb.BatchDeleteMemosResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	// Deleting a memo in the trash deletes it permanently, so the named memos are looked up in the trash too.
+	batchMemos, err := s.listBatchMemos(ctx, user, request.Names, request.Etags, request.Filter, true)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().Unix()
+	updates, purges := []*store.UpdateMemo{}, []*selectedMemo{}
+	// The memo deleted webhook is dispatched when the memo leaves the user's view,
+	// so it is not dispatched again when a trashed memo is deleted permanently.
+	// The messages are converted before the memos are deleted, and dispatched once they are.
+	memoMessages := map[int32]*v1pb.Memo{}
+	for _, batchMemo := range batchMemos {
+		memo := batchMemo.memo
+		if memo == nil {
+			continue
+		}
+		if memo.TrashedTs == nil {
+			if memoMessage, err := s.convertMemoFromStore(ctx, memo, v1pb.MemoView_MEMO_VIEW_METADATA_ONLY); err == nil {
+				memoMessages[memo.ID] = memoMessage
+			}
+		}
+		if memo.TrashedTs == nil && !request.Force {
+			update := &store.UpdateMemo{
+				ID:        memo.ID,
+				TrashedTs: &now,
+			}
+			if batchMemo.etag != "" {
+				update.ExpectedVersion = &memo.Version
+			}
+			updates = append(updates, update)
+		} else {
+			purges = append(purges, batchMemo)
+		}
+	}
+
+	if err := s.Store.BatchUpdateMemos(ctx, updates, nil); err != nil {
+		if errors.Is(err, store.ErrMemoVersionMismatch) {
+			return nil, status.Errorf(codes.FailedPrecondition, "memos were updated since the etags")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to move memos to trash: %v", err)
+	}
+	deletedIDs := []int32{}
+	for _, update := range updates {
+		deletedIDs = append(deletedIDs, update.ID)
+	}
+	// Purging also deletes the resource blobs, which cannot be rolled back, so the memos are purged one by one.
+	for _, batchMemo := range purges {
+		if err := s.Store.PurgeMemo(ctx, batchMemo.memo.ID); err != nil {
+			batchMemo.err = fmt.Sprintf("failed to delete memo: %v", err)
+			continue
+		}
+		deletedIDs = append(deletedIDs, batchMemo.memo.ID)
+	}
+	for _, id := range deletedIDs {
+		if memoMessage, ok := memoMessages[id]; ok {
+			// Try to dispatch webhook when memo is deleted.
+			if err := s.DispatchMemoDeletedWebhook(ctx, memoMessage); err != nil {
+				slog.Warn("Failed to dispatch memo deleted webhook", slog.Any("err", err))
+			}
+		}
+	}
+
+	return &v1pb.BatchDeleteMemosResponse{
+		Results: convertBatchMemoResults(batchMemos),
+	}, nil
+}
+
+// selectedMemo is a memo selected by a batch operation, with the reason it is skipped if any.
+type selectedMemo struct {
+	name string
+	memo *store.Memo
+	// etag is the etag the operation on the memo is based on, empty to skip the check.
+	etag string
+	err  string
+}
+
+// listBatchMemos returns the memos selected by either the names, with their etags if any, or the filter.
+// The memos that cannot be changed by the user, or were updated since their etag,
+// are returned without the memo and with the reason instead.
+func (s *APIV1Service) listBatchMemos(ctx context.Context, user *store.User, names, etags []string, filter string, includeTrashed bool) ([]*selectedMemo, error) {
+	if (len(names) == 0) == (filter == "") {
+		return nil, status.Errorf(codes.InvalidArgument, "exactly one of names and filter is required")
+	}
+	if len(etags) != 0 && len(etags) != len(names) {
+		return nil, status.Errorf(codes.InvalidArgument, "etags must have one etag for each name")
+	}
+
+	batchMemos := []*selectedMemo{}
+	if filter != "" {
+		memoFind := &store.FindMemo{
+			// Exclude comments by default.
+			ExcludeComments: true,
+		}
+		if err := s.buildMemoFindWithFilter(ctx, memoFind, filter); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to build find memos with filter: %v", err)
+		}
+		memos, err := s.Store.ListMemos(ctx, memoFind)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+		}
+		for _, memo := range memos {
+			batchMemos = append(batchMemos, &selectedMemo{
+				name: fmt.Sprintf("%s%d", MemoNamePrefix, memo.ID),
+				memo: memo,
+			})
+		}
+	} else {
+		for i, name := range names {
+			batchMemo := &selectedMemo{name: name}
+			if len(etags) != 0 {
+				batchMemo.etag = etags[i]
+			}
+			batchMemos = append(batchMemos, batchMemo)
+			id, err := ExtractMemoIDFromName(name)
+			if err != nil {
+				batchMemo.err = fmt.Sprintf("invalid memo name: %v", err)
+				continue
+			}
+			if slices.ContainsFunc(batchMemos[:len(batchMemos)-1], func(other *selectedMemo) bool { return other.memo != nil && other.memo.ID == id }) {
+				batchMemo.err = "duplicate memo name"
+				continue
+			}
+			memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &id})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+			}
+			if memo == nil && includeTrashed {
+				memo, err = s.Store.GetMemo(ctx, &store.FindMemo{ID: &id, Trashed: true})
+				if err != nil {
+					return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+				}
+			}
+			if memo == nil {
+				batchMemo.err = "memo not found"
+				continue
+			}
+			batchMemo.memo = memo
+		}
+	}
+
+	// Only the creator or admin can change the memo.
+	for _, batchMemo := range batchMemos {
+		if batchMemo.memo != nil && batchMemo.memo.CreatorID != user.ID && !isSuperUser(user) {
+			batchMemo.memo, batchMemo.err = nil, "permission denied"
+		} else if batchMemo.memo != nil && batchMemo.etag != "" && batchMemo.etag != getMemoEtag(batchMemo.memo) {
+			batchMemo.memo, batchMemo.err = nil, "memo was updated since the etag"
+		}
+	}
+	return batchMemos, nil
+}
+
+func convertBatchMemoResults(batchMemos []*selectedMemo) []*v1pb.BatchMemoResult {
+	results := []*v1pb.BatchMemoResult{}
+	for _, batchMemo := range batchMemos {
+		results = append(results, &v1pb.BatchMemoResult{
+			Name:  batchMemo.name,
+			Error: batchMemo.err,
+		})
+	}
+	return results
+}
+
+// updateMemoContentTags removes the tags from the content, and appends the added tags the content does not have yet.
+func updateMemoContentTags(content string, addTags, removeTags []string) (string, error) {
+	nodes, err := parser.Parse(tokenizer.Tokenize(content))
+	if err != nil {
+		return "", err
+	}
+	if len(removeTags) != 0 {
+		nodes = removeTagNodes(nodes, removeTags)
+		content = restore.Restore(nodes)
+	}
+
+	existingTags := []string{}
+	memopayload.TraverseASTNodes(nodes, func(node ast.Node) {
+		if tag, ok := node.(*ast.Tag); ok {
+			existingTags = append(existingTags, tag.Content)
+		}
+	})
+	appendedTags := []string{}
+	for _, tag := range addTags {
+		tag = strings.TrimPrefix(tag, "#")
+		if tag == "" || strings.ContainsAny(tag, " \t\n") {
+			return "", errors.Errorf("invalid tag %q", tag)
+		}
+		if !slices.Contains(existingTags, tag) && !slices.Contains(appendedTags, "#"+tag) {
+			appendedTags = append(appendedTags, "#"+tag)
+		}
+	}
+	if len(appendedTags) != 0 {
+		if content != "" {
+			content += "\n"
+		}
+		content += strings.Join(appendedTags, " ")
+	}
+	return content, nil
+}
+
+// removeTagNodes removes the tag nodes with any of the tags from the nodes and their children,
+// along with the spaces before them, or after them if there is none before.
+func removeTagNodes(nodes []ast.Node, tags []string) []ast.Node {
+	list := []ast.Node{}
+	trimNext := false
+	for _, node := range nodes {
+		if text, ok := node.(*ast.Text); ok && trimNext {
+			text.Content = strings.TrimLeft(text.Content, " \t")
+		}
+		trimNext = false
+		switch n := node.(type) {
+		case *ast.Tag:
+			if slices.Contains(tags, n.Content) {
+				if len(list) != 0 {
+					if text, ok := list[len(list)-1].(*ast.Text); ok && strings.TrimRight(text.Content, " \t") != text.Content {
+						text.Content = strings.TrimRight(text.Content, " \t")
+						continue
+					}
+				}
+				trimNext = true
+				continue
+			}
+		case *ast.Paragraph:
+			n.Children = removeTagNodes(n.Children, tags)
+		case *ast.Heading:
+			n.Children = removeTagNodes(n.Children, tags)
+		case *ast.Blockquote:
+			n.Children = removeTagNodes(n.Children, tags)
+		case *ast.List:
+			n.Children = removeTagNodes(n.Children, tags)
+		case *ast.OrderedListItem:
+			n.Children = removeTagNodes(n.Children, tags)
+		case *ast.UnorderedListItem:
+			n.Children = removeTagNodes(n.Children, tags)
+		case *ast.TaskListItem:
+			n.Children = removeTagNodes(n.Children, tags)
+		case *ast.Bold:
+			n.Children = removeTagNodes(n.Children, tags)
+		}
+		list = append(list, node)
+	}
+	return list
+}
@@ -0,0 +1,110 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestUpdateMemoContentTags(t *testing.T) {
+	tests := []struct {
+		content    string
+		addTags    []string
+		removeTags []string
+		want       string
+	}{
+		{
+			content: "Hello world",
+			addTags: []string{"work", "#todo"},
+			want:    "Hello world\n#work #todo",
+		},
+		{
+			content: "Hello #work world",
+			addTags: []string{"work"},
+			want:    "Hello #work world",
+		},
+		{
+			content:    "Hello #work #work/meeting world",
+			removeTags: []string{"work"},
+			want:       "Hello #work/meeting world",
+		},
+		{
+			content:    "#work #todo Hello #work",
+			removeTags: []string{"work", "todo"},
+			want:       "Hello",
+		},
+		{
+			content:    "#old",
+			addTags:    []string{"new"},
+			removeTags: []string{"old"},
+			want:       "#new",
+		},
+	}
+	for _, test := range tests {
+		content, err := updateMemoContentTags(test.content, test.addTags, test.removeTags)
+		require.NoError(t, err)
+		require.Equal(t, test.want, content)
+	}
+
+	_, err := updateMemoContentTags("Hello", []string{"two words"}, nil)
+	require.Error(t, err)
+}
+
+func TestBatchUpdateMemosWithEtags(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user, userCtx := createTestingUser(ctx, t, s, "test")
+	names, etags := []string{}, []string{}
+	for _, uid := range []string{"current", "stale"} {
+		memo, err := s.Store.CreateMemo(ctx, &store.Memo{
+			UID:        uid,
+			CreatorID:  user.ID,
+			Content:    uid,
+			Visibility: store.Private,
+		})
+		require.NoError(t, err)
+		names, etags = append(names, fmt.Sprintf("%s%d", MemoNamePrefix, memo.ID)), append(etags, getMemoEtag(memo))
+	}
+	content := "updated"
+	memoID, err := ExtractMemoIDFromName(names[1])
+	require.NoError(t, err)
+	require.NoError(t, s.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: memoID, Content: &content}))
+
+	response, err := s.BatchUpdateMemos(userCtx, &v1pb.BatchUpdateMemosRequest{
+		Names:      names,
+		Etags:      etags,
+		Memo:       &v1pb.Memo{Visibility: v1pb.Visibility_PUBLIC},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}},
+	})
+	require.NoError(t, err)
+	require.Empty(t, response.Results[0].Error)
+	require.NotEmpty(t, response.Results[1].Error)
+	for i, visibility := range []v1pb.Visibility{v1pb.Visibility_PUBLIC, v1pb.Visibility_PRIVATE} {
+		memo, err := s.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: names[i]})
+		require.NoError(t, err)
+		require.Equal(t, visibility, memo.Visibility)
+	}
+
+	// The memos are not deleted with a stale etag either.
+	deleteResponse, err := s.BatchDeleteMemos(userCtx, &v1pb.BatchDeleteMemosRequest{
+		Names: names,
+		Etags: []string{"", etags[1]},
+	})
+	require.NoError(t, err)
+	require.Empty(t, deleteResponse.Results[0].Error)
+	require.NotEmpty(t, deleteResponse.Results[1].Error)
+	_, err = s.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: names[1]})
+	require.NoError(t, err)
+
+	_, err = s.BatchDeleteMemos(userCtx, &v1pb.BatchDeleteMemosRequest{
+		Names: names,
+		Etags: []string{""},
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,260 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// memoCollaboratorEditablePaths are the update mask paths an editor of a memo can update.
+var memoCollaboratorEditablePaths = []string{"content", "location", "pinned"}
+
+func (s *APIV1Service) SetMemoCollaborators(ctx context.Context, request *v1pb.SetMemoCollaboratorsRequest) (*emptypb.Empty, error) {
+	id, err := ExtractMemoIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &id})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	// Only the creator can manage the collaborators of the memo.
+	if memo.CreatorID != user.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if err := s.checkMemoEtag(ctx, memo, request.Etag); err != nil {
+		return nil, err
+	}
+
+	collaborators := []*store.MemoCollaborator{}
+	userIDs := []int32{}
+	for _, collaborator := range request.Collaborators {
+		userID, err := ExtractUserIDFromName(collaborator.User)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+		}
+		if userID == memo.CreatorID {
+			return nil, status.Errorf(codes.InvalidArgument, "the creator cannot be a collaborator of the memo")
+		}
+		if slices.Contains(userIDs, userID) {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate collaborator %q", collaborator.User)
+		}
+		role, err := convertMemoCollaboratorRoleToStore(collaborator.Role)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid role of collaborator %q: %v", collaborator.User, err)
+		}
+		collaboratorUser, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+		}
+		if collaboratorUser == nil {
+			return nil, status.Errorf(codes.NotFound, "user %q not found", collaborator.User)
+		}
+		userIDs = append(userIDs, userID)
+		collaborators = append(collaborators, &store.MemoCollaborator{
+			MemoID: memo.ID,
+			UserID: userID,
+			Role:   role,
+		})
+	}
+
+	existingCollaborators, err := s.Store.ListMemoCollaborators(ctx, &store.FindMemoCollaborator{MemoID: &memo.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo collaborators: %v", err)
+	}
+	existingUserIDs := []int32{}
+	for _, collaborator := range existingCollaborators {
+		existingUserIDs = append(existingUserIDs, collaborator.UserID)
+		if slices.Contains(userIDs, collaborator.UserID) {
+			continue
+		}
+		if err := s.Store.DeleteMemoCollaborator(ctx, &store.DeleteMemoCollaborator{
+			MemoID: &memo.ID,
+			UserID: &collaborator.UserID,
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to delete memo collaborator: %v", err)
+		}
+	}
+	for _, collaborator := range collaborators {
+		if _, err := s.Store.UpsertMemoCollaborator(ctx, collaborator); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to upsert memo collaborator: %v", err)
+		}
+		// Only the users who were just added are notified.
+		if slices.Contains(existingUserIDs, collaborator.UserID) {
+			continue
+		}
+		if err := s.notifyMemoCollaborator(ctx, user.ID, collaborator); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to notify memo collaborator: %v", err)
+		}
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) ListMemoCollaborators(ctx context.Context, request *v1pb.ListMemoCollaboratorsRequest) (*v1pb.ListMemoCollaboratorsResponse, error) {
+	id, err := ExtractMemoIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &id, ExcludeContent: true})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	// The creator and the collaborators can see who else has access to the memo.
+	if memo.CreatorID != user.ID {
+		collaborator, err := s.Store.GetMemoCollaborator(ctx, &store.FindMemoCollaborator{MemoID: &memo.ID, UserID: &user.ID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo collaborator: %v", err)
+		}
+		if collaborator == nil {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+	}
+
+	collaborators, err := s.Store.ListMemoCollaborators(ctx, &store.FindMemoCollaborator{MemoID: &memo.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo collaborators: %v", err)
+	}
+	response := &v1pb.ListMemoCollaboratorsResponse{}
+	for _, collaborator := range collaborators {
+		response.Collaborators = append(response.Collaborators, convertMemoCollaboratorFromStore(collaborator))
+	}
+	return response, nil
+}
+
+// hasMemoCollaboratorRole returns whether the user is a collaborator of the memo with the role.
+// An editor also has the viewer role.
+func (s *APIV1Service) hasMemoCollaboratorRole(ctx context.Context, memoID, userID int32, role store.MemoCollaboratorRole) (bool, error) {
+	collaborator, err := s.Store.GetMemoCollaborator(ctx, &store.FindMemoCollaborator{
+		MemoID: &memoID,
+		UserID: &userID,
+	})
+	if err != nil {
+		return false, err
+	}
+	if collaborator == nil {
+		return false, nil
+	}
+	return role == store.MemoCollaboratorViewer || collaborator.Role == store.MemoCollaboratorEditor, nil
+}
+
+// checkMemoEditor returns a PERMISSION_DENIED error unless the user is the creator of the memo,
+// an admin, or an editor of the memo.
+func (s *APIV1Service) checkMemoEditor(ctx context.Context, memo *store.Memo, user *store.User) error {
+	if memo.CreatorID == user.ID || isSuperUser(user) {
+		return nil
+	}
+	isEditor, err := s.hasMemoCollaboratorRole(ctx, memo.ID, user.ID, store.MemoCollaboratorEditor)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get memo collaborator: %v", err)
+	}
+	if !isEditor {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return nil
+}
+
+// isMemoVisibleToUser reports whether the user, nil if not signed in, can see the memo.
+// A private memo is visible to its creator and its collaborators.
+func (s *APIV1Service) isMemoVisibleToUser(ctx context.Context, memo *store.Memo, user *store.User) (bool, error) {
+	if memo.Visibility == store.Public {
+		return true, nil
+	}
+	if user == nil {
+		return false, nil
+	}
+	if memo.Visibility != store.Private || memo.CreatorID == user.ID {
+		return true, nil
+	}
+	return s.hasMemoCollaboratorRole(ctx, memo.ID, user.ID, store.MemoCollaboratorViewer)
+}
+
+// notifyMemoCollaborator sends an inbox message to the user added as a collaborator of the memo.
+func (s *APIV1Service) notifyMemoCollaborator(ctx context.Context, senderID int32, collaborator *store.MemoCollaborator) error {
+	activity, err := s.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: senderID,
+		Type:      store.ActivityTypeMemoCollaborator,
+		Level:     store.ActivityLevelInfo,
+		Payload: &storepb.ActivityPayload{
+			MemoCollaborator: &storepb.ActivityMemoCollaboratorPayload{
+				MemoId: collaborator.MemoID,
+				Role:   collaborator.Role.String(),
+			},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create activity")
+	}
+	if _, err := s.Store.CreateInbox(ctx, &store.Inbox{
+		SenderID:   senderID,
+		ReceiverID: collaborator.UserID,
+		Status:     store.UNREAD,
+		Message: &storepb.InboxMessage{
+			Type:       storepb.InboxMessage_MEMO_COLLABORATOR,
+			ActivityId: &activity.ID,
+		},
+	}); err != nil {
+		return errors.Wrap(err, "failed to create inbox")
+	}
+	return nil
+}
+
+func convertMemoCollaboratorFromStore(collaborator *store.MemoCollaborator) *v1pb.MemoCollaborator {
+	return &v1pb.MemoCollaborator{
+		User:       fmt.Sprintf("%s%d", UserNamePrefix, collaborator.UserID),
+		Role:       convertMemoCollaboratorRoleFromStore(collaborator.Role),
+		CreateTime: timestamppb.New(time.Unix(collaborator.CreatedTs, 0)),
+	}
+}
+
+func convertMemoCollaboratorRoleFromStore(role store.MemoCollaboratorRole) v1pb.MemoCollaborator_Role {
+	switch role {
+	case store.MemoCollaboratorViewer:
+		return v1pb.MemoCollaborator_VIEWER
+	case store.MemoCollaboratorEditor:
+		return v1pb.MemoCollaborator_EDITOR
+	default:
+		return v1pb.MemoCollaborator_ROLE_UNSPECIFIED
+	}
+}
+
+func convertMemoCollaboratorRoleToStore(role v1pb.MemoCollaborator_Role) (store.MemoCollaboratorRole, error) {
+	switch role {
+	case v1pb.MemoCollaborator_VIEWER:
+		return store.MemoCollaboratorViewer, nil
+	case v1pb.MemoCollaborator_EDITOR:
+		return store.MemoCollaboratorEditor, nil
+	default:
+		return "", errors.Errorf("unsupported role %q", role.String())
+	}
+}
@@ -0,0 +1,281 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/bits"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// DefaultDuplicateMemoDistance is the number of bits the fingerprints of duplicates differ in by default.
+	DefaultDuplicateMemoDistance = 3
+	// MaxDuplicateMemoDistance is the maximum number of bits the fingerprints of duplicates can differ in.
+	MaxDuplicateMemoDistance = 10
+)
+
+func (s *APIV1Service) ListDuplicateMemos(ctx context.Context, request *v1pb.ListDuplicateMemosRequest) (*v1pb.ListDuplicateMemosResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	maxDistance := int(request.MaxDistance)
+	if maxDistance <= 0 {
+		maxDistance = DefaultDuplicateMemoDistance
+	}
+	if maxDistance > MaxDuplicateMemoDistance {
+		return nil, status.Errorf(codes.InvalidArgument, "max distance must be at most %d", MaxDuplicateMemoDistance)
+	}
+
+	normalStatus := store.Normal
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:       &user.ID,
+		RowStatus:       &normalStatus,
+		ExcludeComments: true,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+
+	response := &v1pb.ListDuplicateMemosResponse{
+		Groups: []*v1pb.DuplicateMemoGroup{},
+	}
+	for _, group := range groupDuplicateMemos(memos, maxDistance) {
+		duplicateMemoGroup := &v1pb.DuplicateMemoGroup{}
+		for _, memo := range group {
+			snippet, err := getMemoContentSnippet(memo.Content)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get memo content snippet")
+			}
+			duplicateMemoGroup.Memos = append(duplicateMemoGroup.Memos, &v1pb.MemoRelation_Memo{
+				Name:    fmt.Sprintf("%s%d", MemoNamePrefix, memo.ID),
+				Uid:     memo.UID,
+				Snippet: snippet,
+			})
+		}
+		response.Groups = append(response.Groups, duplicateMemoGroup)
+	}
+	return response, nil
+}
+
+// groupDuplicateMemos groups the memos whose fingerprints differ in at most maxDistance bits,
+// directly or through other memos of the group. The groups and their memos keep the order of the memos.
+func groupDuplicateMemos(memos []*store.Memo, maxDistance int) [][]*store.Memo {
+	// parents is a union-find forest of the indexes of the memos.
+	parents := make([]int, len(memos))
+	for i := range parents {
+		parents[i] = i
+	}
+	var find func(i int) int
+	find = func(i int) int {
+		if parents[i] != i {
+			parents[i] = find(parents[i])
+		}
+		return parents[i]
+	}
+	for i, memo := range memos {
+		simhash := memo.Payload.GetProperty().GetSimhash()
+		// Memos without terms have no fingerprint to compare.
+		if simhash == 0 {
+			continue
+		}
+		for j := 0; j < i; j++ {
+			other := memos[j].Payload.GetProperty().GetSimhash()
+			if other != 0 && bits.OnesCount64(simhash^other) <= maxDistance {
+				// The root of the earlier memo is kept, so the groups are in the order of their first memo.
+				parents[find(i)] = find(j)
+			}
+		}
+	}
+
+	groups := [][]*store.Memo{}
+	groupIndexes := map[int]int{}
+	for i, memo := range memos {
+		root := find(i)
+		index, ok := groupIndexes[root]
+		if !ok {
+			index = len(groups)
+			groupIndexes[root] = index
+			groups = append(groups, []*store.Memo{})
+		}
+		groups[index] = append(groups[index], memo)
+	}
+	return slices.DeleteFunc(groups, func(group []*store.Memo) bool {
+		return len(group) < 2
+	})
+}
+
+func (s *APIV1Service) MergeMemos(ctx context.Context, request *v1pb.MergeMemosRequest) (*v1pb.Memo, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if len(request.Memos) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "memos are required")
+	}
+	memo, err := s.getMergedMemo(ctx, user, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	mergedMemos := []*store.Memo{}
+	for _, name := range request.Memos {
+		mergedMemo, err := s.getMergedMemo(ctx, user, name)
+		if err != nil {
+			return nil, err
+		}
+		if mergedMemo.ID == memo.ID || slices.ContainsFunc(mergedMemos, func(other *store.Memo) bool { return other.ID == mergedMemo.ID }) {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate memo name %q", name)
+		}
+		mergedMemos = append(mergedMemos, mergedMemo)
+	}
+
+	// The contents are appended once, so exact duplicates are not repeated.
+	contents := []string{}
+	for _, contentMemo := range append([]*store.Memo{memo}, mergedMemos...) {
+		if content := strings.TrimSpace(contentMemo.Content); content != "" && !slices.Contains(contents, content) {
+			contents = append(contents, content)
+		}
+	}
+	content := strings.Join(contents, "\n\n")
+	contentLengthLimit, err := s.getContentLengthLimit(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get content length limit")
+	}
+	if len(content) > contentLengthLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "merged content too long (max %d characters)", contentLengthLimit)
+	}
+
+	// The merged content is saved along with the moves in one transaction, so the payload is rebuilt beforehand
+	// with the resources of all the memos of the merge.
+	previousContent, previousVisibility := memo.Content, memo.Visibility
+	previousReferences := memo.Payload.GetReferences()
+	previousMemo := &store.Memo{Content: memo.Content, Payload: &storepb.MemoPayload{Property: memo.Payload.GetProperty()}}
+	memo.Content = content
+	if err := memopayload.RebuildMemoPayload(memo); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to rebuild memo payload: %v", err)
+	}
+	ruleEffects, err := s.applyMemoRules(ctx, memo, "", previousMemo)
+	if err != nil {
+		return nil, err
+	}
+	for _, resourceMemo := range append([]*store.Memo{memo}, mergedMemos...) {
+		resources, err := s.Store.ListResources(ctx, &store.FindResource{MemoID: &resourceMemo.ID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list resources")
+		}
+		if slices.ContainsFunc(resources, func(resource *store.Resource) bool { return strings.HasPrefix(resource.Type, "image/") }) {
+			memo.Payload.Property.HasImage = true
+		}
+	}
+	now := time.Now().Unix()
+	// Every memo of the merge must be at the version it was read at, so that no concurrent change is lost.
+	merge := &store.MergeMemos{
+		Update: &store.UpdateMemo{
+			ID:              memo.ID,
+			Content:         &memo.Content,
+			Payload:         memo.Payload,
+			UpdatedTs:       &now,
+			ExpectedVersion: &memo.Version,
+		},
+		ContentID: fmt.Sprintf("%s%d", MemoNamePrefix, memo.ID),
+	}
+	if ruleEffects.visibility != nil {
+		if memo.PublishTs != nil {
+			// A scheduled memo stays private, and the visibility is applied once it is published.
+			merge.Update.PublishVisibility = ruleEffects.visibility
+		} else {
+			merge.Update.Visibility = ruleEffects.visibility
+		}
+	}
+	if memo.PublishTs != nil {
+		deferMemoRuleWebhooks(memo, ruleEffects)
+	}
+	archived := store.Archived
+	for _, mergedMemo := range mergedMemos {
+		merge.Merged = append(merge.Merged, &store.MergedMemo{
+			Update: &store.UpdateMemo{
+				ID:              mergedMemo.ID,
+				RowStatus:       &archived,
+				UpdatedTs:       &now,
+				ExpectedVersion: &mergedMemo.Version,
+			},
+			ContentID: fmt.Sprintf("%s%d", MemoNamePrefix, mergedMemo.ID),
+		})
+	}
+	if err := s.Store.MergeMemos(ctx, merge); err != nil {
+		if errors.Is(err, store.ErrMemoVersionMismatch) {
+			return nil, status.Errorf(codes.FailedPrecondition, "memos were updated during the merge")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to merge memos: %v", err)
+	}
+
+	if err := memopayload.SyncMemoReferences(ctx, s.Store, memo, previousReferences); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to sync memo references: %v", err)
+	}
+	if memo.Content != previousContent || (merge.Update.Visibility != nil && *merge.Update.Visibility != previousVisibility) {
+		if err := s.createMemoRevision(ctx, &store.MemoRevision{
+			MemoID:     memo.ID,
+			CreatorID:  user.ID,
+			Content:    previousContent,
+			Visibility: previousVisibility,
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create memo revision: %v", err)
+		}
+	}
+	memo, err = s.Store.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if err := s.applyMemoRuleActions(ctx, memo, ruleEffects); err != nil {
+		return nil, err
+	}
+	memoMessage, err := s.convertMemoFromStore(ctx, memo, v1pb.MemoView_MEMO_VIEW_FULL)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert memo")
+	}
+	// Try to dispatch webhook when memo is updated.
+	if err := s.DispatchMemoUpdatedWebhook(ctx, memoMessage); err != nil {
+		slog.Warn("Failed to dispatch memo updated webhook", slog.Any("err", err))
+	}
+	if memo.PublishTs == nil {
+		s.dispatchMemoRuleWebhooks(ctx, memoMessage, ruleEffects.webhookIDs)
+	}
+	return memoMessage, nil
+}
+
+// getMergedMemo returns the memo of a merge, which only its creator or an admin can merge.
+func (s *APIV1Service) getMergedMemo(ctx context.Context, user *store.User, name string) (*store.Memo, error) {
+	id, err := ExtractMemoIDFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &id})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo %q not found", name)
+	}
+	if memo.CreatorID != user.ID && !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return memo, nil
+}
@@ -0,0 +1,87 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestGroupDuplicateMemos(t *testing.T) {
+	newMemo := func(id int32, simhash uint64) *store.Memo {
+		return &store.Memo{
+			ID:      id,
+			Payload: &storepb.MemoPayload{Property: &storepb.MemoPayload_Property{Simhash: simhash}},
+		}
+	}
+	memos := []*store.Memo{
+		newMemo(6, 0xff),
+		newMemo(5, 0xff000000),
+		newMemo(4, 0x7f),
+		newMemo(3, 0),
+		// Memo 2 differs from memo 4 in 3 bits and from memo 6 in 4 bits.
+		newMemo(2, 0x77f),
+		newMemo(1, 0xff000000),
+	}
+
+	groupIDs := func(groups [][]*store.Memo) [][]int32 {
+		ids := [][]int32{}
+		for _, group := range groups {
+			groupIDs := []int32{}
+			for _, memo := range group {
+				groupIDs = append(groupIDs, memo.ID)
+			}
+			ids = append(ids, groupIDs)
+		}
+		return ids
+	}
+	require.Equal(t, [][]int32{{6, 4}, {5, 1}}, groupIDs(groupDuplicateMemos(memos, 1)))
+	// Memo 2 is grouped with memo 6 through memo 4.
+	require.Equal(t, [][]int32{{6, 4, 2}, {5, 1}}, groupIDs(groupDuplicateMemos(memos, 3)))
+	// Memos without fingerprints are never duplicates.
+	require.Empty(t, groupDuplicateMemos(memos[3:4], 10))
+}
+
+func TestMergeMemos(t *testing.T) {
+	ctx := context.Background()
+	service := newTestingService(ctx, t)
+	user, userCtx := createTestingUser(ctx, t, service, "test")
+	memos := []*store.Memo{}
+	for _, content := range []string{"one", "two", "one"} {
+		memo, err := service.Store.CreateMemo(ctx, &store.Memo{
+			UID:        fmt.Sprintf("memo-%d", len(memos)+1),
+			CreatorID:  user.ID,
+			Content:    content,
+			Visibility: store.Private,
+		})
+		require.NoError(t, err)
+		memos = append(memos, memo)
+	}
+	_, err := service.Store.CreateResource(ctx, &store.Resource{
+		UID:       "resource",
+		CreatorID: user.ID,
+		Filename:  "image.png",
+		Type:      "image/png",
+		MemoID:    &memos[1].ID,
+	})
+	require.NoError(t, err)
+
+	memo, err := service.MergeMemos(userCtx, &v1pb.MergeMemosRequest{
+		Name:  fmt.Sprintf("memos/%d", memos[0].ID),
+		Memos: []string{fmt.Sprintf("memos/%d", memos[1].ID), fmt.Sprintf("memos/%d", memos[2].ID)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "one\n\ntwo", memo.Content)
+	require.Len(t, memo.Resources, 1)
+	require.True(t, memo.Property.HasImage)
+	for _, merged := range memos[1:] {
+		merged, err := service.Store.GetMemo(ctx, &store.FindMemo{ID: &merged.ID})
+		require.NoError(t, err)
+		require.Equal(t, store.Archived, merged.RowStatus)
+	}
+}
@@ -0,0 +1,87 @@
+package v1
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// getMemoEtag returns the etag of the memo, derived from its version.
+func getMemoEtag(memo *store.Memo) string {
+	return strconv.Itoa(int(memo.Version))
+}
+
+// checkMemoEtag returns a FAILED_PRECONDITION error if the memo was updated since the etag.
+// An empty etag skips the check.
+func (s *APIV1Service) checkMemoEtag(ctx context.Context, memo *store.Memo, etag string) error {
+	if etag == "" || etag == getMemoEtag(memo) {
+		return nil
+	}
+	return s.newMemoEtagMismatchError(ctx, memo)
+}
+
+// checkMemoEtagByID is checkMemoEtag for the mutations that do not get the memo otherwise.
+// The memo is looked up in the trash too, and the current user must be allowed to edit it,
+// so that the check does not tell anything about the memos of other users.
+func (s *APIV1Service) checkMemoEtagByID(ctx context.Context, memoID int32, etag string) error {
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoID})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		memo, err = s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoID, Trashed: true})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get memo: %v", err)
+		}
+	}
+	if memo == nil {
+		return status.Errorf(codes.NotFound, "memo not found")
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if err := s.checkMemoEditor(ctx, memo, user); err != nil {
+		return err
+	}
+	return s.checkMemoEtag(ctx, memo, etag)
+}
+
+// newMemoEtagMismatchError returns the FAILED_PRECONDITION error of a stale write,
+// with the current memo in its details so that the client can merge the changes.
+// The memo is only attached if the current user can see it.
+func (s *APIV1Service) newMemoEtagMismatchError(ctx context.Context, memo *store.Memo) error {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get current user")
+	}
+	visible, err := s.isMemoVisibleToUser(ctx, memo, user)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get memo collaborator: %v", err)
+	}
+	// The memos in the trash are only visible to their creators.
+	if memo.TrashedTs != nil && (user == nil || memo.CreatorID != user.ID) {
+		visible = false
+	}
+	if !visible {
+		return status.Errorf(codes.FailedPrecondition, "memo was updated since the etag")
+	}
+	memoMessage, err := s.convertMemoFromStore(ctx, memo, v1pb.MemoView_MEMO_VIEW_FULL)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert memo")
+	}
+	st, err := status.New(codes.FailedPrecondition, "memo was updated since the etag").WithDetails(memoMessage)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to attach memo to error: %v", err)
+	}
+	return st.Err()
+}
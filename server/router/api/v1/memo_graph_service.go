@@ -0,0 +1,271 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	defaultMemoGraphDepth = 1
+	maxMemoGraphDepth     = 5
+)
+
+func (s *APIV1Service) GetMemoGraph(ctx context.Context, request *v1pb.GetMemoGraphRequest) (*v1pb.MemoGraph, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	normalStatus := store.Normal
+	memoFind := &store.FindMemo{
+		RowStatus:           &normalStatus,
+		ContentPrefixLength: memoSnippetContentLength,
+	}
+	if user != nil {
+		memoFind.CreatorID = &user.ID
+	} else {
+		memoFind.VisibilityList = []store.Visibility{store.Public}
+	}
+
+	if request.Root == "" {
+		memos, err := s.Store.ListMemos(ctx, memoFind)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+		}
+		relations := []*store.MemoRelation{}
+		if len(memos) != 0 {
+			memoIDs := []int32{}
+			for _, memo := range memos {
+				memoIDs = append(memoIDs, memo.ID)
+			}
+			relations, err = s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
+				MemoIDList:        memoIDs,
+				RelatedMemoIDList: memoIDs,
+			})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to list memo relations: %v", err)
+			}
+		}
+		graph, err := newMemoGraph(memos, relations)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to build memo graph: %v", err)
+		}
+		return graph.convert(nil), nil
+	}
+
+	rootID, err := ExtractMemoIDFromName(request.Root)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid root: %v", err)
+	}
+	depth := int(request.Depth)
+	if depth <= 0 {
+		depth = defaultMemoGraphDepth
+	}
+	if depth > maxMemoGraphDepth {
+		depth = maxMemoGraphDepth
+	}
+	graph, included, err := s.getMemoGraphAround(ctx, memoFind, rootID, depth)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to build memo graph: %v", err)
+	}
+	if included == nil {
+		return nil, status.Errorf(codes.NotFound, "root memo not found")
+	}
+	return graph.convert(included), nil
+}
+
+// getMemoGraphAround expands the graph from the root memo outward, and returns it with the names of the nodes
+// at most depth edges away from the root, or nil names if the root is not found.
+// The graph also has the memos one more edge away, so that the degrees of the returned nodes are complete.
+func (s *APIV1Service) getMemoGraphAround(ctx context.Context, memoFind *store.FindMemo, rootID int32, depth int) (*memoGraph, map[string]bool, error) {
+	memos, relations := []*store.Memo{}, []*store.MemoRelation{}
+	loadedMemoIDs, seenTags, seenRelations := map[int32]bool{}, map[string]bool{}, map[string]bool{}
+	// loadMemos loads the visible memos with the IDs that are not loaded yet.
+	loadMemos := func(ids []int32) ([]*store.Memo, error) {
+		ids = slices.DeleteFunc(ids, func(id int32) bool { return loadedMemoIDs[id] })
+		if len(ids) == 0 {
+			return nil, nil
+		}
+		find := *memoFind
+		find.IDList = ids
+		list, err := s.Store.ListMemos(ctx, &find)
+		if err != nil {
+			return nil, err
+		}
+		for _, memo := range list {
+			loadedMemoIDs[memo.ID] = true
+		}
+		memos = append(memos, list...)
+		return list, nil
+	}
+
+	frontierMemos, err := loadMemos([]int32{rootID})
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(frontierMemos) == 0 {
+		return nil, nil, nil
+	}
+	included := map[string]bool{}
+	frontierTags := []string{}
+	for distance := 0; distance <= depth; distance++ {
+		frontierMemoIDs := []int32{}
+		for _, memo := range frontierMemos {
+			frontierMemoIDs = append(frontierMemoIDs, memo.ID)
+			included[fmt.Sprintf("%s%d", MemoNamePrefix, memo.ID)] = true
+		}
+		for _, tag := range frontierTags {
+			included[TagNamePrefix+tag] = true
+		}
+
+		// The memos next to the frontier are the memos related to its memos in either direction and the memos with its tags.
+		nextMemoIDs := []int32{}
+		if len(frontierMemoIDs) != 0 {
+			for _, find := range []*store.FindMemoRelation{{MemoIDList: frontierMemoIDs}, {RelatedMemoIDList: frontierMemoIDs}} {
+				list, err := s.Store.ListMemoRelations(ctx, find)
+				if err != nil {
+					return nil, nil, err
+				}
+				for _, relation := range list {
+					key := fmt.Sprintf("%d/%d/%s", relation.MemoID, relation.RelatedMemoID, relation.Type)
+					if seenRelations[key] {
+						continue
+					}
+					seenRelations[key] = true
+					relations = append(relations, relation)
+					nextMemoIDs = append(nextMemoIDs, relation.MemoID, relation.RelatedMemoID)
+				}
+			}
+		}
+		for _, tag := range frontierTags {
+			find := *memoFind
+			find.PayloadFind = &store.FindMemoPayload{TagSearch: []string{tag}}
+			find.ExcludeContent = true
+			list, err := s.Store.ListMemos(ctx, &find)
+			if err != nil {
+				return nil, nil, err
+			}
+			for _, memo := range list {
+				// The tag search also finds the memos with the sub-tags of the tag.
+				if slices.Contains(memo.Payload.GetTags(), tag) {
+					nextMemoIDs = append(nextMemoIDs, memo.ID)
+				}
+			}
+		}
+		nextTags := []string{}
+		for _, memo := range frontierMemos {
+			for _, tag := range memo.Payload.GetTags() {
+				if !seenTags[tag] {
+					seenTags[tag] = true
+					nextTags = append(nextTags, tag)
+				}
+			}
+		}
+		slices.Sort(nextMemoIDs)
+		if frontierMemos, err = loadMemos(slices.Compact(nextMemoIDs)); err != nil {
+			return nil, nil, err
+		}
+		frontierTags = nextTags
+	}
+
+	graph, err := newMemoGraph(memos, relations)
+	if err != nil {
+		return nil, nil, err
+	}
+	return graph, included, nil
+}
+
+// memoGraph is the graph of memos and tags, with the nodes named like in v1pb.MemoGraph.
+type memoGraph struct {
+	// nodeNames keeps the nodes in the order they were added.
+	nodeNames []string
+	nodes     map[string]*v1pb.MemoGraph_Node
+	edges     []*v1pb.MemoGraph_Edge
+	// neighbors are the names of the nodes linked to a node by an edge in either direction.
+	neighbors map[string][]string
+}
+
+// newMemoGraph builds the graph of the memos, keeping the relations between two of the memos only.
+func newMemoGraph(memos []*store.Memo, relations []*store.MemoRelation) (*memoGraph, error) {
+	graph := &memoGraph{
+		nodes:     map[string]*v1pb.MemoGraph_Node{},
+		neighbors: map[string][]string{},
+	}
+	for _, memo := range memos {
+		snippet, err := getMemoContentSnippet(memo.Content)
+		if err != nil {
+			return nil, err
+		}
+		graph.addNode(fmt.Sprintf("%s%d", MemoNamePrefix, memo.ID), v1pb.MemoGraph_Node_MEMO, snippet)
+	}
+	for _, memo := range memos {
+		name := fmt.Sprintf("%s%d", MemoNamePrefix, memo.ID)
+		for _, tag := range memo.Payload.GetTags() {
+			tagName := TagNamePrefix + tag
+			graph.addNode(tagName, v1pb.MemoGraph_Node_TAG, tag)
+			graph.addEdge(name, tagName, v1pb.MemoGraph_Edge_TAG)
+		}
+	}
+	for _, relation := range relations {
+		source := fmt.Sprintf("%s%d", MemoNamePrefix, relation.MemoID)
+		target := fmt.Sprintf("%s%d", MemoNamePrefix, relation.RelatedMemoID)
+		if graph.nodes[source] == nil || graph.nodes[target] == nil || source == target {
+			continue
+		}
+		edgeType := v1pb.MemoGraph_Edge_REFERENCE
+		if relation.Type == store.MemoRelationComment {
+			edgeType = v1pb.MemoGraph_Edge_COMMENT
+		}
+		graph.addEdge(source, target, edgeType)
+	}
+	return graph, nil
+}
+
+func (g *memoGraph) addNode(name string, nodeType v1pb.MemoGraph_Node_Type, label string) {
+	if _, ok := g.nodes[name]; ok {
+		return
+	}
+	g.nodeNames = append(g.nodeNames, name)
+	g.nodes[name] = &v1pb.MemoGraph_Node{
+		Name:  name,
+		Type:  nodeType,
+		Label: label,
+	}
+}
+
+func (g *memoGraph) addEdge(source, target string, edgeType v1pb.MemoGraph_Edge_Type) {
+	g.edges = append(g.edges, &v1pb.MemoGraph_Edge{
+		Source: source,
+		Target: target,
+		Type:   edgeType,
+	})
+	g.nodes[source].Degree++
+	g.nodes[target].Degree++
+	g.neighbors[source] = append(g.neighbors[source], target)
+	g.neighbors[target] = append(g.neighbors[target], source)
+}
+
+// convert returns the subgraph of the included nodes, or the whole graph if included is nil.
+func (g *memoGraph) convert(included map[string]bool) *v1pb.MemoGraph {
+	graph := &v1pb.MemoGraph{
+		Nodes: []*v1pb.MemoGraph_Node{},
+		Edges: []*v1pb.MemoGraph_Edge{},
+	}
+	for _, name := range g.nodeNames {
+		if included == nil || included[name] {
+			graph.Nodes = append(graph.Nodes, g.nodes[name])
+		}
+	}
+	for _, edge := range g.edges {
+		if included == nil || (included[edge.Source] && included[edge.Target]) {
+			graph.Edges = append(graph.Edges, edge)
+		}
+	}
+	return graph
+}
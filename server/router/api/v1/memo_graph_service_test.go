@@ -0,0 +1,87 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoGraph(t *testing.T) {
+	memos := []*store.Memo{
+		{ID: 1, Content: "#work one", Payload: &storepb.MemoPayload{Tags: []string{"work"}}},
+		{ID: 2, Content: "two", Payload: &storepb.MemoPayload{}},
+		{ID: 3, Content: "#work three", Payload: &storepb.MemoPayload{Tags: []string{"work"}}},
+		{ID: 4, Content: "four", Payload: &storepb.MemoPayload{}},
+	}
+	relations := []*store.MemoRelation{
+		{MemoID: 2, RelatedMemoID: 1, Type: store.MemoRelationReference},
+		{MemoID: 4, RelatedMemoID: 2, Type: store.MemoRelationComment},
+		// Relations to the memos outside of the graph are left out.
+		{MemoID: 1, RelatedMemoID: 99, Type: store.MemoRelationReference},
+	}
+	graph, err := newMemoGraph(memos, relations)
+	require.NoError(t, err)
+
+	whole := graph.convert(nil)
+	require.Len(t, whole.Nodes, 5)
+	require.Len(t, whole.Edges, 4)
+	require.Equal(t, &v1pb.MemoGraph_Node{Name: "tags/work", Type: v1pb.MemoGraph_Node_TAG, Label: "work", Degree: 2}, whole.Nodes[4])
+
+	getNames := func(graph *v1pb.MemoGraph) []string {
+		names := []string{}
+		for _, node := range graph.Nodes {
+			names = append(names, node.Name)
+		}
+		return names
+	}
+	// The rooted graph is expanded from the root with the same memos and relations in the store.
+	ctx := context.Background()
+	service := newTestingService(ctx, t)
+	user, userCtx := createTestingUser(ctx, t, service, "test")
+	other, _ := createTestingUser(ctx, t, service, "other")
+	for _, memo := range memos {
+		_, err := service.Store.CreateMemo(ctx, &store.Memo{
+			UID:        fmt.Sprintf("memo-%d", memo.ID),
+			CreatorID:  user.ID,
+			Content:    memo.Content,
+			Visibility: store.Private,
+			Payload:    memo.Payload,
+		})
+		require.NoError(t, err)
+	}
+	otherMemo, err := service.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "memo-other",
+		CreatorID:  other.ID,
+		Content:    "#work other",
+		Visibility: store.Private,
+		Payload:    &storepb.MemoPayload{Tags: []string{"work"}},
+	})
+	require.NoError(t, err)
+	for _, relation := range append(relations[:2], &store.MemoRelation{MemoID: otherMemo.ID, RelatedMemoID: 1, Type: store.MemoRelationReference}) {
+		_, err := service.Store.UpsertMemoRelation(ctx, relation)
+		require.NoError(t, err)
+	}
+
+	subgraph, err := service.GetMemoGraph(userCtx, &v1pb.GetMemoGraphRequest{Root: "memos/1", Depth: 1})
+	require.NoError(t, err)
+	require.Equal(t, []string{"memos/1", "memos/2", "tags/work"}, getNames(subgraph))
+	require.Len(t, subgraph.Edges, 2)
+	// The degree counts the edges outside of the subgraph, but not the ones to the memos of other users.
+	require.Equal(t, int32(2), subgraph.Nodes[0].Degree)
+	require.Equal(t, int32(2), subgraph.Nodes[1].Degree)
+	require.Equal(t, int32(2), subgraph.Nodes[2].Degree)
+
+	subgraph, err = service.GetMemoGraph(userCtx, &v1pb.GetMemoGraphRequest{Root: "memos/1", Depth: 2})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"memos/1", "memos/2", "memos/3", "memos/4", "tags/work"}, getNames(subgraph))
+	require.Len(t, subgraph.Edges, 4)
+
+	_, err = service.GetMemoGraph(userCtx, &v1pb.GetMemoGraphRequest{Root: fmt.Sprintf("memos/%d", otherMemo.ID)})
+	require.Error(t, err)
+}
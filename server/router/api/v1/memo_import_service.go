@@ -0,0 +1,352 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/lithammer/shortuuid/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/util"
+	"github.com/usememos/memos/plugin/importer"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ImportMemos(ctx context.Context, request *v1pb.ImportMemosRequest) (*v1pb.ImportMemosResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if request.Source == v1pb.ImportMemosRequest_SOURCE_UNSPECIFIED {
+		return nil, status.Errorf(codes.InvalidArgument, "source is required")
+	}
+
+	notes, err := importer.Parse(importer.Source(request.Source.String()), request.Content)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse the imported file: %v", err)
+	}
+	results, err := ImportNotes(ctx, s.Store, user.ID, notes, request.DryRun)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to import memos: %v", err)
+	}
+	return &v1pb.ImportMemosResponse{Results: results}, nil
+}
+
+// importedNote is a note being imported and the memo created from it.
+type importedNote struct {
+	note   *importer.Note
+	result *v1pb.ImportMemosResult
+	// memo is nil if the note was not imported.
+	memo *store.Memo
+}
+
+// ImportNotes creates the notes as memos of the creator with their original timestamps,
+// uploads their attachments and turns their links into relations between the memos.
+// If dryRun is true, nothing is created and the results only report what would be imported.
+func ImportNotes(ctx context.Context, stores *store.Store, creatorID int32, notes []*importer.Note, dryRun bool) ([]*v1pb.ImportMemosResult, error) {
+	workspaceMemoRelatedSetting, err := stores.GetWorkspaceMemoRelatedSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace memo related setting")
+	}
+	workspaceStorageSetting, err := stores.GetWorkspaceStorageSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace storage setting")
+	}
+	uploadSizeLimit := int(workspaceStorageSetting.UploadSizeLimitMb) * MebiByte
+	if uploadSizeLimit == 0 {
+		uploadSizeLimit = MaxUploadBufferSizeBytes
+	}
+
+	importedNotes := []*importedNote{}
+	for _, note := range notes {
+		imported := &importedNote{
+			note: note,
+			result: &v1pb.ImportMemosResult{
+				Source:   note.Source,
+				Warnings: slices.Clone(note.Warnings),
+			},
+		}
+		importedNotes = append(importedNotes, imported)
+
+		create, err := buildImportedMemo(ctx, stores, creatorID, imported, workspaceMemoRelatedSetting)
+		if err != nil {
+			return nil, err
+		}
+		if create == nil {
+			continue
+		}
+		resources := []*store.Resource{}
+		for _, attachment := range note.Attachments {
+			resource := &store.Resource{
+				UID:       shortuuid.New(),
+				CreatorID: creatorID,
+				Filename:  attachment.Filename,
+				Type:      attachment.Type,
+				Size:      int64(len(attachment.Blob)),
+				Blob:      attachment.Blob,
+			}
+			if attachment.Link != "" {
+				resource.StorageType = storepb.ResourceStorageType_EXTERNAL
+				resource.Reference = attachment.Link
+			} else if len(attachment.Blob) > uploadSizeLimit {
+				imported.result.Warnings = append(imported.result.Warnings, fmt.Sprintf("attachment %s exceeds the size limit", attachment.Filename))
+				continue
+			}
+			resources = append(resources, resource)
+		}
+		imported.result.ResourceCount = int32(len(resources))
+		if create.CreatedTs != 0 {
+			imported.result.CreateTime = timestamppb.New(time.Unix(create.CreatedTs, 0))
+		}
+		if dryRun {
+			imported.memo = create
+			continue
+		}
+
+		if imported.memo, err = createImportedMemo(ctx, stores, create, note, resources); err != nil {
+			return nil, errors.Wrapf(err, "failed to import %s", note.Source)
+		}
+		imported.result.Memo = fmt.Sprintf("%s%d", MemoNamePrefix, imported.memo.ID)
+	}
+
+	if err := importNoteRelations(ctx, stores, creatorID, importedNotes, dryRun); err != nil {
+		return nil, err
+	}
+	results := []*v1pb.ImportMemosResult{}
+	for _, imported := range importedNotes {
+		results = append(results, imported.result)
+	}
+	return results, nil
+}
+
+// buildImportedMemo returns the memo to create from the note,
+// or nil with the error of the result set if the note cannot be imported.
+func buildImportedMemo(ctx context.Context, stores *store.Store, creatorID int32, imported *importedNote, workspaceMemoRelatedSetting *storepb.WorkspaceMemoRelatedSetting) (*store.Memo, error) {
+	note, result := imported.note, imported.result
+	content := appendMissingTags(note.Content, note.Tags)
+	if strings.TrimSpace(content) == "" && len(note.Attachments) == 0 {
+		result.Error = "the note is empty"
+		return nil, nil
+	}
+	if contentLengthLimit := int(workspaceMemoRelatedSetting.ContentLengthLimit); len(content) > contentLengthLimit {
+		result.Error = fmt.Sprintf("content too long (max %d characters)", contentLengthLimit)
+		return nil, nil
+	}
+
+	visibility := store.Private
+	switch v := store.Visibility(note.Visibility); v {
+	case "":
+	case store.Public, store.Protected, store.Private:
+		visibility = v
+	default:
+		result.Warnings = append(result.Warnings, fmt.Sprintf("unknown visibility %q, imported as private", note.Visibility))
+	}
+	if visibility == store.Public && workspaceMemoRelatedSetting.DisallowPublicVisibility {
+		result.Warnings = append(result.Warnings, "public memos are disallowed, imported as private")
+		visibility = store.Private
+	}
+
+	uid := shortuuid.New()
+	if note.UID != "" {
+		memo, err := memopayload.GetMemoByReference(ctx, stores, note.UID)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get memo")
+		}
+		if memo == nil && util.UIDMatcher.MatchString(note.UID) {
+			uid = note.UID
+		} else {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("uid %s is taken, imported with a new uid", note.UID))
+		}
+	}
+
+	create := &store.Memo{
+		UID:        uid,
+		CreatorID:  creatorID,
+		Content:    content,
+		Visibility: visibility,
+	}
+	now := time.Now()
+	if !note.CreateTime.IsZero() && note.CreateTime.Before(now) {
+		create.CreatedTs = note.CreateTime.Unix()
+		create.UpdatedTs = create.CreatedTs
+		if note.UpdateTime.After(note.CreateTime) && note.UpdateTime.Before(now) {
+			create.UpdatedTs = note.UpdateTime.Unix()
+		}
+	} else if !note.CreateTime.IsZero() {
+		result.Warnings = append(result.Warnings, "create time is in the future, imported with the current time")
+	}
+	if err := memopayload.RebuildMemoPayload(create); err != nil {
+		return nil, errors.Wrap(err, "failed to rebuild memo payload")
+	}
+	if location := note.Location; location != nil {
+		create.Payload.Location = &storepb.MemoPayload_Location{
+			Placeholder: location.Placeholder,
+			Latitude:    location.Latitude,
+			Longitude:   location.Longitude,
+		}
+	}
+	return create, nil
+}
+
+// createImportedMemo creates the memo with its resources, and pins or archives it like the note.
+func createImportedMemo(ctx context.Context, stores *store.Store, create *store.Memo, note *importer.Note, resources []*store.Resource) (*store.Memo, error) {
+	memo, err := stores.CreateMemo(ctx, create)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create memo")
+	}
+	for _, resource := range resources {
+		resource.MemoID = &memo.ID
+		if resource.StorageType != storepb.ResourceStorageType_EXTERNAL {
+			if err := SaveResourceBlob(ctx, stores, resource); err != nil {
+				return nil, errors.Wrap(err, "failed to save resource blob")
+			}
+		}
+		if _, err := stores.CreateResource(ctx, resource); err != nil {
+			return nil, errors.Wrap(err, "failed to create resource")
+		}
+	}
+	if note.Pinned {
+		if _, err := stores.UpsertMemoOrganizer(ctx, &store.MemoOrganizer{
+			MemoID: memo.ID,
+			UserID: memo.CreatorID,
+			Pinned: true,
+		}); err != nil {
+			return nil, errors.Wrap(err, "failed to pin memo")
+		}
+	}
+	if note.Archived {
+		// Keep the original update time, which archiving would otherwise overwrite.
+		rowStatus := store.Archived
+		if err := stores.UpdateMemo(ctx, &store.UpdateMemo{
+			ID:        memo.ID,
+			RowStatus: &rowStatus,
+			UpdatedTs: &memo.UpdatedTs,
+		}); err != nil {
+			return nil, errors.Wrap(err, "failed to archive memo")
+		}
+		memo.RowStatus = rowStatus
+	}
+	return memo, nil
+}
+
+// importNoteRelations turns the wiki-links and the relations of the notes into relations between the memos.
+// The relations of exported memos are resolved by uid among the imported notes first,
+// then among the existing memos the creator can see.
+func importNoteRelations(ctx context.Context, stores *store.Store, creatorID int32, importedNotes []*importedNote, dryRun bool) error {
+	notesByName, notesByUID := map[string]*importedNote{}, map[string]*importedNote{}
+	for _, imported := range importedNotes {
+		for _, name := range imported.note.Names {
+			if _, ok := notesByName[name]; !ok {
+				notesByName[name] = imported
+			}
+		}
+		if imported.note.UID != "" {
+			notesByUID[imported.note.UID] = imported
+		}
+	}
+
+	for _, imported := range importedNotes {
+		if imported.memo == nil {
+			continue
+		}
+		type target struct {
+			relationType store.MemoRelationType
+			memo         *store.Memo
+		}
+		targets := []target{}
+		addTarget := func(relationType store.MemoRelationType, memo *store.Memo) {
+			for _, t := range targets {
+				if t.relationType == relationType && t.memo.UID == memo.UID {
+					return
+				}
+			}
+			if memo.UID != imported.memo.UID {
+				targets = append(targets, target{relationType: relationType, memo: memo})
+			}
+		}
+
+		for _, link := range imported.note.Links {
+			linked, ok := notesByName[link]
+			if !ok || linked.memo == nil {
+				imported.result.Warnings = append(imported.result.Warnings, fmt.Sprintf("unresolved link %q", link))
+				continue
+			}
+			addTarget(store.MemoRelationReference, linked.memo)
+		}
+		for _, relation := range imported.note.Relations {
+			relationType := store.MemoRelationType(relation.Type)
+			if relationType != store.MemoRelationReference && relationType != store.MemoRelationComment {
+				imported.result.Warnings = append(imported.result.Warnings, fmt.Sprintf("unknown relation type %q", relation.Type))
+				continue
+			}
+			if related, ok := notesByUID[relation.UID]; ok {
+				if related.memo != nil {
+					addTarget(relationType, related.memo)
+					continue
+				}
+			} else {
+				memo, err := stores.GetMemo(ctx, &store.FindMemo{UID: &relation.UID, ExcludeContent: true})
+				if err != nil {
+					return errors.Wrap(err, "failed to get related memo")
+				}
+				// A relation to a memo the creator cannot see must not expose it, so it is left unresolved.
+				visible := false
+				if memo != nil {
+					if visible, err = memopayload.IsMemoVisibleToCreator(ctx, stores, memo, creatorID); err != nil {
+						return errors.Wrap(err, "failed to check related memo visibility")
+					}
+				}
+				if visible {
+					addTarget(relationType, memo)
+					continue
+				}
+			}
+			imported.result.Warnings = append(imported.result.Warnings, fmt.Sprintf("unresolved relation to memo %s", relation.UID))
+		}
+
+		imported.result.RelationCount = int32(len(targets))
+		if dryRun {
+			continue
+		}
+		for _, t := range targets {
+			if _, err := stores.UpsertMemoRelation(ctx, &store.MemoRelation{
+				MemoID:        imported.memo.ID,
+				RelatedMemoID: t.memo.ID,
+				Type:          t.relationType,
+			}); err != nil {
+				return errors.Wrap(err, "failed to create memo relation")
+			}
+		}
+	}
+	return nil
+}
+
+// appendMissingTags appends the tags that are not in the content yet as a line of hashtags.
+func appendMissingTags(content string, tags []string) string {
+	missingTags := []string{}
+	for _, tag := range tags {
+		hashtag := "#" + tag
+		if !slices.Contains(strings.Fields(content), hashtag) && !slices.Contains(missingTags, hashtag) {
+			missingTags = append(missingTags, hashtag)
+		}
+	}
+	if len(missingTags) == 0 {
+		return content
+	}
+	if content == "" {
+		return strings.Join(missingTags, " ")
+	}
+	return content + "\n\n" + strings.Join(missingTags, " ")
+}
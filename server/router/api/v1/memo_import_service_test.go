@@ -0,0 +1,109 @@
+package v1
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func newTestingZip(t *testing.T, files map[string]string) []byte {
+	var buffer bytes.Buffer
+	writer := zip.NewWriter(&buffer)
+	for name, content := range files {
+		file, err := writer.Create(name)
+		require.NoError(t, err)
+		_, err = file.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+	return buffer.Bytes()
+}
+
+func TestImportMemos(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user, userCtx := createTestingUser(ctx, t, s, "test")
+	other, _ := createTestingUser(ctx, t, s, "other")
+	_, err := s.Store.CreateMemo(ctx, &store.Memo{UID: "public", CreatorID: other.ID, Content: "public", Visibility: store.Public})
+	require.NoError(t, err)
+	_, err = s.Store.CreateMemo(ctx, &store.Memo{UID: "private", CreatorID: other.ID, Content: "private", Visibility: store.Private})
+	require.NoError(t, err)
+	content := newTestingZip(t, map[string]string{
+		"memos/first.md": `---
+uid: first
+create_time: 2023-11-14T22:13:20Z
+update_time: 2023-11-15T22:13:20Z
+relations:
+  - type: REFERENCE
+    memo: second
+  - type: REFERENCE
+    memo: public
+  - type: REFERENCE
+    memo: private
+---
+first`,
+		"memos/second.md": "---\nuid: second\n---\nsecond",
+	})
+
+	// A dry run reports the memos without creating them.
+	response, err := s.ImportMemos(userCtx, &v1pb.ImportMemosRequest{
+		Source:  v1pb.ImportMemosRequest_MARKDOWN,
+		Content: content,
+		DryRun:  true,
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Results, 2)
+	require.Empty(t, response.Results[0].Memo)
+	require.Equal(t, int32(2), response.Results[0].RelationCount)
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Empty(t, memos)
+
+	response, err = s.ImportMemos(userCtx, &v1pb.ImportMemosRequest{
+		Source:  v1pb.ImportMemosRequest_MARKDOWN,
+		Content: content,
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Results, 2)
+	first := response.Results[0]
+	require.NotEmpty(t, first.Memo)
+	require.Equal(t, int32(1700000000), int32(first.CreateTime.GetSeconds()))
+
+	// The memo keeps its original timestamps.
+	uid := "first"
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &uid})
+	require.NoError(t, err)
+	require.Equal(t, user.ID, memo.CreatorID)
+	require.Equal(t, int64(1700000000), memo.CreatedTs)
+	require.Equal(t, int64(1700086400), memo.UpdatedTs)
+
+	// The relations resolve to the imported memo and to the memo of the other user the importer can see,
+	// while the private memo of the other user is left unresolved.
+	relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Len(t, relations, 2)
+	require.Equal(t, int32(2), first.RelationCount)
+	require.Contains(t, first.Warnings, "unresolved relation to memo private")
+
+	// An archive that cannot be read is rejected.
+	_, err = s.ImportMemos(userCtx, &v1pb.ImportMemosRequest{
+		Source:  v1pb.ImportMemosRequest_MARKDOWN,
+		Content: []byte("not a zip"),
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestAppendMissingTags(t *testing.T) {
+	require.Equal(t, "#work hello", appendMissingTags("#work hello", []string{"work"}))
+	require.Equal(t, "hello\n\n#work #home", appendMissingTags("hello", []string{"work", "home", "work"}))
+	require.Equal(t, "#work", appendMissingTags("", []string{"work"}))
+	require.Equal(t, "hello", appendMissingTags("hello", nil))
+}
@@ -0,0 +1,95 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// MaxMemoLocationZoom is the highest zoom level of the maps the memo locations are clustered for.
+const MaxMemoLocationZoom = 22
+
+func (s *APIV1Service) ListMemoLocations(ctx context.Context, request *v1pb.ListMemoLocationsRequest) (*v1pb.ListMemoLocationsResponse, error) {
+	if request.Zoom < 0 || request.Zoom > MaxMemoLocationZoom {
+		return nil, status.Errorf(codes.InvalidArgument, "zoom must be between 0 and %d", MaxMemoLocationZoom)
+	}
+	memoFind := &store.FindMemo{
+		ExcludeComments: true,
+		ExcludeContent:  true,
+	}
+	filter := request.Filter
+	if request.Shortcut != "" {
+		shortcutFilter, err := s.getShortcutFilter(ctx, request.Shortcut)
+		if err != nil {
+			return nil, err
+		}
+		filter = joinMemoFilters(shortcutFilter, request.Filter)
+	}
+	if err := s.buildMemoFindWithFilter(ctx, memoFind, filter); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to build find memos with filter: %v", err)
+	}
+	// Only the memos with a location are listed, which are the ones within the whole world.
+	withLocation := store.NewMemoFilterCondition(store.MemoFilterFieldLocation, store.MemoFilterOperatorWithinBox, -90.0, -180.0, 90.0, 180.0)
+	if memoFind.Filter != nil {
+		memoFind.Filter = store.NewMemoFilterAnd(memoFind.Filter, withLocation)
+	} else {
+		memoFind.Filter = withLocation
+	}
+	memos, err := s.Store.ListMemos(ctx, memoFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+
+	return &v1pb.ListMemoLocationsResponse{
+		Clusters: clusterMemoLocations(memos, request.Zoom),
+	}, nil
+}
+
+// clusterMemoLocations groups the locations of the memos by the cells of the grid at the zoom level.
+// The memos are expected to be ordered from the latest, which is the one a cluster points to.
+func clusterMemoLocations(memos []*store.Memo, zoom int32) []*v1pb.MemoLocationCluster {
+	// The cells are a quarter of a map tile wide, and the antimeridian is on their borders.
+	cellDegrees := 90 / math.Exp2(float64(zoom))
+	type cell struct {
+		row, column int64
+	}
+	clusters := []*v1pb.MemoLocationCluster{}
+	clusterByCell := map[cell]*v1pb.MemoLocationCluster{}
+	for _, memo := range memos {
+		if memo.Payload == nil || memo.Payload.Location == nil {
+			continue
+		}
+		latitude, longitude := memo.Payload.Location.Latitude, memo.Payload.Location.Longitude
+		key := cell{
+			row:    int64(math.Floor(latitude / cellDegrees)),
+			column: int64(math.Floor(longitude / cellDegrees)),
+		}
+		cluster, ok := clusterByCell[key]
+		if !ok {
+			cluster = &v1pb.MemoLocationCluster{
+				Memo: fmt.Sprintf("%s%d", MemoNamePrefix, memo.ID),
+			}
+			clusterByCell[key] = cluster
+			clusters = append(clusters, cluster)
+		}
+		// The sums of the coordinates are kept until all the memos are counted.
+		cluster.Latitude += latitude
+		cluster.Longitude += longitude
+		cluster.Count++
+	}
+	for _, cluster := range clusters {
+		cluster.Latitude /= float64(cluster.Count)
+		cluster.Longitude /= float64(cluster.Count)
+	}
+	sort.SliceStable(clusters, func(i, j int) bool {
+		return clusters[i].Count > clusters[j].Count
+	})
+	return clusters
+}
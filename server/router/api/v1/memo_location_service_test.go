@@ -0,0 +1,35 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestClusterMemoLocations(t *testing.T) {
+	newMemo := func(id int32, latitude, longitude float64) *store.Memo {
+		return &store.Memo{
+			ID:      id,
+			Payload: &storepb.MemoPayload{Location: &storepb.MemoPayload_Location{Latitude: latitude, Longitude: longitude}},
+		}
+	}
+	memos := []*store.Memo{
+		newMemo(4, 48.8, 2.4),
+		newMemo(3, -17.7, 178.0),
+		newMemo(2, 48.9, 2.2),
+		{ID: 1, Payload: &storepb.MemoPayload{}},
+	}
+
+	// At zoom 0, the cells are 90 degrees wide.
+	require.Equal(t, []*v1pb.MemoLocationCluster{
+		{Latitude: 48.849999999999994, Longitude: 2.3, Count: 2, Memo: "memos/4"},
+		{Latitude: -17.7, Longitude: 178.0, Count: 1, Memo: "memos/3"},
+	}, clusterMemoLocations(memos, 0))
+	// At zoom 10, the cells are less than 0.1 degree wide.
+	require.Len(t, clusterMemoLocations(memos, 10), 3)
+	require.Empty(t, clusterMemoLocations(nil, 0))
+}
@@ -0,0 +1,68 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/scheduler"
+	"github.com/usememos/memos/store"
+)
+
+// DefaultMemoPublishSchedule checks for scheduled memos due to be published every minute.
+const DefaultMemoPublishSchedule = "@every 1m"
+
+// PublishScheduledMemos publishes the scheduled memos that are due and dispatches their memo created webhooks,
+// along with the webhooks of the memo rules they met while they were scheduled.
+// It returns scheduler.ErrNothingToDo if no memo is due, so that the frequent empty runs are not kept in the job history.
+func (s *APIV1Service) PublishScheduledMemos(ctx context.Context) error {
+	publishTsBefore := time.Now().Unix() + 1
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		PublishTsBefore: &publishTsBefore,
+		ExcludeContent:  true,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list scheduled memos")
+	}
+	if len(memos) == 0 {
+		return scheduler.ErrNothingToDo
+	}
+
+	for _, memo := range memos {
+		// The memo is considered created at the time it is published.
+		unscheduled := int64(0)
+		update := &store.UpdateMemo{
+			ID:         memo.ID,
+			CreatedTs:  memo.PublishTs,
+			UpdatedTs:  memo.PublishTs,
+			Visibility: &memo.PublishVisibility,
+			PublishTs:  &unscheduled,
+		}
+		webhookIDs := memo.Payload.GetPublishWebhookIds()
+		if len(webhookIDs) > 0 {
+			payload := memo.Payload
+			payload.PublishWebhookIds = nil
+			update.Payload = payload
+		}
+		if err := s.Store.UpdateMemo(ctx, update); err != nil {
+			return errors.Wrapf(err, "failed to publish memo %d", memo.ID)
+		}
+
+		published, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+		if err != nil {
+			return errors.Wrap(err, "failed to get memo")
+		}
+		memoMessage, err := s.convertMemoFromStore(ctx, published, v1pb.MemoView_MEMO_VIEW_FULL)
+		if err != nil {
+			return errors.Wrap(err, "failed to convert memo")
+		}
+		if err := s.DispatchMemoCreatedWebhook(ctx, memoMessage); err != nil {
+			slog.Warn("Failed to dispatch memo created webhook", slog.Any("err", err))
+		}
+		s.dispatchMemoRuleWebhooks(ctx, memoMessage, webhookIDs)
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// DefaultRelatedMemoPageSize is the number of related memos listed by default.
+	DefaultRelatedMemoPageSize = 5
+	// MaxRelatedMemoPageSize is the maximum number of related memos listed at once.
+	MaxRelatedMemoPageSize = 20
+
+	// bm25K1 and bm25B are the usual BM25 parameters, for the saturation of the term frequencies
+	// and for the normalization by the lengths of the memos.
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+func (s *APIV1Service) ListRelatedMemos(ctx context.Context, request *v1pb.ListRelatedMemosRequest) (*v1pb.ListRelatedMemosResponse, error) {
+	id, err := ExtractMemoIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &id, ExcludeContent: true})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo")
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	visible, err := s.isMemoVisibleToUser(ctx, memo, user)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo collaborator: %v", err)
+	}
+	if !visible {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	pageSize := int(request.PageSize)
+	if pageSize <= 0 {
+		pageSize = DefaultRelatedMemoPageSize
+	}
+	pageSize = min(pageSize, MaxRelatedMemoPageSize)
+
+	response := &v1pb.ListRelatedMemosResponse{
+		RelatedMemos: []*v1pb.RelatedMemo{},
+	}
+	memoTerms, err := s.Store.ListMemoTerms(ctx, &store.FindMemoTerm{MemoIDList: []int32{memo.ID}})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo terms: %v", err)
+	}
+	if len(memoTerms) == 0 {
+		return response, nil
+	}
+	termList := []string{}
+	for _, memoTerm := range memoTerms {
+		termList = append(termList, memoTerm.Term)
+	}
+	// The memos the user cannot see are left out of the postings, so that they do not weigh the terms either.
+	visibilityList, userID := []store.Visibility{store.Public}, (*int32)(nil)
+	if user != nil {
+		visibilityList, userID = []store.Visibility{store.Public, store.Protected}, &user.ID
+	}
+	postings, err := s.Store.ListMemoTerms(ctx, &store.FindMemoTerm{
+		TermList:       termList,
+		VisibilityList: visibilityList,
+		UserID:         userID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo terms: %v", err)
+	}
+	// The postings are ordered by memo, so each candidate is appended once.
+	candidateIDs := []int32{}
+	for _, posting := range postings {
+		if posting.MemoID != memo.ID && (len(candidateIDs) == 0 || candidateIDs[len(candidateIDs)-1] != posting.MemoID) {
+			candidateIDs = append(candidateIDs, posting.MemoID)
+		}
+	}
+	if len(candidateIDs) == 0 {
+		return response, nil
+	}
+	stats, err := s.Store.GetMemoTermStats(ctx, &store.FindMemoTermStats{
+		MemoIDList:     candidateIDs,
+		VisibilityList: visibilityList,
+		UserID:         userID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo term stats: %v", err)
+	}
+
+	// The ranked memos are loaded a page at a time, as some of them may be left out.
+	scores := rankRelatedMemos(memo.ID, postings, stats)
+	normalStatus := store.Normal
+	for start := 0; start < len(scores) && len(response.RelatedMemos) < pageSize; start += pageSize {
+		page := scores[start:min(len(scores), start+pageSize)]
+		pageIDs := []int32{}
+		for _, score := range page {
+			pageIDs = append(pageIDs, score.memoID)
+		}
+		// Comments, archived memos and memos in the trash are left out.
+		memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+			IDList:              pageIDs,
+			RowStatus:           &normalStatus,
+			ExcludeComments:     true,
+			ContentPrefixLength: memoSnippetContentLength,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+		}
+		memoByID := map[int32]*store.Memo{}
+		for _, relatedMemo := range memos {
+			memoByID[relatedMemo.ID] = relatedMemo
+		}
+		for _, score := range page {
+			relatedMemo := memoByID[score.memoID]
+			if relatedMemo == nil || len(response.RelatedMemos) == pageSize {
+				continue
+			}
+			snippet, err := getMemoContentSnippet(relatedMemo.Content)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get memo content snippet")
+			}
+			response.RelatedMemos = append(response.RelatedMemos, &v1pb.RelatedMemo{
+				Memo: &v1pb.MemoRelation_Memo{
+					Name:    fmt.Sprintf("%s%d", MemoNamePrefix, relatedMemo.ID),
+					Uid:     relatedMemo.UID,
+					Snippet: snippet,
+				},
+				Score: score.score,
+			})
+		}
+	}
+	return response, nil
+}
+
+type relatedMemoScore struct {
+	memoID int32
+	score  float64
+}
+
+// rankRelatedMemos scores the memos of the postings, the terms of the index that the memo has,
+// with BM25 and the distinct terms of the memo as the query, from the highest score.
+func rankRelatedMemos(memoID int32, postings []*store.MemoTerm, stats *store.MemoTermStats) []*relatedMemoScore {
+	if stats.MemoCount == 0 || stats.TermCount == 0 {
+		return []*relatedMemoScore{}
+	}
+	memoCount := float64(stats.MemoCount)
+	averageTermCount := float64(stats.TermCount) / memoCount
+	// The postings of a term are all the memos with the term, so they count the memos it occurs in.
+	documentFrequencies := map[string]int{}
+	for _, posting := range postings {
+		documentFrequencies[posting.Term]++
+	}
+
+	scores := []*relatedMemoScore{}
+	scoreByMemoID := map[int32]*relatedMemoScore{}
+	for _, posting := range postings {
+		if posting.MemoID == memoID {
+			continue
+		}
+		documentFrequency := float64(documentFrequencies[posting.Term])
+		idf := math.Log(1 + (memoCount-documentFrequency+0.5)/(documentFrequency+0.5))
+		frequency := float64(posting.Frequency)
+		lengthRatio := float64(stats.MemoTermCounts[posting.MemoID]) / averageTermCount
+		score, ok := scoreByMemoID[posting.MemoID]
+		if !ok {
+			score = &relatedMemoScore{memoID: posting.MemoID}
+			scoreByMemoID[posting.MemoID] = score
+			scores = append(scores, score)
+		}
+		score.score += idf * frequency * (bm25K1 + 1) / (frequency + bm25K1*(1-bm25B+bm25B*lengthRatio))
+	}
+	// Ties go to the latest memos.
+	sort.SliceStable(scores, func(i, j int) bool {
+		if scores[i].score != scores[j].score {
+			return scores[i].score > scores[j].score
+		}
+		return scores[i].memoID > scores[j].memoID
+	})
+	return scores
+}
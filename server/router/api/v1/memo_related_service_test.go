@@ -0,0 +1,80 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestRankRelatedMemos(t *testing.T) {
+	// Memo 1 has the terms "golang" and "channels", which memos 2 and 3 share.
+	postings := []*store.MemoTerm{
+		{MemoID: 1, Term: "channels", Frequency: 1},
+		{MemoID: 1, Term: "golang", Frequency: 1},
+		{MemoID: 2, Term: "channels", Frequency: 2},
+		{MemoID: 2, Term: "golang", Frequency: 1},
+		{MemoID: 3, Term: "golang", Frequency: 1},
+		{MemoID: 4, Term: "golang", Frequency: 1},
+	}
+	stats := &store.MemoTermStats{
+		MemoCount:      5,
+		TermCount:      20,
+		MemoTermCounts: map[int32]int64{2: 4, 3: 4, 4: 8},
+	}
+
+	scores := rankRelatedMemos(1, postings, stats)
+	memoIDs := []int32{}
+	for _, score := range scores {
+		memoIDs = append(memoIDs, score.memoID)
+	}
+	// The rarer term weighs more, and longer memos weigh less.
+	require.Equal(t, []int32{2, 3, 4}, memoIDs)
+	require.Greater(t, scores[1].score, scores[2].score)
+
+	require.Empty(t, rankRelatedMemos(1, nil, &store.MemoTermStats{}))
+}
+
+func TestListRelatedMemos(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user, userCtx := createTestingUser(ctx, t, s, "test")
+	other, _ := createTestingUser(ctx, t, s, "other")
+	memoIDs := map[string]int32{}
+	for _, create := range []*store.Memo{
+		{UID: "memo", CreatorID: user.ID, Content: "golang channels", Visibility: store.Private},
+		{UID: "own", CreatorID: user.ID, Content: "golang channels and goroutines", Visibility: store.Private},
+		{UID: "public", CreatorID: other.ID, Content: "golang generics", Visibility: store.Public},
+		{UID: "private", CreatorID: other.ID, Content: "golang channels", Visibility: store.Private},
+		{UID: "unrelated", CreatorID: user.ID, Content: "gardening notes", Visibility: store.Private},
+	} {
+		memo, err := s.Store.CreateMemo(ctx, create)
+		require.NoError(t, err)
+		memoIDs[memo.UID] = memo.ID
+	}
+
+	getUIDs := func() []string {
+		response, err := s.ListRelatedMemos(userCtx, &v1pb.ListRelatedMemosRequest{Name: fmt.Sprintf("%s%d", MemoNamePrefix, memoIDs["memo"])})
+		require.NoError(t, err)
+		uids := []string{}
+		for _, relatedMemo := range response.RelatedMemos {
+			uids = append(uids, relatedMemo.Memo.Uid)
+		}
+		return uids
+	}
+	// The private memos of other users are left out.
+	require.Equal(t, []string{"own", "public"}, getUIDs())
+
+	// Unless the user is a collaborator of them.
+	_, err := s.Store.UpsertMemoCollaborator(ctx, &store.MemoCollaborator{
+		MemoID: memoIDs["private"],
+		UserID: user.ID,
+		Role:   store.MemoCollaboratorViewer,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"private", "own", "public"}, getUIDs())
+}
@@ -3,6 +3,9 @@ package v1
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
@@ -10,6 +13,7 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/memopayload"
 	"github.com/usememos/memos/store"
 )
 
@@ -18,6 +22,9 @@ func (s *APIV1Service) SetMemoRelations(ctx context.Context, request *v1pb.SetMe
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
 	}
+	if err := s.checkMemoEtagByID(ctx, id, request.Etag); err != nil {
+		return nil, err
+	}
 	referenceType := store.MemoRelationReference
 	// Delete all reference relations first.
 	if err := s.Store.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{
@@ -49,6 +56,16 @@ func (s *APIV1Service) SetMemoRelations(ctx context.Context, request *v1pb.SetMe
 			return nil, status.Errorf(codes.Internal, "failed to upsert memo relation")
 		}
 	}
+	// The references in the content are kept whatever relations are set.
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &id, ExcludeContent: true})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo")
+	}
+	if memo != nil {
+		if err := memopayload.SyncMemoReferences(ctx, s.Store, memo, nil); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to sync memo references: %v", err)
+		}
+	}
 
 	return &emptypb.Empty{}, nil
 }
@@ -70,6 +87,9 @@ func (s *APIV1Service) ListMemoRelations(ctx context.Context, request *v1pb.List
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to convert memo relation")
 		}
+		if relation == nil {
+			continue
+		}
 		relationList = append(relationList, relation)
 	}
 	tempList, err = s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
@@ -83,6 +103,9 @@ func (s *APIV1Service) ListMemoRelations(ctx context.Context, request *v1pb.List
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to convert memo relation")
 		}
+		if relation == nil {
+			continue
+		}
 		relationList = append(relationList, relation)
 	}
 
@@ -92,11 +115,130 @@ func (s *APIV1Service) ListMemoRelations(ctx context.Context, request *v1pb.List
 	return response, nil
 }
 
+func (s *APIV1Service) ListMemoBacklinks(ctx context.Context, request *v1pb.ListMemoBacklinksRequest) (*v1pb.ListMemoBacklinksResponse, error) {
+	id, err := ExtractMemoIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &id, ExcludeContent: true})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo")
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	visible, err := s.isMemoVisibleToUser(ctx, memo, user)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo collaborator: %v", err)
+	}
+	if !visible {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	referenceType := store.MemoRelationReference
+	relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
+		RelatedMemoID: &id,
+		Type:          &referenceType,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo relations")
+	}
+	referenceRegexp := getMemoReferenceRegexp(memo)
+	backlinks := []*v1pb.MemoBacklink{}
+	for _, relation := range relations {
+		referencingMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &relation.MemoID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo")
+		}
+		// Memos in the trash and memos the user cannot see are left out.
+		if referencingMemo == nil {
+			continue
+		}
+		visible, err := s.isMemoVisibleToUser(ctx, referencingMemo, user)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo collaborator: %v", err)
+		}
+		if !visible {
+			continue
+		}
+		snippet, err := getMemoContentSnippet(referencingMemo.Content)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo content snippet")
+		}
+		backlinks = append(backlinks, &v1pb.MemoBacklink{
+			Memo: &v1pb.MemoRelation_Memo{
+				Name:    fmt.Sprintf("%s%d", MemoNamePrefix, referencingMemo.ID),
+				Uid:     referencingMemo.UID,
+				Snippet: snippet,
+			},
+			Sentence: getReferenceSentence(referencingMemo.Content, referenceRegexp),
+		})
+	}
+	return &v1pb.ListMemoBacklinksResponse{Backlinks: backlinks}, nil
+}
+
+// getMemoReferenceRegexp returns the regexp matching the references to the memo
+// parsed by memopayload: [[uid]] and ![[memos/{id}]] references, /m/{uid} links and memos/{id} names.
+func getMemoReferenceRegexp(memo *store.Memo) *regexp.Regexp {
+	uid := regexp.QuoteMeta(memo.UID)
+	return regexp.MustCompile(fmt.Sprintf(`\[\[(?:memos/)?(?:%s|%d)(?:\?[^\]]*)?\]\]|/m/%s\b|\bmemos/%d\b`, uid, memo.ID, uid, memo.ID))
+}
+
+// maxReferenceSentenceLength is the maximum number of characters of the sentence around a reference.
+const maxReferenceSentenceLength = 200
+
+// getReferenceSentence returns the sentence around the first reference in the content,
+// or an empty string if the content has no reference.
+func getReferenceSentence(content string, referenceRegexp *regexp.Regexp) string {
+	loc := referenceRegexp.FindStringIndex(content)
+	if loc == nil {
+		return ""
+	}
+	// A sentence ends at a line break, or at a punctuation followed by a space,
+	// which keeps the dots of the links in the sentence.
+	isSentenceEnd := func(i int) bool {
+		if content[i] == '\n' {
+			return true
+		}
+		return strings.ContainsRune(".!?", rune(content[i])) && (i+1 == len(content) || content[i+1] == ' ' || content[i+1] == '\n')
+	}
+	start := 0
+	for i := loc[0] - 1; i >= 0; i-- {
+		if isSentenceEnd(i) {
+			start = i + 1
+			break
+		}
+	}
+	end := len(content)
+	for i := loc[1]; i < len(content); i++ {
+		if isSentenceEnd(i) {
+			end = i
+			if content[i] != '\n' {
+				end++
+			}
+			break
+		}
+	}
+	sentence := strings.TrimSpace(content[start:end])
+	if utf8.RuneCountInString(sentence) > maxReferenceSentenceLength {
+		return substring(sentence, maxReferenceSentenceLength) + "..."
+	}
+	return sentence
+}
+
+// convertMemoRelationFromStore returns nil if either side of the relation is in the trash.
 func (s *APIV1Service) convertMemoRelationFromStore(ctx context.Context, memoRelation *store.MemoRelation) (*v1pb.MemoRelation, error) {
 	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoRelation.MemoID})
 	if err != nil {
 		return nil, err
 	}
+	if memo == nil {
+		return nil, nil
+	}
 	memoSnippet, err := getMemoContentSnippet(memo.Content)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get memo content snippet")
@@ -105,6 +247,9 @@ func (s *APIV1Service) convertMemoRelationFromStore(ctx context.Context, memoRel
 	if err != nil {
 		return nil, err
 	}
+	if relatedMemo == nil {
+		return nil, nil
+	}
 	relatedMemoSnippet, err := getMemoContentSnippet(relatedMemo.Content)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get related memo content snippet")
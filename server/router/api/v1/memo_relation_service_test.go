@@ -0,0 +1,77 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestGetReferenceSentence(t *testing.T) {
+	referenceRegexp := getMemoReferenceRegexp(&store.Memo{ID: 1, UID: "abc"})
+	tests := []struct {
+		content  string
+		sentence string
+	}{
+		{content: "First line.\nSee [[abc]] for details. Next sentence.", sentence: "See [[abc]] for details."},
+		{content: "Read https://demo.usememos.com/m/abc today! Later.", sentence: "Read https://demo.usememos.com/m/abc today!"},
+		{content: "Related to memos/1", sentence: "Related to memos/1"},
+		{content: "Related to memos/12 and [[abcd]]", sentence: ""},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.sentence, getReferenceSentence(test.content, referenceRegexp), test.content)
+	}
+}
+
+func TestListMemoBacklinksOfCollaborator(t *testing.T) {
+	ctx := context.Background()
+	service := newTestingService(ctx, t)
+	user, userCtx := createTestingUser(ctx, t, service, "test")
+	collaborator, collaboratorCtx := createTestingUser(ctx, t, service, "collaborator")
+	memo, err := service.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "memo",
+		CreatorID:  user.ID,
+		Content:    "memo",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	referencingMemo, err := service.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "referencing",
+		CreatorID:  collaborator.ID,
+		Content:    "See [[memo]]",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	_, err = service.Store.UpsertMemoRelation(ctx, &store.MemoRelation{
+		MemoID:        referencingMemo.ID,
+		RelatedMemoID: memo.ID,
+		Type:          store.MemoRelationReference,
+	})
+	require.NoError(t, err)
+	request := &v1pb.ListMemoBacklinksRequest{Name: fmt.Sprintf("%s%d", MemoNamePrefix, memo.ID)}
+
+	_, err = service.ListMemoBacklinks(collaboratorCtx, request)
+	require.Error(t, err)
+	response, err := service.ListMemoBacklinks(userCtx, request)
+	require.NoError(t, err)
+	require.Empty(t, response.Backlinks)
+
+	// The private memos are visible to their collaborators.
+	for _, upsert := range []*store.MemoCollaborator{
+		{MemoID: memo.ID, UserID: collaborator.ID, Role: store.MemoCollaboratorViewer},
+		{MemoID: referencingMemo.ID, UserID: user.ID, Role: store.MemoCollaboratorViewer},
+	} {
+		_, err := service.Store.UpsertMemoCollaborator(ctx, upsert)
+		require.NoError(t, err)
+	}
+	response, err = service.ListMemoBacklinks(collaboratorCtx, request)
+	require.NoError(t, err)
+	require.Len(t, response.Backlinks, 1)
+	response, err = service.ListMemoBacklinks(userCtx, request)
+	require.NoError(t, err)
+	require.Len(t, response.Backlinks, 1)
+}
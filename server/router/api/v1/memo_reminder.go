@@ -0,0 +1,90 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/scheduler"
+	"github.com/usememos/memos/store"
+)
+
+// DefaultMemoReminderSchedule checks for memo reminders that are due every minute.
+const DefaultMemoReminderSchedule = "@every 1m"
+
+// SendMemoReminders reminds the creators of the memos whose reminders are due with an inbox message
+// and the memo reminder webhook, then schedules the next reminders of the memos.
+// It returns scheduler.ErrNothingToDo if no reminder is due, so that the frequent empty runs are not kept in the job history.
+func (s *APIV1Service) SendMemoReminders(ctx context.Context) error {
+	now := time.Now().Unix()
+	remindTsBefore := now + 1
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		RemindTsBefore: &remindTsBefore,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memos with due reminders")
+	}
+	if len(memos) == 0 {
+		return scheduler.ErrNothingToDo
+	}
+
+	for _, memo := range memos {
+		// The reminders that are due are sent at once, so the next one is after now.
+		remindTs := store.GetNextMemoRemindTs(memo.Payload, now)
+		if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{
+			ID:       memo.ID,
+			RemindTs: &remindTs,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to schedule the next reminder of memo %d", memo.ID)
+		}
+		// Archived memos are not reminded of.
+		if memo.RowStatus != store.Normal {
+			continue
+		}
+
+		if err := s.createMemoReminderInbox(ctx, memo); err != nil {
+			slog.Error("failed to create memo reminder inbox", "memo_id", memo.ID, "error", err)
+		}
+		memoMessage, err := s.convertMemoFromStore(ctx, memo, v1pb.MemoView_MEMO_VIEW_FULL)
+		if err != nil {
+			return errors.Wrap(err, "failed to convert memo")
+		}
+		if err := s.DispatchMemoReminderWebhook(ctx, memoMessage); err != nil {
+			slog.Warn("Failed to dispatch memo reminder webhook", slog.Any("err", err))
+		}
+	}
+	return nil
+}
+
+// createMemoReminderInbox sends an inbox message reminding the creator of the memo.
+func (s *APIV1Service) createMemoReminderInbox(ctx context.Context, memo *store.Memo) error {
+	activity, err := s.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: memo.CreatorID,
+		Type:      store.ActivityTypeMemoReminder,
+		Level:     store.ActivityLevelInfo,
+		Payload: &storepb.ActivityPayload{
+			MemoReminder: &storepb.ActivityMemoReminderPayload{
+				MemoId: memo.ID,
+			},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create activity")
+	}
+	if _, err := s.Store.CreateInbox(ctx, &store.Inbox{
+		SenderID:   memo.CreatorID,
+		ReceiverID: memo.CreatorID,
+		Status:     store.UNREAD,
+		Message: &storepb.InboxMessage{
+			Type:       storepb.InboxMessage_REMINDER,
+			ActivityId: &activity.ID,
+		},
+	}); err != nil {
+		return errors.Wrap(err, "failed to create inbox")
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/scheduler"
+	"github.com/usememos/memos/store"
+)
+
+func TestSendMemoReminders(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user, _ := createTestingUser(ctx, t, s, "test")
+
+	// The runs without a due reminder are not kept.
+	require.ErrorIs(t, s.SendMemoReminders(ctx), scheduler.ErrNothingToDo)
+
+	memo, err := s.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "reminder",
+		CreatorID:  user.ID,
+		Content:    "content",
+		Visibility: store.Private,
+		Payload:    &storepb.MemoPayload{RemindTs: time.Now().Unix() + 60},
+	})
+	require.NoError(t, err)
+	remindTs := time.Now().Unix() - 1
+	require.NoError(t, s.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, RemindTs: &remindTs}))
+	require.NoError(t, s.SendMemoReminders(ctx))
+	require.ErrorIs(t, s.SendMemoReminders(ctx), scheduler.ErrNothingToDo)
+}
@@ -20,6 +20,9 @@ func (s *APIV1Service) SetMemoResources(ctx context.Context, request *v1pb.SetMe
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
 	}
+	if err := s.checkMemoEtagByID(ctx, memoID, request.Etag); err != nil {
+		return nil, err
+	}
 	resources, err := s.Store.ListResources(ctx, &store.FindResource{
 		MemoID: &memoID,
 	})
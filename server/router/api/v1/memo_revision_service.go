@@ -0,0 +1,169 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ListMemoRevisions(ctx context.Context, request *v1pb.ListMemoRevisionsRequest) (*v1pb.ListMemoRevisionsResponse, error) {
+	id, err := ExtractMemoIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	if _, err := s.getMemoForRevisionAccess(ctx, id); err != nil {
+		return nil, err
+	}
+
+	revisions, err := s.Store.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &id})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo revisions: %v", err)
+	}
+	response := &v1pb.ListMemoRevisionsResponse{}
+	for _, revision := range revisions {
+		response.Revisions = append(response.Revisions, convertMemoRevisionFromStore(revision))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) GetMemoRevisionDiff(ctx context.Context, request *v1pb.GetMemoRevisionDiffRequest) (*v1pb.GetMemoRevisionDiffResponse, error) {
+	memoID, revisionID, err := ExtractMemoRevisionIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo revision name: %v", err)
+	}
+	memo, err := s.getMemoForRevisionAccess(ctx, memoID)
+	if err != nil {
+		return nil, err
+	}
+	revision, err := s.getMemoRevision(ctx, memoID, revisionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Compare with the current memo unless another revision is specified.
+	newName, newContent, newVisibility := fmt.Sprintf("%s%d", MemoNamePrefix, memo.ID), memo.Content, memo.Visibility
+	if request.CompareTo != "" {
+		compareMemoID, compareRevisionID, err := ExtractMemoRevisionIDFromName(request.CompareTo)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid compare to revision name: %v", err)
+		}
+		if compareMemoID != memoID {
+			return nil, status.Errorf(codes.InvalidArgument, "revisions must belong to the same memo")
+		}
+		compareRevision, err := s.getMemoRevision(ctx, memoID, compareRevisionID)
+		if err != nil {
+			return nil, err
+		}
+		newName, newContent, newVisibility = request.CompareTo, compareRevision.Content, compareRevision.Visibility
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(revision.Content),
+		B:        difflib.SplitLines(newContent),
+		FromFile: request.Name,
+		ToFile:   newName,
+		Context:  3,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to compute diff: %v", err)
+	}
+	return &v1pb.GetMemoRevisionDiffResponse{
+		Diff:          diff,
+		OldVisibility: convertVisibilityFromStore(revision.Visibility),
+		NewVisibility: convertVisibilityFromStore(newVisibility),
+	}, nil
+}
+
+func (s *APIV1Service) RestoreMemoRevision(ctx context.Context, request *v1pb.RestoreMemoRevisionRequest) (*v1pb.Memo, error) {
+	memoID, revisionID, err := ExtractMemoRevisionIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo revision name: %v", err)
+	}
+	if _, err := s.getMemoForRevisionAccess(ctx, memoID); err != nil {
+		return nil, err
+	}
+	revision, err := s.getMemoRevision(ctx, memoID, revisionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Restoring goes through UpdateMemo, so the current state is kept as a new revision.
+	return s.UpdateMemo(ctx, &v1pb.UpdateMemoRequest{
+		Memo: &v1pb.Memo{
+			Name:       fmt.Sprintf("%s%d", MemoNamePrefix, memoID),
+			Content:    revision.Content,
+			Visibility: convertVisibilityFromStore(revision.Visibility),
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content", "visibility"}},
+		Etag:       request.Etag,
+	})
+}
+
+// createMemoRevision records a revision and prunes the oldest ones beyond the workspace limit.
+func (s *APIV1Service) createMemoRevision(ctx context.Context, create *store.MemoRevision) error {
+	if _, err := s.Store.CreateMemoRevision(ctx, create); err != nil {
+		return err
+	}
+	workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get workspace memo related setting")
+	}
+	return s.Store.PruneMemoRevisions(ctx, create.MemoID, int(workspaceMemoRelatedSetting.MemoRevisionLimit))
+}
+
+// getMemoForRevisionAccess returns the memo if the current user is allowed to access its revisions.
+func (s *APIV1Service) getMemoForRevisionAccess(ctx context.Context, memoID int32) (*store.Memo, error) {
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	// Only the creator or admin can access the revisions of the memo.
+	if memo.CreatorID != user.ID && !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return memo, nil
+}
+
+func (s *APIV1Service) getMemoRevision(ctx context.Context, memoID, revisionID int32) (*store.MemoRevision, error) {
+	revision, err := s.Store.GetMemoRevision(ctx, &store.FindMemoRevision{
+		ID:     &revisionID,
+		MemoID: &memoID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo revision: %v", err)
+	}
+	if revision == nil {
+		return nil, status.Errorf(codes.NotFound, "memo revision not found")
+	}
+	return revision, nil
+}
+
+func convertMemoRevisionFromStore(revision *store.MemoRevision) *v1pb.MemoRevision {
+	return &v1pb.MemoRevision{
+		Name:       fmt.Sprintf("%s%d/%s%d", MemoNamePrefix, revision.MemoID, MemoRevisionNamePrefix, revision.ID),
+		Creator:    fmt.Sprintf("%s%d", UserNamePrefix, revision.CreatorID),
+		CreateTime: timestamppb.New(time.Unix(revision.CreatedTs, 0)),
+		Content:    revision.Content,
+		Visibility: convertVisibilityFromStore(revision.Visibility),
+	}
+}
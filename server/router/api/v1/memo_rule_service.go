@@ -0,0 +1,530 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/lithammer/shortuuid/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/usememos/memos/plugin/webhook"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ListMemoRules(ctx context.Context, request *v1pb.ListMemoRulesRequest) (*v1pb.ListMemoRulesResponse, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	rules, err := s.getUserMemoRules(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	response := &v1pb.ListMemoRulesResponse{
+		Rules: []*v1pb.MemoRule{},
+	}
+	for _, rule := range rules {
+		response.Rules = append(response.Rules, convertMemoRuleFromStore(userID, rule))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) CreateMemoRule(ctx context.Context, request *v1pb.CreateMemoRuleRequest) (*v1pb.MemoRule, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	if request.Rule == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "rule is required")
+	}
+	rules, err := s.getUserMemoRules(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := convertMemoRuleToStore(request.Rule)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid rule: %v", err)
+	}
+	rule.Id = shortuuid.New()
+	if err := s.upsertUserMemoRules(ctx, userID, append(slices.Clone(rules), rule)); err != nil {
+		return nil, err
+	}
+	return convertMemoRuleFromStore(userID, rule), nil
+}
+
+func (s *APIV1Service) UpdateMemoRule(ctx context.Context, request *v1pb.UpdateMemoRuleRequest) (*v1pb.MemoRule, error) {
+	if request.Rule == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "rule is required")
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+	userID, ruleID, err := ExtractMemoRuleIDFromName(request.Rule.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo rule name: %v", err)
+	}
+	rules, err := s.getUserMemoRules(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	index := slices.IndexFunc(rules, func(rule *storepb.MemoRulesUserSetting_Rule) bool {
+		return rule.Id == ruleID
+	})
+	if index < 0 {
+		return nil, status.Errorf(codes.NotFound, "memo rule not found")
+	}
+
+	update := convertMemoRuleFromStore(userID, rules[index])
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "title":
+			update.Title = request.Rule.Title
+		case "condition":
+			update.Condition = request.Rule.Condition
+		case "actions":
+			update.Actions = request.Rule.Actions
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid update path: %s", path)
+		}
+	}
+	rule, err := convertMemoRuleToStore(update)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid rule: %v", err)
+	}
+	rule.Id = ruleID
+	rules = slices.Clone(rules)
+	rules[index] = rule
+	if err := s.upsertUserMemoRules(ctx, userID, rules); err != nil {
+		return nil, err
+	}
+	return convertMemoRuleFromStore(userID, rule), nil
+}
+
+func (s *APIV1Service) DeleteMemoRule(ctx context.Context, request *v1pb.DeleteMemoRuleRequest) (*emptypb.Empty, error) {
+	userID, ruleID, err := ExtractMemoRuleIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo rule name: %v", err)
+	}
+	rules, err := s.getUserMemoRules(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	index := slices.IndexFunc(rules, func(rule *storepb.MemoRulesUserSetting_Rule) bool {
+		return rule.Id == ruleID
+	})
+	if index < 0 {
+		return nil, status.Errorf(codes.NotFound, "memo rule not found")
+	}
+
+	if err := s.upsertUserMemoRules(ctx, userID, slices.Delete(slices.Clone(rules), index, index+1)); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) TestMemoRules(ctx context.Context, request *v1pb.TestMemoRulesRequest) (*v1pb.TestMemoRulesResponse, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	rules, err := s.getUserMemoRules(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(request.Rules) > 0 {
+		rules = []*storepb.MemoRulesUserSetting_Rule{}
+		for _, rule := range request.Rules {
+			storeRule, err := convertMemoRuleToStore(rule)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid rule: %v", err)
+			}
+			// The rules keep their names, so that the matched rules can be told apart.
+			if rule.Name != "" {
+				if _, ruleID, err := ExtractMemoRuleIDFromName(rule.Name); err == nil {
+					storeRule.Id = ruleID
+				}
+			}
+			rules = append(rules, storeRule)
+		}
+	}
+
+	memo := &store.Memo{
+		CreatorID: userID,
+		Content:   request.Content,
+	}
+	if err := memopayload.RebuildMemoPayload(memo); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to rebuild memo payload: %v", err)
+	}
+	effects := matchMemoRules(rules, memo.Content, memo.Payload.GetProperty(), request.Source, nil)
+	if err := applyMemoRuleTags(memo, effects); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to apply memo rule tags: %v", err)
+	}
+
+	response := &v1pb.TestMemoRulesResponse{
+		MatchedRules: []*v1pb.MemoRule{},
+		Content:      memo.Content,
+		Pinned:       effects.pinned,
+		Relations:    []string{},
+		Webhooks:     effects.webhookIDs,
+	}
+	for _, rule := range effects.rules {
+		response.MatchedRules = append(response.MatchedRules, convertMemoRuleFromStore(userID, rule))
+	}
+	if effects.visibility != nil {
+		response.Visibility = convertVisibilityFromStore(*effects.visibility)
+	}
+	for _, memoID := range effects.relatedMemoIDs {
+		response.Relations = append(response.Relations, fmt.Sprintf("%s%d", MemoNamePrefix, memoID))
+	}
+	return response, nil
+}
+
+// getUserMemoRules returns the memo rules of the user if they are the current user.
+// The returned slice is shared with the store cache and must not be modified.
+func (s *APIV1Service) getUserMemoRules(ctx context.Context, userID int32) ([]*storepb.MemoRulesUserSetting_Rule, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	// Memo rules are private to the user.
+	if user.ID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	rules, err := s.Store.GetUserMemoRules(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user memo rules: %v", err)
+	}
+	return rules, nil
+}
+
+func (s *APIV1Service) upsertUserMemoRules(ctx context.Context, userID int32, rules []*storepb.MemoRulesUserSetting_Rule) error {
+	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSettingKey_MEMO_RULES,
+		Value: &storepb.UserSetting_MemoRules{
+			MemoRules: &storepb.MemoRulesUserSetting{
+				Rules: rules,
+			},
+		},
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+	}
+	return nil
+}
+
+// memoRuleEffects are the actions of the memo rules a memo meets, in the order of the rules.
+type memoRuleEffects struct {
+	rules []*storepb.MemoRulesUserSetting_Rule
+	tags  []string
+	// visibility is the visibility set by the last rule with a visibility action, if any.
+	visibility     *store.Visibility
+	pinned         bool
+	relatedMemoIDs []int32
+	webhookIDs     []int32
+}
+
+// matchMemoRules returns the effects of the rules the memo meets. The conditions are matched
+// against the memo as it is sent, before any tag is added by the rules.
+// The rules the previous memo already met, if any, only add their tags, so that their other
+// actions are not applied again on every update of the content.
+func matchMemoRules(rules []*storepb.MemoRulesUserSetting_Rule, content string, property *storepb.MemoPayload_Property, source string, previous *store.Memo) *memoRuleEffects {
+	effects := &memoRuleEffects{
+		rules:          []*storepb.MemoRulesUserSetting_Rule{},
+		tags:           []string{},
+		relatedMemoIDs: []int32{},
+		webhookIDs:     []int32{},
+	}
+	for _, rule := range rules {
+		if !matchMemoRuleCondition(rule.Condition, content, property, source) {
+			continue
+		}
+		effects.rules = append(effects.rules, rule)
+		matchedBefore := previous != nil && matchMemoRuleCondition(rule.Condition, previous.Content, previous.Payload.GetProperty(), source)
+		for _, action := range rule.Actions {
+			if _, ok := action.Action.(*storepb.MemoRulesUserSetting_Action_AddTag); !ok && matchedBefore {
+				continue
+			}
+			switch action := action.Action.(type) {
+			case *storepb.MemoRulesUserSetting_Action_AddTag:
+				if !slices.Contains(effects.tags, action.AddTag) {
+					effects.tags = append(effects.tags, action.AddTag)
+				}
+			case *storepb.MemoRulesUserSetting_Action_SetVisibility:
+				visibility := store.Visibility(action.SetVisibility)
+				effects.visibility = &visibility
+			case *storepb.MemoRulesUserSetting_Action_Pin:
+				effects.pinned = effects.pinned || action.Pin
+			case *storepb.MemoRulesUserSetting_Action_AddRelation:
+				if !slices.Contains(effects.relatedMemoIDs, action.AddRelation) {
+					effects.relatedMemoIDs = append(effects.relatedMemoIDs, action.AddRelation)
+				}
+			case *storepb.MemoRulesUserSetting_Action_DispatchWebhook:
+				if !slices.Contains(effects.webhookIDs, action.DispatchWebhook) {
+					effects.webhookIDs = append(effects.webhookIDs, action.DispatchWebhook)
+				}
+			}
+		}
+	}
+	return effects
+}
+
+func matchMemoRuleCondition(condition *storepb.MemoRulesUserSetting_Condition, content string, property *storepb.MemoPayload_Property, source string) bool {
+	if condition == nil {
+		return true
+	}
+	if condition.ContentPattern != "" {
+		// The pattern is validated when the rule is saved.
+		pattern, err := regexp.Compile(condition.ContentPattern)
+		if err != nil || !pattern.MatchString(content) {
+			return false
+		}
+	}
+	if condition.HasLink && !property.GetHasLink() {
+		return false
+	}
+	if condition.HasCode && !property.GetHasCode() {
+		return false
+	}
+	if condition.Source != "" && !strings.EqualFold(condition.Source, source) {
+		return false
+	}
+	return true
+}
+
+// applyMemoRuleTags adds the tags of the effects to the content of the memo, and rebuilds its payload.
+func applyMemoRuleTags(memo *store.Memo, effects *memoRuleEffects) error {
+	if len(effects.tags) == 0 {
+		return nil
+	}
+	content, err := updateMemoContentTags(memo.Content, effects.tags, nil)
+	if err != nil {
+		return err
+	}
+	if content == memo.Content {
+		return nil
+	}
+	memo.Content = content
+	return memopayload.RebuildMemoPayload(memo)
+}
+
+// applyMemoRules matches the memo against the memo rules of its creator, adds the tags of the
+// matched rules to the memo, and returns the effects of the rules for the caller to apply.
+// The payload of the memo must be built from its content. The previous memo is the memo before
+// the update of its content, with its content and property, or nil for a new memo.
+func (s *APIV1Service) applyMemoRules(ctx context.Context, memo *store.Memo, source string, previous *store.Memo) (*memoRuleEffects, error) {
+	rules, err := s.Store.GetUserMemoRules(ctx, memo.CreatorID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user memo rules: %v", err)
+	}
+	effects := matchMemoRules(rules, memo.Content, memo.Payload.GetProperty(), source, previous)
+	if err := applyMemoRuleTags(memo, effects); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to apply memo rule tags: %v", err)
+	}
+	if effects.visibility != nil && *effects.visibility == store.Public {
+		workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get workspace memo related setting")
+		}
+		if workspaceMemoRelatedSetting.DisallowPublicVisibility {
+			effects.visibility = nil
+		}
+	}
+	return effects, nil
+}
+
+// applyMemoRuleActions pins the saved memo and adds its relations as the effects require.
+// The related memos that are gone or that the creator cannot see are skipped.
+func (s *APIV1Service) applyMemoRuleActions(ctx context.Context, memo *store.Memo, effects *memoRuleEffects) error {
+	if effects.pinned {
+		if _, err := s.Store.UpsertMemoOrganizer(ctx, &store.MemoOrganizer{
+			MemoID: memo.ID,
+			UserID: memo.CreatorID,
+			Pinned: true,
+		}); err != nil {
+			return status.Errorf(codes.Internal, "failed to upsert memo organizer")
+		}
+	}
+	if len(effects.relatedMemoIDs) == 0 {
+		return nil
+	}
+	creator, err := s.Store.GetUser(ctx, &store.FindUser{ID: &memo.CreatorID})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get user")
+	}
+	for _, relatedMemoID := range effects.relatedMemoIDs {
+		if relatedMemoID == memo.ID {
+			continue
+		}
+		relatedMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &relatedMemoID, ExcludeContent: true})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get memo")
+		}
+		if relatedMemo == nil {
+			continue
+		}
+		visible, err := s.isMemoVisibleToUser(ctx, relatedMemo, creator)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get memo collaborator: %v", err)
+		}
+		if !visible {
+			continue
+		}
+		if _, err := s.Store.UpsertMemoRelation(ctx, &store.MemoRelation{
+			MemoID:        memo.ID,
+			RelatedMemoID: relatedMemoID,
+			Type:          store.MemoRelationReference,
+		}); err != nil {
+			return status.Errorf(codes.Internal, "failed to upsert memo relation")
+		}
+	}
+	return nil
+}
+
+// deferMemoRuleWebhooks keeps the webhooks of the effects in the payload of a scheduled memo,
+// which is sent to them once it is published.
+func deferMemoRuleWebhooks(memo *store.Memo, effects *memoRuleEffects) {
+	for _, webhookID := range effects.webhookIDs {
+		if !slices.Contains(memo.Payload.PublishWebhookIds, webhookID) {
+			memo.Payload.PublishWebhookIds = append(memo.Payload.PublishWebhookIds, webhookID)
+		}
+	}
+}
+
+// dispatchMemoRuleWebhooks sends the memo to the webhooks of the memo rules that belong to its creator.
+func (s *APIV1Service) dispatchMemoRuleWebhooks(ctx context.Context, memo *v1pb.Memo, webhookIDs []int32) {
+	creatorID, err := ExtractUserIDFromName(memo.Creator)
+	if err != nil {
+		return
+	}
+	for _, webhookID := range webhookIDs {
+		hook, err := s.Store.GetWebhook(ctx, &store.FindWebhook{
+			ID:        &webhookID,
+			CreatorID: &creatorID,
+		})
+		if err != nil || hook == nil {
+			slog.Warn("Failed to get memo rule webhook", slog.Int("webhook", int(webhookID)), slog.Any("err", err))
+			continue
+		}
+		payload, err := convertMemoToWebhookPayload(memo)
+		if err != nil {
+			slog.Warn("Failed to convert memo to webhook payload", slog.Any("err", err))
+			return
+		}
+		payload.ActivityType = "memos.memo.matched"
+		payload.Url = hook.URL
+		if err := webhook.Post(payload); err != nil {
+			slog.Warn("Failed to dispatch memo rule webhook", slog.Any("err", err))
+		}
+	}
+}
+
+// convertMemoRuleToStore returns the memo rule in the store format, without its ID,
+// or an error if the rule is not valid.
+func convertMemoRuleToStore(rule *v1pb.MemoRule) (*storepb.MemoRulesUserSetting_Rule, error) {
+	storeRule := &storepb.MemoRulesUserSetting_Rule{
+		Title:   strings.TrimSpace(rule.Title),
+		Actions: []*storepb.MemoRulesUserSetting_Action{},
+	}
+	if storeRule.Title == "" {
+		return nil, errors.New("title is required")
+	}
+	if condition := rule.Condition; condition != nil {
+		if condition.ContentPattern != "" {
+			if _, err := regexp.Compile(condition.ContentPattern); err != nil {
+				return nil, errors.Wrap(err, "invalid content pattern")
+			}
+		}
+		storeRule.Condition = &storepb.MemoRulesUserSetting_Condition{
+			ContentPattern: condition.ContentPattern,
+			HasLink:        condition.HasLink,
+			HasCode:        condition.HasCode,
+			Source:         strings.TrimSpace(condition.Source),
+		}
+	}
+	if len(rule.Actions) == 0 {
+		return nil, errors.New("actions are required")
+	}
+	for _, action := range rule.Actions {
+		storeAction := &storepb.MemoRulesUserSetting_Action{}
+		switch action := action.Action.(type) {
+		case *v1pb.MemoRuleAction_AddTag:
+			tag := strings.TrimPrefix(action.AddTag, "#")
+			if tag == "" || strings.ContainsAny(tag, " \t\n") {
+				return nil, errors.Errorf("invalid tag %q", action.AddTag)
+			}
+			storeAction.Action = &storepb.MemoRulesUserSetting_Action_AddTag{AddTag: tag}
+		case *v1pb.MemoRuleAction_SetVisibility:
+			if action.SetVisibility == v1pb.Visibility_VISIBILITY_UNSPECIFIED {
+				return nil, errors.New("visibility is required")
+			}
+			storeAction.Action = &storepb.MemoRulesUserSetting_Action_SetVisibility{SetVisibility: convertVisibilityToStore(action.SetVisibility).String()}
+		case *v1pb.MemoRuleAction_Pin:
+			storeAction.Action = &storepb.MemoRulesUserSetting_Action_Pin{Pin: action.Pin}
+		case *v1pb.MemoRuleAction_AddRelation:
+			memoID, err := ExtractMemoIDFromName(action.AddRelation)
+			if err != nil {
+				return nil, errors.Wrap(err, "invalid relation")
+			}
+			storeAction.Action = &storepb.MemoRulesUserSetting_Action_AddRelation{AddRelation: memoID}
+		case *v1pb.MemoRuleAction_DispatchWebhook:
+			if action.DispatchWebhook <= 0 {
+				return nil, errors.Errorf("invalid webhook %d", action.DispatchWebhook)
+			}
+			storeAction.Action = &storepb.MemoRulesUserSetting_Action_DispatchWebhook{DispatchWebhook: action.DispatchWebhook}
+		default:
+			return nil, errors.New("action is required")
+		}
+		storeRule.Actions = append(storeRule.Actions, storeAction)
+	}
+	return storeRule, nil
+}
+
+func convertMemoRuleFromStore(userID int32, rule *storepb.MemoRulesUserSetting_Rule) *v1pb.MemoRule {
+	memoRule := &v1pb.MemoRule{
+		Title:   rule.Title,
+		Actions: []*v1pb.MemoRuleAction{},
+	}
+	// The rules tested without being saved have no ID.
+	if rule.Id != "" {
+		memoRule.Name = fmt.Sprintf("%s%d/%s%s", UserNamePrefix, userID, MemoRuleNamePrefix, rule.Id)
+	}
+	if condition := rule.Condition; condition != nil {
+		memoRule.Condition = &v1pb.MemoRuleCondition{
+			ContentPattern: condition.ContentPattern,
+			HasLink:        condition.HasLink,
+			HasCode:        condition.HasCode,
+			Source:         condition.Source,
+		}
+	}
+	for _, action := range rule.Actions {
+		memoRuleAction := &v1pb.MemoRuleAction{}
+		switch action := action.Action.(type) {
+		case *storepb.MemoRulesUserSetting_Action_AddTag:
+			memoRuleAction.Action = &v1pb.MemoRuleAction_AddTag{AddTag: action.AddTag}
+		case *storepb.MemoRulesUserSetting_Action_SetVisibility:
+			memoRuleAction.Action = &v1pb.MemoRuleAction_SetVisibility{SetVisibility: convertVisibilityFromStore(store.Visibility(action.SetVisibility))}
+		case *storepb.MemoRulesUserSetting_Action_Pin:
+			memoRuleAction.Action = &v1pb.MemoRuleAction_Pin{Pin: action.Pin}
+		case *storepb.MemoRulesUserSetting_Action_AddRelation:
+			memoRuleAction.Action = &v1pb.MemoRuleAction_AddRelation{AddRelation: fmt.Sprintf("%s%d", MemoNamePrefix, action.AddRelation)}
+		case *storepb.MemoRulesUserSetting_Action_DispatchWebhook:
+			memoRuleAction.Action = &v1pb.MemoRuleAction_DispatchWebhook{DispatchWebhook: action.DispatchWebhook}
+		}
+		memoRule.Actions = append(memoRule.Actions, memoRuleAction)
+	}
+	return memoRule
+}
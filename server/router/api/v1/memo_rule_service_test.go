@@ -0,0 +1,219 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+func TestMatchMemoRules(t *testing.T) {
+	rules := []*storepb.MemoRulesUserSetting_Rule{
+		{
+			Id:        "links",
+			Condition: &storepb.MemoRulesUserSetting_Condition{HasLink: true, Source: "telegram"},
+			Actions: []*storepb.MemoRulesUserSetting_Action{
+				{Action: &storepb.MemoRulesUserSetting_Action_AddTag{AddTag: "reading"}},
+				{Action: &storepb.MemoRulesUserSetting_Action_SetVisibility{SetVisibility: "PROTECTED"}},
+			},
+		},
+		{
+			Id:        "todo",
+			Condition: &storepb.MemoRulesUserSetting_Condition{ContentPattern: "(?i)^todo"},
+			Actions: []*storepb.MemoRulesUserSetting_Action{
+				{Action: &storepb.MemoRulesUserSetting_Action_AddTag{AddTag: "todo"}},
+				{Action: &storepb.MemoRulesUserSetting_Action_Pin{Pin: true}},
+				{Action: &storepb.MemoRulesUserSetting_Action_AddRelation{AddRelation: 3}},
+			},
+		},
+		{
+			Id: "all",
+			Actions: []*storepb.MemoRulesUserSetting_Action{
+				{Action: &storepb.MemoRulesUserSetting_Action_SetVisibility{SetVisibility: "PRIVATE"}},
+				{Action: &storepb.MemoRulesUserSetting_Action_DispatchWebhook{DispatchWebhook: 2}},
+				{Action: &storepb.MemoRulesUserSetting_Action_AddRelation{AddRelation: 3}},
+			},
+		},
+	}
+	newMemo := func(content string) *store.Memo {
+		memo := &store.Memo{Content: content}
+		require.NoError(t, memopayload.RebuildMemoPayload(memo))
+		return memo
+	}
+
+	memo := newMemo("TODO read https://usememos.com #reading")
+	effects := matchMemoRules(rules, memo.Content, memo.Payload.Property, "Telegram", nil)
+	require.Equal(t, 3, len(effects.rules))
+	require.Equal(t, []string{"reading", "todo"}, effects.tags)
+	// The last rule that sets the visibility wins.
+	require.Equal(t, store.Private, *effects.visibility)
+	require.True(t, effects.pinned)
+	require.Equal(t, []int32{3}, effects.relatedMemoIDs)
+	require.Equal(t, []int32{2}, effects.webhookIDs)
+	require.NoError(t, applyMemoRuleTags(memo, effects))
+	require.Equal(t, "TODO read https://usememos.com #reading\n#todo", memo.Content)
+	require.Equal(t, []string{"reading", "todo"}, memo.Payload.Tags)
+
+	memo = newMemo("read https://usememos.com")
+	effects = matchMemoRules(rules, memo.Content, memo.Payload.Property, "web", nil)
+	require.Equal(t, 1, len(effects.rules))
+	require.Equal(t, "all", effects.rules[0].Id)
+	require.Empty(t, effects.tags)
+	require.False(t, effects.pinned)
+
+	// An update only applies the tags of the rules the memo met before.
+	previous := newMemo("todo later")
+	memo = newMemo("todo read https://usememos.com")
+	effects = matchMemoRules(rules, memo.Content, memo.Payload.Property, "telegram", previous)
+	require.Equal(t, 3, len(effects.rules))
+	require.Equal(t, []string{"reading", "todo"}, effects.tags)
+	require.Equal(t, store.Protected, *effects.visibility)
+	require.False(t, effects.pinned)
+	require.Empty(t, effects.relatedMemoIDs)
+	require.Empty(t, effects.webhookIDs)
+
+	effects = matchMemoRules(nil, memo.Content, memo.Payload.Property, "", nil)
+	require.Empty(t, effects.rules)
+	require.Nil(t, effects.visibility)
+}
+
+func TestApplyMemoRules(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user, userCtx := createTestingUser(ctx, t, s, "test")
+	activities := []string{}
+	var mutex sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		payload := &struct {
+			ActivityType string `json:"activityType"`
+		}{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(payload))
+		mutex.Lock()
+		activities = append(activities, payload.ActivityType)
+		mutex.Unlock()
+		_, err := w.Write([]byte(`{"code":0}`))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+	hook, err := s.Store.CreateWebhook(ctx, &store.Webhook{CreatorID: user.ID, Name: "rule", URL: server.URL})
+	require.NoError(t, err)
+	_, err = s.CreateMemoRule(userCtx, &v1pb.CreateMemoRuleRequest{
+		Parent: fmt.Sprintf("%s%d", UserNamePrefix, user.ID),
+		Rule: &v1pb.MemoRule{
+			Title:     "Todo",
+			Condition: &v1pb.MemoRuleCondition{ContentPattern: "(?i)^todo"},
+			Actions: []*v1pb.MemoRuleAction{
+				{Action: &v1pb.MemoRuleAction_SetVisibility{SetVisibility: v1pb.Visibility_PROTECTED}},
+				{Action: &v1pb.MemoRuleAction_Pin{Pin: true}},
+				{Action: &v1pb.MemoRuleAction_DispatchWebhook{DispatchWebhook: hook.ID}},
+			},
+		},
+	})
+	require.NoError(t, err)
+	isPinned := func(name string) bool {
+		memo, err := s.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: name})
+		require.NoError(t, err)
+		return memo.Pinned
+	}
+
+	memo, err := s.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Content: "todo one", Visibility: v1pb.Visibility_PRIVATE})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.Visibility_PROTECTED, memo.Visibility)
+	require.True(t, isPinned(memo.Name))
+	require.Equal(t, []string{"memos.memo.created", "memos.memo.matched"}, activities)
+
+	// Editing a memo that already met the condition does not apply the actions again.
+	memo, err = s.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, Visibility: v1pb.Visibility_PRIVATE},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility", "pinned"}},
+	})
+	require.NoError(t, err)
+	activities = []string{}
+	memo, err = s.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, Content: "todo two"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.Visibility_PRIVATE, memo.Visibility)
+	require.False(t, isPinned(memo.Name))
+	require.Equal(t, []string{"memos.memo.updated"}, activities)
+	// The actions are applied when the memo meets the condition again.
+	activities = []string{}
+	for _, content := range []string{"done", "todo three"} {
+		memo, err = s.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: memo.Name, Content: content},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+		})
+		require.NoError(t, err)
+	}
+	require.Equal(t, v1pb.Visibility_PROTECTED, memo.Visibility)
+	require.True(t, isPinned(memo.Name))
+	require.Equal(t, []string{"memos.memo.updated", "memos.memo.updated", "memos.memo.matched"}, activities)
+
+	// A scheduled memo is sent to the webhooks of the rules once it is published.
+	activities = []string{}
+	memo, err = s.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Content:     "todo scheduled",
+		Visibility:  v1pb.Visibility_PRIVATE,
+		PublishTime: timestamppb.New(time.Now().Add(time.Hour)),
+	})
+	require.NoError(t, err)
+	require.Empty(t, activities)
+	id, err := ExtractMemoIDFromName(memo.Name)
+	require.NoError(t, err)
+	publishTs := time.Now().Unix() - 1
+	require.NoError(t, s.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: id, PublishTs: &publishTs}))
+	require.NoError(t, s.PublishScheduledMemos(ctx))
+	require.Equal(t, []string{"memos.memo.created", "memos.memo.matched"}, activities)
+	published, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &id})
+	require.NoError(t, err)
+	require.Equal(t, store.Protected, published.Visibility)
+	require.Empty(t, published.Payload.PublishWebhookIds)
+}
+
+func TestConvertMemoRuleToStore(t *testing.T) {
+	rule, err := convertMemoRuleToStore(&v1pb.MemoRule{
+		Title:     " Links ",
+		Condition: &v1pb.MemoRuleCondition{HasLink: true},
+		Actions: []*v1pb.MemoRuleAction{
+			{Action: &v1pb.MemoRuleAction_AddTag{AddTag: "#reading"}},
+			{Action: &v1pb.MemoRuleAction_SetVisibility{SetVisibility: v1pb.Visibility_PROTECTED}},
+			{Action: &v1pb.MemoRuleAction_AddRelation{AddRelation: "memos/3"}},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Links", rule.Title)
+	require.Equal(t, "reading", rule.Actions[0].GetAddTag())
+	require.Equal(t, "PROTECTED", rule.Actions[1].GetSetVisibility())
+	require.Equal(t, int32(3), rule.Actions[2].GetAddRelation())
+	rule.Id = "links"
+	require.Equal(t, "users/1/memoRules/links", convertMemoRuleFromStore(1, rule).Name)
+	require.Equal(t, v1pb.Visibility_PROTECTED, convertMemoRuleFromStore(1, rule).Actions[1].GetSetVisibility())
+
+	invalidRules := []*v1pb.MemoRule{
+		{Title: "", Actions: []*v1pb.MemoRuleAction{{Action: &v1pb.MemoRuleAction_Pin{Pin: true}}}},
+		{Title: "No actions"},
+		{Title: "Pattern", Condition: &v1pb.MemoRuleCondition{ContentPattern: "(todo"}, Actions: []*v1pb.MemoRuleAction{{Action: &v1pb.MemoRuleAction_Pin{Pin: true}}}},
+		{Title: "Tag", Actions: []*v1pb.MemoRuleAction{{Action: &v1pb.MemoRuleAction_AddTag{AddTag: "two words"}}}},
+		{Title: "Visibility", Actions: []*v1pb.MemoRuleAction{{Action: &v1pb.MemoRuleAction_SetVisibility{}}}},
+		{Title: "Relation", Actions: []*v1pb.MemoRuleAction{{Action: &v1pb.MemoRuleAction_AddRelation{AddRelation: "users/1"}}}},
+		{Title: "Empty action", Actions: []*v1pb.MemoRuleAction{{}}},
+	}
+	for _, invalidRule := range invalidRules {
+		_, err := convertMemoRuleToStore(invalidRule)
+		require.Error(t, err, invalidRule.Title)
+	}
+}
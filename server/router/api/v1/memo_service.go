@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
 	"time"
 	"unicode/utf8"
@@ -20,6 +21,7 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/usememos/memos/internal/util"
 	"github.com/usememos/memos/plugin/webhook"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	storepb "github.com/usememos/memos/proto/gen/store"
@@ -36,6 +38,12 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 	if request.CreateTime != nil && request.CreateTime.AsTime().Unix() > time.Now().Unix() {
 		return nil, status.Errorf(codes.InvalidArgument, "create_time cannot be in the future")
 	}
+	if request.CreateTime != nil && request.PublishTime != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "create_time and publish_time cannot be set together")
+	}
+	if request.RemindTime != nil && request.RemindTime.AsTime().Unix() <= time.Now().Unix() {
+		return nil, status.Errorf(codes.InvalidArgument, "remind_time must be in the future")
+	}
 
 	create := &store.Memo{
 		UID:        shortuuid.New(),
@@ -55,6 +63,13 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 	if workspaceMemoRelatedSetting.DisallowPublicVisibility && create.Visibility == store.Public {
 		return nil, status.Errorf(codes.PermissionDenied, "disable public memos system setting is enabled")
 	}
+	if request.PublishTime != nil && request.PublishTime.AsTime().Unix() > time.Now().Unix() {
+		// Keep the memo private until it is published with the requested visibility.
+		publishTs := request.PublishTime.AsTime().Unix()
+		create.PublishTs = &publishTs
+		create.PublishVisibility = create.Visibility
+		create.Visibility = store.Private
+	}
 	contentLengthLimit, err := s.getContentLengthLimit(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get content length limit")
@@ -65,9 +80,33 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 	if err := memopayload.RebuildMemoPayload(create); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to rebuild memo payload: %v", err)
 	}
+	ruleEffects, err := s.applyMemoRules(ctx, create, request.Source, nil)
+	if err != nil {
+		return nil, err
+	}
+	slugUID, err := s.getMemoSlugUID(ctx, create.CreatorID, create.Content)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo slug: %v", err)
+	}
+	if slugUID != "" {
+		create.UID = slugUID
+	}
+	if ruleEffects.visibility != nil {
+		if create.PublishTs != nil {
+			create.PublishVisibility = *ruleEffects.visibility
+		} else {
+			create.Visibility = *ruleEffects.visibility
+		}
+	}
+	if create.PublishTs != nil {
+		deferMemoRuleWebhooks(create, ruleEffects)
+	}
 	if request.Location != nil {
 		create.Payload.Location = convertLocationToStore(request.Location)
 	}
+	if request.RemindTime != nil {
+		create.Payload.RemindTs = request.RemindTime.AsTime().Unix()
+	}
 
 	memo, err := s.Store.CreateMemo(ctx, create)
 	if err != nil {
@@ -91,14 +130,24 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 			return nil, errors.Wrap(err, "failed to set memo relations")
 		}
 	}
+	// The references in the content are related whatever relations are set.
+	if err := memopayload.SyncMemoReferences(ctx, s.Store, memo, nil); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to sync memo references: %v", err)
+	}
+	if err := s.applyMemoRuleActions(ctx, memo, ruleEffects); err != nil {
+		return nil, err
+	}
 
 	memoMessage, err := s.convertMemoFromStore(ctx, memo, v1pb.MemoView_MEMO_VIEW_FULL)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to convert memo")
 	}
-	// Try to dispatch webhook when memo is created.
-	if err := s.DispatchMemoCreatedWebhook(ctx, memoMessage); err != nil {
-		slog.Warn("Failed to dispatch memo created webhook", slog.Any("err", err))
+	// Try to dispatch webhook when memo is created. Scheduled memos dispatch it once they are published.
+	if memo.PublishTs == nil {
+		if err := s.DispatchMemoCreatedWebhook(ctx, memoMessage); err != nil {
+			slog.Warn("Failed to dispatch memo created webhook", slog.Any("err", err))
+		}
+		s.dispatchMemoRuleWebhooks(ctx, memoMessage, ruleEffects.webhookIDs)
 	}
 
 	return memoMessage, nil
@@ -109,10 +158,21 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 		// Exclude comments by default.
 		ExcludeComments: true,
 	}
-	if err := s.buildMemoFindWithFilter(ctx, memoFind, request.Filter); err != nil {
+	filter := request.Filter
+	if request.Shortcut != "" {
+		shortcutFilter, err := s.getShortcutFilter(ctx, request.Shortcut)
+		if err != nil {
+			return nil, err
+		}
+		filter = joinMemoFilters(shortcutFilter, request.Filter)
+	}
+	if err := s.buildMemoFindWithFilter(ctx, memoFind, filter); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "failed to build find memos with filter: %v", err)
 	}
 
+	// Search results are ordered by relevance and random memos are not ordered,
+	// so they are paged by offset rather than by the sort key of the last memo.
+	keyset := len(memoFind.ContentSearch) == 0 && !memoFind.Random
 	var limit, offset int
 	if request.PageToken != "" {
 		var pageToken v1pb.PageToken
@@ -121,6 +181,9 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 		}
 		limit = int(pageToken.Limit)
 		offset = int(pageToken.Offset)
+		if keyset {
+			memoFind.Cursor = convertPageCursorToStore(pageToken.Cursor)
+		}
 	} else {
 		limit = int(request.PageSize)
 	}
@@ -142,26 +205,51 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 	nextPageToken := ""
 	if len(memos) == limitPlusOne {
 		memos = memos[:limit]
-		nextPageToken, err = getPageToken(limit, offset+limit)
+		if keyset {
+			nextPageToken, err = getCursorPageToken(limit, getMemoCursor(memos[limit-1], memoFind))
+		} else {
+			nextPageToken, err = getPageToken(limit, offset+limit)
+		}
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
 		}
 	}
+	searchSnippets := []*v1pb.MemoSearchSnippet{}
 	for _, memo := range memos {
 		memoMessage, err := s.convertMemoFromStore(ctx, memo, request.View)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to convert memo")
 		}
 		memoMessages = append(memoMessages, memoMessage)
+		if memo.SearchSnippet != "" {
+			searchSnippets = append(searchSnippets, &v1pb.MemoSearchSnippet{
+				Name:    memoMessage.Name,
+				Snippet: memo.SearchSnippet,
+			})
+		}
 	}
 
 	response := &v1pb.ListMemosResponse{
-		Memos:         memoMessages,
-		NextPageToken: nextPageToken,
+		Memos:          memoMessages,
+		NextPageToken:  nextPageToken,
+		SearchSnippets: searchSnippets,
 	}
 	return response, nil
 }
 
+// getMemoCursor returns the sort key of the memo in the order of the find.
+func getMemoCursor(memo *store.Memo, find *store.FindMemo) *store.Cursor {
+	cursor := &store.Cursor{
+		Pinned: memo.Pinned,
+		Ts:     memo.CreatedTs,
+		ID:     memo.ID,
+	}
+	if find.OrderByUpdatedTs {
+		cursor.Ts = memo.UpdatedTs
+	}
+	return cursor
+}
+
 func (s *APIV1Service) GetMemo(ctx context.Context, request *v1pb.GetMemoRequest) (*v1pb.Memo, error) {
 	id, err := ExtractMemoIDFromName(request.Name)
 	if err != nil {
@@ -176,15 +264,17 @@ func (s *APIV1Service) GetMemo(ctx context.Context, request *v1pb.GetMemoRequest
 	if memo == nil {
 		return nil, status.Errorf(codes.NotFound, "memo not found")
 	}
-	if memo.Visibility != store.Public {
+	// A share link of the memo grants access to it regardless of its visibility.
+	if memo.Visibility != store.Public && !isMemoSharedInContext(ctx, memo.ID) {
 		user, err := s.GetCurrentUser(ctx)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get user")
 		}
-		if user == nil {
-			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		visible, err := s.isMemoVisibleToUser(ctx, memo, user)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo collaborator: %v", err)
 		}
-		if memo.Visibility == store.Private && memo.CreatorID != user.ID {
+		if !visible {
 			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 		}
 	}
@@ -198,9 +288,7 @@ func (s *APIV1Service) GetMemo(ctx context.Context, request *v1pb.GetMemoRequest
 
 //nolint:all
 func (s *APIV1Service) GetMemoByUid(ctx context.Context, request *v1pb.GetMemoByUidRequest) (*v1pb.Memo, error) {
-	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
-		UID: &request.Uid,
-	})
+	memo, err := s.getMemoByUID(ctx, request.Uid)
 	if err != nil {
 		return nil, err
 	}
@@ -212,10 +300,11 @@ func (s *APIV1Service) GetMemoByUid(ctx context.Context, request *v1pb.GetMemoBy
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get user")
 		}
-		if user == nil {
-			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		visible, err := s.isMemoVisibleToUser(ctx, memo, user)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo collaborator: %v", err)
 		}
-		if memo.Visibility == store.Private && memo.CreatorID != user.ID {
+		if !visible {
 			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 		}
 	}
@@ -248,14 +337,37 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get current user")
 	}
-	// Only the creator or admin can update the memo.
+	// Only the creator or admin can update the memo, and its editors can update some of its fields.
 	if memo.CreatorID != user.ID && !isSuperUser(user) {
-		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		isEditor, err := s.hasMemoCollaboratorRole(ctx, memo.ID, user.ID, store.MemoCollaboratorEditor)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo collaborator: %v", err)
+		}
+		if !isEditor {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+		for _, path := range request.UpdateMask.Paths {
+			if !slices.Contains(memoCollaboratorEditablePaths, path) {
+				return nil, status.Errorf(codes.PermissionDenied, "editors cannot update %q", path)
+			}
+		}
+	}
+	if err := s.checkMemoEtag(ctx, memo, request.Etag); err != nil {
+		return nil, err
 	}
 
+	// Keep a snapshot of the memo so that a revision can be recorded after the update.
+	previousContent, previousVisibility, previousUID := memo.Content, memo.Visibility, memo.UID
+	previousReferences := memo.Payload.GetReferences()
+	// The memo rules are matched against the memo before the update too, as the payload is rebuilt in place.
+	previousMemo := &store.Memo{Content: memo.Content, Payload: &storepb.MemoPayload{Property: memo.Payload.GetProperty()}}
 	update := &store.UpdateMemo{
 		ID: id,
 	}
+	if request.Etag != "" {
+		// Only update the version of the memo the etag was checked against.
+		update.ExpectedVersion = &memo.Version
+	}
 	if !request.PreserveUpdateTime {
 		currentTs := time.Now().Unix()
 		update.UpdatedTs = &currentTs
@@ -292,6 +404,11 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 		return nil, status.Errorf(codes.InvalidArgument, "create_time cannot be after update_time")
 	}
 
+	// The effects of the memo rules, when the content is updated.
+	var ruleEffects *memoRuleEffects
+	// The changes of the pin, resources and relations of the memo are made once the update of the memo
+	// passed the version check, so that a stale request changes nothing.
+	sideEffects := []func() error{}
 	for _, path := range request.UpdateMask.Paths {
 		if path == "content" {
 			contentLengthLimit, err := s.getContentLengthLimit(ctx)
@@ -305,6 +422,10 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 			if err := memopayload.RebuildMemoPayload(memo); err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to rebuild memo payload: %v", err)
 			}
+			ruleEffects, err = s.applyMemoRules(ctx, memo, request.Source, previousMemo)
+			if err != nil {
+				return nil, err
+			}
 			// Also check attached image resources.
 			resources, err := s.Store.ListResources(ctx, &store.FindResource{MemoID: &memo.ID})
 			if err != nil {
@@ -318,6 +439,21 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 			}
 			update.Content = &memo.Content
 			update.Payload = memo.Payload
+		} else if path == "uid" {
+			uid := strings.TrimSpace(request.Memo.Uid)
+			if !util.UIDMatcher.MatchString(uid) {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid uid: %s", uid)
+			}
+			if uid != memo.UID {
+				available, err := s.isMemoUIDAvailable(ctx, uid, memo.ID)
+				if err != nil {
+					return nil, status.Errorf(codes.Internal, "failed to check memo uid: %v", err)
+				}
+				if !available {
+					return nil, status.Errorf(codes.AlreadyExists, "uid %s is taken", uid)
+				}
+				update.UID = &uid
+			}
 		} else if path == "visibility" {
 			workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
 			if err != nil {
@@ -336,39 +472,115 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 		} else if path == "update_time" {
 			update.UpdatedTs = &finalUpdatedTs
 		} else if path == "pinned" {
-			if _, err := s.Store.UpsertMemoOrganizer(ctx, &store.MemoOrganizer{
-				MemoID: id,
-				UserID: user.ID,
-				Pinned: request.Memo.Pinned,
-			}); err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to upsert memo organizer")
-			}
+			sideEffects = append(sideEffects, func() error {
+				if _, err := s.Store.UpsertMemoOrganizer(ctx, &store.MemoOrganizer{
+					MemoID: id,
+					UserID: user.ID,
+					Pinned: request.Memo.Pinned,
+				}); err != nil {
+					return status.Errorf(codes.Internal, "failed to upsert memo organizer")
+				}
+				return nil
+			})
 		} else if path == "resources" {
-			_, err := s.SetMemoResources(ctx, &v1pb.SetMemoResourcesRequest{
-				Name:      request.Memo.Name,
-				Resources: request.Memo.Resources,
+			sideEffects = append(sideEffects, func() error {
+				_, err := s.SetMemoResources(ctx, &v1pb.SetMemoResourcesRequest{
+					Name:      request.Memo.Name,
+					Resources: request.Memo.Resources,
+				})
+				return errors.Wrap(err, "failed to set memo resources")
 			})
-			if err != nil {
-				return nil, errors.Wrap(err, "failed to set memo resources")
-			}
 		} else if path == "relations" {
-			_, err := s.SetMemoRelations(ctx, &v1pb.SetMemoRelationsRequest{
-				Name:      request.Memo.Name,
-				Relations: request.Memo.Relations,
+			sideEffects = append(sideEffects, func() error {
+				_, err := s.SetMemoRelations(ctx, &v1pb.SetMemoRelationsRequest{
+					Name:      request.Memo.Name,
+					Relations: request.Memo.Relations,
+				})
+				return errors.Wrap(err, "failed to set memo relations")
 			})
-			if err != nil {
-				return nil, errors.Wrap(err, "failed to set memo relations")
-			}
 		} else if path == "location" {
 			payload := memo.Payload
 			payload.Location = convertLocationToStore(request.Memo.Location)
 			update.Payload = payload
+		} else if path == "publish_time" {
+			// Clearing the publish time cancels the schedule, and the memo stays private.
+			publishTs := int64(0)
+			if request.Memo.PublishTime != nil {
+				publishTs = request.Memo.PublishTime.AsTime().Unix()
+				if publishTs <= now {
+					return nil, status.Errorf(codes.InvalidArgument, "publish_time must be in the future")
+				}
+			}
+			update.PublishTs = &publishTs
+		} else if path == "remind_time" {
+			// Clearing the remind time cancels the reminder, while the due dates of the tasks still remind the creator.
+			payload := memo.Payload
+			payload.RemindTs = 0
+			if request.Memo.RemindTime != nil {
+				payload.RemindTs = request.Memo.RemindTime.AsTime().Unix()
+				if payload.RemindTs <= now {
+					return nil, status.Errorf(codes.InvalidArgument, "remind_time must be in the future")
+				}
+			}
+			update.Payload = payload
+		}
+	}
+	if ruleEffects != nil && ruleEffects.visibility != nil && !slices.Contains(request.UpdateMask.Paths, "visibility") {
+		update.Visibility = ruleEffects.visibility
+	}
+	// A scheduled memo stays private, and the requested visibility is applied once it is published.
+	scheduled := memo.PublishTs != nil
+	if update.PublishTs != nil {
+		scheduled = *update.PublishTs != 0
+	}
+	if scheduled && (update.Visibility != nil || update.PublishTs != nil) {
+		publishVisibility := memo.PublishVisibility
+		if memo.PublishTs == nil {
+			publishVisibility = memo.Visibility
+		}
+		if update.Visibility != nil {
+			publishVisibility = *update.Visibility
 		}
+		private := store.Private
+		update.Visibility = &private
+		update.PublishVisibility = &publishVisibility
+	}
+	if ruleEffects != nil && scheduled {
+		deferMemoRuleWebhooks(memo, ruleEffects)
 	}
 
 	if err = s.Store.UpdateMemo(ctx, update); err != nil {
+		if errors.Is(err, store.ErrMemoVersionMismatch) {
+			return nil, s.checkMemoEtagByID(ctx, id, request.Etag)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to update memo")
 	}
+	if update.UID != nil {
+		// Links to the previous uid keep leading to the memo.
+		if err := s.setMemoAlias(ctx, id, previousUID, *update.UID); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to set memo alias: %v", err)
+		}
+	}
+	for _, sideEffect := range sideEffects {
+		if err := sideEffect(); err != nil {
+			return nil, err
+		}
+	}
+	if update.Content != nil {
+		if err := memopayload.SyncMemoReferences(ctx, s.Store, memo, previousReferences); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to sync memo references: %v", err)
+		}
+	}
+	if (update.Content != nil && *update.Content != previousContent) || (update.Visibility != nil && *update.Visibility != previousVisibility) {
+		if err := s.createMemoRevision(ctx, &store.MemoRevision{
+			MemoID:     id,
+			CreatorID:  user.ID,
+			Content:    previousContent,
+			Visibility: previousVisibility,
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create memo revision: %v", err)
+		}
+	}
 
 	memo, err = s.Store.GetMemo(ctx, &store.FindMemo{
 		ID: &id,
@@ -376,6 +588,11 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get memo")
 	}
+	if ruleEffects != nil {
+		if err := s.applyMemoRuleActions(ctx, memo, ruleEffects); err != nil {
+			return nil, err
+		}
+	}
 	memoMessage, err := s.convertMemoFromStore(ctx, memo, v1pb.MemoView_MEMO_VIEW_FULL)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to convert memo")
@@ -384,6 +601,9 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 	if err := s.DispatchMemoUpdatedWebhook(ctx, memoMessage); err != nil {
 		slog.Warn("Failed to dispatch memo updated webhook", slog.Any("err", err))
 	}
+	if ruleEffects != nil && !scheduled {
+		s.dispatchMemoRuleWebhooks(ctx, memoMessage, ruleEffects.webhookIDs)
+	}
 
 	return memoMessage, nil
 }
@@ -399,6 +619,16 @@ func (s *APIV1Service) DeleteMemo(ctx context.Context, request *v1pb.DeleteMemoR
 	if err != nil {
 		return nil, err
 	}
+	if memo == nil {
+		// Deleting a memo in the trash deletes it permanently.
+		memo, err = s.Store.GetMemo(ctx, &store.FindMemo{
+			ID:      &id,
+			Trashed: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
 	if memo == nil {
 		return nil, status.Errorf(codes.NotFound, "memo not found")
 	}
@@ -411,52 +641,39 @@ func (s *APIV1Service) DeleteMemo(ctx context.Context, request *v1pb.DeleteMemoR
 	if memo.CreatorID != user.ID && !isSuperUser(user) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
-
-	if memoMessage, err := s.convertMemoFromStore(ctx, memo, v1pb.MemoView_MEMO_VIEW_METADATA_ONLY); err == nil {
-		// Try to dispatch webhook when memo is deleted.
-		if err := s.DispatchMemoDeletedWebhook(ctx, memoMessage); err != nil {
-			slog.Warn("Failed to dispatch memo deleted webhook", slog.Any("err", err))
-		}
-	}
-
-	if err = s.Store.DeleteMemo(ctx, &store.DeleteMemo{ID: id}); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete memo")
-	}
-
-	// Delete memo relation
-	if err := s.Store.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{MemoID: &id}); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete memo relations")
+	if err := s.checkMemoEtag(ctx, memo, request.Etag); err != nil {
+		return nil, err
 	}
 
-	// Delete related resources.
-	resources, err := s.Store.ListResources(ctx, &store.FindResource{MemoID: &id})
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list resources")
-	}
-	for _, resource := range resources {
-		if err := s.Store.DeleteResource(ctx, &store.DeleteResource{ID: resource.ID}); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to delete resource")
+	if memo.TrashedTs == nil && !request.Force {
+		trashedTs := time.Now().Unix()
+		update := &store.UpdateMemo{
+			ID:        id,
+			TrashedTs: &trashedTs,
 		}
-	}
-
-	// Delete memo comments
-	commentType := store.MemoRelationComment
-	relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{RelatedMemoID: &id, Type: &commentType})
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list memo comments")
-	}
-	for _, relation := range relations {
-		if _, err := s.DeleteMemo(ctx, &v1pb.DeleteMemoRequest{Name: fmt.Sprintf("%s%d", MemoNamePrefix, relation.MemoID)}); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to delete memo comment")
+		if request.Etag != "" {
+			update.ExpectedVersion = &memo.Version
 		}
+		if err := s.Store.UpdateMemo(ctx, update); err != nil {
+			if errors.Is(err, store.ErrMemoVersionMismatch) {
+				return nil, s.checkMemoEtagByID(ctx, id, request.Etag)
+			}
+			return nil, status.Errorf(codes.Internal, "failed to move memo to trash")
+		}
+	} else if err := s.Store.PurgeMemo(ctx, id); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete memo: %v", err)
 	}
 
-	// Delete memo references
-	referenceType := store.MemoRelationReference
-	if err := s.Store.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{RelatedMemoID: &id, Type: &referenceType}); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete memo references")
+	// The memo deleted webhook is dispatched once the memo left the user's view,
+	// so it is not dispatched again when a trashed memo is deleted permanently.
+	if memo.TrashedTs == nil {
+		if memoMessage, err := s.convertMemoFromStore(ctx, memo, v1pb.MemoView_MEMO_VIEW_METADATA_ONLY); err == nil {
+			// Try to dispatch webhook when memo is deleted.
+			if err := s.DispatchMemoDeletedWebhook(ctx, memoMessage); err != nil {
+				slog.Warn("Failed to dispatch memo deleted webhook", slog.Any("err", err))
+			}
+		}
 	}
-
 	return &emptypb.Empty{}, nil
 }
 
@@ -469,6 +686,9 @@ func (s *APIV1Service) CreateMemoComment(ctx context.Context, request *v1pb.Crea
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get memo")
 	}
+	if relatedMemo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
 
 	// Create the comment memo first.
 	memo, err := s.CreateMemo(ctx, request.Comment)
@@ -529,34 +749,50 @@ func (s *APIV1Service) ListMemoComments(ctx context.Context, request *v1pb.ListM
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
 	}
-	memoRelationComment := store.MemoRelationComment
-	memoRelations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
-		RelatedMemoID: &id,
-		Type:          &memoRelationComment,
-	})
+	// Comments are listed from the oldest.
+	memoFind := &store.FindMemo{
+		ParentID:       &id,
+		OrderByTimeAsc: true,
+	}
+	// All the comments are listed unless a page is requested.
+	limit := int(request.PageSize)
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		memoFind.Cursor = convertPageCursorToStore(pageToken.Cursor)
+	}
+	if limit > 0 {
+		limitPlusOne := limit + 1
+		memoFind.Limit = &limitPlusOne
+	}
+	comments, err := s.Store.ListMemos(ctx, memoFind)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list memo relations")
+		return nil, status.Errorf(codes.Internal, "failed to list memo comments: %v", err)
 	}
 
-	var memos []*v1pb.Memo
-	for _, memoRelation := range memoRelations {
-		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
-			ID: &memoRelation.MemoID,
-		})
+	nextPageToken := ""
+	if limit > 0 && len(comments) == limit+1 {
+		comments = comments[:limit]
+		nextPageToken, err = getCursorPageToken(limit, getMemoCursor(comments[limit-1], memoFind))
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to get memo")
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
 		}
-		if memo != nil {
-			memoMessage, err := s.convertMemoFromStore(ctx, memo, v1pb.MemoView_MEMO_VIEW_FULL)
-			if err != nil {
-				return nil, errors.Wrap(err, "failed to convert memo")
-			}
-			memos = append(memos, memoMessage)
+	}
+	var memos []*v1pb.Memo
+	for _, comment := range comments {
+		memoMessage, err := s.convertMemoFromStore(ctx, comment, v1pb.MemoView_MEMO_VIEW_FULL)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert memo")
 		}
+		memos = append(memos, memoMessage)
 	}
 
 	response := &v1pb.ListMemoCommentsResponse{
-		Memos: memos,
+		Memos:         memos,
+		NextPageToken: nextPageToken,
 	}
 	return response, nil
 }
@@ -636,11 +872,16 @@ func (s *APIV1Service) DeleteMemoTag(ctx context.Context, request *v1pb.DeleteMe
 		return nil, status.Errorf(codes.Internal, "failed to list memos")
 	}
 
+	trashedTs := time.Now().Unix()
 	for _, memo := range memos {
 		if request.DeleteRelatedMemos {
-			err := s.Store.DeleteMemo(ctx, &store.DeleteMemo{ID: memo.ID})
+			// The memos are moved to the trash, from which they can be restored until they are purged.
+			err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{
+				ID:        memo.ID,
+				TrashedTs: &trashedTs,
+			})
 			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to delete memo")
+				return nil, status.Errorf(codes.Internal, "failed to move memo to trash")
 			}
 		} else {
 			archived := store.Archived
@@ -675,6 +916,11 @@ func (s *APIV1Service) DispatchMemoUpdatedWebhook(ctx context.Context, memo *v1p
 	return s.dispatchMemoRelatedWebhook(ctx, memo, "memos.memo.updated")
 }
 
+// DispatchMemoReminderWebhook dispatches webhook when the creator of a memo is reminded of it.
+func (s *APIV1Service) DispatchMemoReminderWebhook(ctx context.Context, memo *v1pb.Memo) error {
+	return s.dispatchMemoRelatedWebhook(ctx, memo, "memos.memo.reminder")
+}
+
 // DispatchMemoDeletedWebhook dispatches webhook when memo is deleted.
 func (s *APIV1Service) DispatchMemoDeletedWebhook(ctx context.Context, memo *v1pb.Memo) error {
 	return s.dispatchMemoRelatedWebhook(ctx, memo, "memos.memo.deleted")
@@ -717,6 +963,10 @@ func convertMemoToWebhookPayload(memo *v1pb.Memo) (*v1pb.WebhookRequestPayload,
 	}, nil
 }
 
+// memoSnippetContentLength is the number of runes of the content enough for the snippet of a memo,
+// leaving room for the Markdown syntax that is not part of the snippet.
+const memoSnippetContentLength = 256
+
 func getMemoContentSnippet(content string) (string, error) {
 	nodes, err := parser.Parse(tokenizer.Tokenize(content))
 	if err != nil {
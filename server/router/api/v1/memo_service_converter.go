@@ -29,15 +29,26 @@ func (s *APIV1Service) convertMemoFromStore(ctx context.Context, memo *store.Mem
 		Visibility: convertVisibilityFromStore(memo.Visibility),
 		Pinned:     memo.Pinned,
 		Tags:       memo.Payload.Tags,
+		Etag:       getMemoEtag(memo),
 	}
 	if memo.Payload != nil {
 		memoMessage.Property = convertMemoPropertyFromStore(memo.Payload.Property)
 		memoMessage.Location = convertLocationFromStore(memo.Payload.Location)
+		if memo.Payload.RemindTs != 0 {
+			memoMessage.RemindTime = timestamppb.New(time.Unix(memo.Payload.RemindTs, 0))
+		}
 	}
 	if memo.ParentID != nil {
 		parent := fmt.Sprintf("%s%d", MemoNamePrefix, *memo.ParentID)
 		memoMessage.Parent = &parent
 	}
+	if memo.TrashedTs != nil {
+		memoMessage.TrashTime = timestamppb.New(time.Unix(*memo.TrashedTs, 0))
+	}
+	if memo.PublishTs != nil {
+		memoMessage.PublishTime = timestamppb.New(time.Unix(*memo.PublishTs, 0))
+		memoMessage.PublishVisibility = convertVisibilityFromStore(memo.PublishVisibility)
+	}
 
 	// Fill content when view is MEMO_VIEW_FULL.
 	if view == v1pb.MemoView_MEMO_VIEW_FULL {
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/operators"
 	"github.com/pkg/errors"
 	expr "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
 	"google.golang.org/grpc/codes"
@@ -24,15 +25,6 @@ func (s *APIV1Service) buildMemoFindWithFilter(ctx context.Context, find *store.
 		if len(filter.ContentSearch) > 0 {
 			find.ContentSearch = filter.ContentSearch
 		}
-		if len(filter.Visibilities) > 0 {
-			find.VisibilityList = filter.Visibilities
-		}
-		if filter.TagSearch != nil {
-			if find.PayloadFind == nil {
-				find.PayloadFind = &store.FindMemoPayload{}
-			}
-			find.PayloadFind.TagSearch = filter.TagSearch
-		}
 		if filter.OrderByPinned {
 			find.OrderByPinned = filter.OrderByPinned
 		}
@@ -72,9 +64,6 @@ func (s *APIV1Service) buildMemoFindWithFilter(ctx context.Context, find *store.
 			}
 			find.CreatorID = &user.ID
 		}
-		if filter.RowStatus != nil {
-			find.RowStatus = filter.RowStatus
-		}
 		if filter.Random {
 			find.Random = filter.Random
 		}
@@ -84,23 +73,8 @@ func (s *APIV1Service) buildMemoFindWithFilter(ctx context.Context, find *store.
 		if filter.IncludeComments {
 			find.ExcludeComments = false
 		}
-		if filter.HasLink {
-			find.PayloadFind.HasLink = true
-		}
-		if filter.HasTaskList {
-			find.PayloadFind.HasTaskList = true
-		}
-		if filter.HasCode {
-			find.PayloadFind.HasCode = true
-		}
-		if filter.HasIncompleteTasks {
-			find.PayloadFind.HasIncompleteTasks = true
-		}
-		if filter.HasImage {
-			find.PayloadFind.HasImage = true
-		}
-		if filter.Pinned {
-			find.Pinned = &filter.Pinned
+		if filter.Condition != nil {
+			find.Filter = filter.Condition
 		}
 	}
 
@@ -117,8 +91,10 @@ func (s *APIV1Service) buildMemoFindWithFilter(ctx context.Context, find *store.
 
 		find.VisibilityList = []store.Visibility{store.Public}
 	} else if find.CreatorID == nil || *find.CreatorID != user.ID {
-		// If creator is not specified or the creator is not the current user, only public and protected memos are visible.
+		// If creator is not specified or the creator is not the current user, only public and protected memos are visible,
+		// along with the memos the user is a collaborator of.
 		find.VisibilityList = []store.Visibility{store.Public, store.Protected}
+		find.CollaboratorID = &user.ID
 	}
 
 	return nil
@@ -128,12 +104,16 @@ func (s *APIV1Service) buildMemoFindWithFilter(ctx context.Context, find *store.
 var MemoFilterCELAttributes = []cel.EnvOption{
 	cel.Variable("content_search", cel.ListType(cel.StringType)),
 	cel.Variable("visibilities", cel.ListType(cel.StringType)),
+	cel.Variable("visibility", cel.StringType),
 	cel.Variable("tag_search", cel.ListType(cel.StringType)),
 	cel.Variable("order_by_pinned", cel.BoolType),
 	cel.Variable("order_by_time_asc", cel.BoolType),
 	cel.Variable("order_by_field", cel.StringType),
 	cel.Variable("display_time_before", cel.IntType),
 	cel.Variable("display_time_after", cel.IntType),
+	cel.Variable("id", cel.IntType),
+	cel.Variable("create_time", cel.IntType),
+	cel.Variable("update_time", cel.IntType),
 	cel.Variable("creator", cel.StringType),
 	cel.Variable("uid", cel.StringType),
 	cel.Variable("row_status", cel.StringType),
@@ -146,28 +126,87 @@ var MemoFilterCELAttributes = []cel.EnvOption{
 	cel.Variable("has_incomplete_tasks", cel.BoolType),
 	cel.Variable("has_image", cel.BoolType),
 	cel.Variable("pinned", cel.BoolType),
+	// location_within_box(south, west, north, east) matches the memos located in the box, in degrees.
+	cel.Function("location_within_box",
+		cel.Overload("location_within_box_numbers", []*cel.Type{cel.DynType, cel.DynType, cel.DynType, cel.DynType}, cel.BoolType),
+	),
+	// location_within_radius(latitude, longitude, radius) matches the memos located within the radius in meters of the point.
+	cel.Function("location_within_radius",
+		cel.Overload("location_within_radius_numbers", []*cel.Type{cel.DynType, cel.DynType, cel.DynType}, cel.BoolType),
+	),
+}
+
+// memoFilterLocationFunctions maps the CEL location functions to the geo operators of the memo filter.
+var memoFilterLocationFunctions = map[string]store.MemoFilterOperator{
+	"location_within_box":    store.MemoFilterOperatorWithinBox,
+	"location_within_radius": store.MemoFilterOperatorWithinRadius,
+}
+
+// memoFilterDirectives are the CEL attributes that shape the query rather than match the memos.
+// They can only be compared with a constant in the top-level conjunction of the filter.
+var memoFilterDirectives = map[string]bool{
+	"content_search":      true,
+	"order_by_pinned":     true,
+	"order_by_time_asc":   true,
+	"order_by_field":      true,
+	"display_time_before": true,
+	"display_time_after":  true,
+	"random":              true,
+	"limit":               true,
+	"include_comments":    true,
+}
+
+// memoFilterFields are the CEL attributes that compile to a condition on a memo field.
+var memoFilterFields = map[string]store.MemoFilterField{
+	"id":                   store.MemoFilterFieldID,
+	"uid":                  store.MemoFilterFieldUID,
+	"creator":              store.MemoFilterFieldCreatorID,
+	"create_time":          store.MemoFilterFieldCreatedTs,
+	"update_time":          store.MemoFilterFieldUpdatedTs,
+	"row_status":           store.MemoFilterFieldRowStatus,
+	"visibility":           store.MemoFilterFieldVisibility,
+	"pinned":               store.MemoFilterFieldPinned,
+	"has_link":             store.MemoFilterFieldHasLink,
+	"has_task_list":        store.MemoFilterFieldHasTaskList,
+	"has_code":             store.MemoFilterFieldHasCode,
+	"has_incomplete_tasks": store.MemoFilterFieldHasIncompleteTasks,
+	"has_image":            store.MemoFilterFieldHasImage,
+}
+
+// memoFilterComparisonOperators maps the CEL comparison functions to the memo filter operators.
+var memoFilterComparisonOperators = map[string]store.MemoFilterOperator{
+	operators.Equals:        store.MemoFilterOperatorEqual,
+	operators.NotEquals:     store.MemoFilterOperatorNotEqual,
+	operators.Less:          store.MemoFilterOperatorLess,
+	operators.LessEquals:    store.MemoFilterOperatorLessOrEqual,
+	operators.Greater:       store.MemoFilterOperatorGreater,
+	operators.GreaterEquals: store.MemoFilterOperatorGreaterOrEqual,
+}
+
+// mirroredMemoFilterOperators are the operators that keep the meaning of a comparison when its operands are swapped.
+var mirroredMemoFilterOperators = map[store.MemoFilterOperator]store.MemoFilterOperator{
+	store.MemoFilterOperatorEqual:          store.MemoFilterOperatorEqual,
+	store.MemoFilterOperatorNotEqual:       store.MemoFilterOperatorNotEqual,
+	store.MemoFilterOperatorLess:           store.MemoFilterOperatorGreater,
+	store.MemoFilterOperatorLessOrEqual:    store.MemoFilterOperatorGreaterOrEqual,
+	store.MemoFilterOperatorGreater:        store.MemoFilterOperatorLess,
+	store.MemoFilterOperatorGreaterOrEqual: store.MemoFilterOperatorLessOrEqual,
 }
 
 type MemoFilter struct {
-	ContentSearch      []string
-	Visibilities       []store.Visibility
-	TagSearch          []string
-	OrderByPinned      bool
-	OrderByTimeAsc     bool
-	OrderByField       *string
-	DisplayTimeBefore  *int64
-	DisplayTimeAfter   *int64
-	Creator            *string
-	RowStatus          *store.RowStatus
-	Random             bool
-	Limit              *int
-	IncludeComments    bool
-	HasLink            bool
-	HasTaskList        bool
-	HasCode            bool
-	HasIncompleteTasks bool
-	HasImage           bool
-	Pinned             bool
+	ContentSearch     []string
+	OrderByPinned     bool
+	OrderByTimeAsc    bool
+	OrderByField      *string
+	DisplayTimeBefore *int64
+	DisplayTimeAfter  *int64
+	// Creator is the creator compared in the top-level conjunction, which also decides the visible memos.
+	Creator         *string
+	Random          bool
+	Limit           *int
+	IncludeComments bool
+	// Condition is the compiled predicate of the remaining conditions, nil if there are none.
+	Condition *store.MemoFilter
 }
 
 func parseMemoFilter(expression string) (*MemoFilter, error) {
@@ -180,96 +219,289 @@ func parseMemoFilter(expression string) (*MemoFilter, error) {
 		return nil, errors.Errorf("found issue %v", issues)
 	}
 	filter := &MemoFilter{}
-	expr, err := cel.AstToParsedExpr(ast)
+	parsedExpr, err := cel.AstToParsedExpr(ast)
 	if err != nil {
 		return nil, err
 	}
-	callExpr := expr.GetExpr().GetCallExpr()
-	findMemoField(callExpr, filter)
+
+	conditions := []*store.MemoFilter{}
+	for _, conjunct := range splitMemoFilterConjunction(parsedExpr.GetExpr()) {
+		ok, err := parseMemoFilterDirective(conjunct, filter)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			continue
+		}
+		condition, err := compileMemoFilterCondition(conjunct)
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, condition)
+	}
+	if len(conditions) == 1 {
+		filter.Condition = conditions[0]
+	} else if len(conditions) > 1 {
+		filter.Condition = store.NewMemoFilterAnd(conditions...)
+	}
+	if filter.Condition != nil {
+		if err := filter.Condition.Validate(); err != nil {
+			return nil, err
+		}
+	}
 	return filter, nil
 }
 
-func findMemoField(callExpr *expr.Expr_Call, filter *MemoFilter) {
-	if len(callExpr.Args) == 2 {
-		idExpr := callExpr.Args[0].GetIdentExpr()
-		if idExpr != nil {
-			if idExpr.Name == "content_search" {
-				contentSearch := []string{}
-				for _, expr := range callExpr.Args[1].GetListExpr().GetElements() {
-					value := expr.GetConstExpr().GetStringValue()
-					contentSearch = append(contentSearch, value)
-				}
-				filter.ContentSearch = contentSearch
-			} else if idExpr.Name == "visibilities" {
-				visibilities := []store.Visibility{}
-				for _, expr := range callExpr.Args[1].GetListExpr().GetElements() {
-					value := expr.GetConstExpr().GetStringValue()
-					visibilities = append(visibilities, store.Visibility(value))
-				}
-				filter.Visibilities = visibilities
-			} else if idExpr.Name == "tag_search" {
-				tagSearch := []string{}
-				for _, expr := range callExpr.Args[1].GetListExpr().GetElements() {
-					value := expr.GetConstExpr().GetStringValue()
-					tagSearch = append(tagSearch, value)
-				}
-				filter.TagSearch = tagSearch
-			} else if idExpr.Name == "order_by_pinned" {
-				value := callExpr.Args[1].GetConstExpr().GetBoolValue()
-				filter.OrderByPinned = value
-			} else if idExpr.Name == "order_by_time_asc" {
-				value := callExpr.Args[1].GetConstExpr().GetBoolValue()
-				filter.OrderByTimeAsc = value
-			} else if idExpr.Name == "order_by_field" {
-				value := callExpr.Args[1].GetConstExpr().GetStringValue()
-				filter.OrderByField = &value
-			} else if idExpr.Name == "display_time_before" {
-				displayTimeBefore := callExpr.Args[1].GetConstExpr().GetInt64Value()
-				filter.DisplayTimeBefore = &displayTimeBefore
-			} else if idExpr.Name == "display_time_after" {
-				displayTimeAfter := callExpr.Args[1].GetConstExpr().GetInt64Value()
-				filter.DisplayTimeAfter = &displayTimeAfter
-			} else if idExpr.Name == "creator" {
-				creator := callExpr.Args[1].GetConstExpr().GetStringValue()
-				filter.Creator = &creator
-			} else if idExpr.Name == "row_status" {
-				rowStatus := store.RowStatus(callExpr.Args[1].GetConstExpr().GetStringValue())
-				filter.RowStatus = &rowStatus
-			} else if idExpr.Name == "random" {
-				value := callExpr.Args[1].GetConstExpr().GetBoolValue()
-				filter.Random = value
-			} else if idExpr.Name == "limit" {
-				limit := int(callExpr.Args[1].GetConstExpr().GetInt64Value())
-				filter.Limit = &limit
-			} else if idExpr.Name == "include_comments" {
-				value := callExpr.Args[1].GetConstExpr().GetBoolValue()
-				filter.IncludeComments = value
-			} else if idExpr.Name == "has_link" {
-				value := callExpr.Args[1].GetConstExpr().GetBoolValue()
-				filter.HasLink = value
-			} else if idExpr.Name == "has_task_list" {
-				value := callExpr.Args[1].GetConstExpr().GetBoolValue()
-				filter.HasTaskList = value
-			} else if idExpr.Name == "has_code" {
-				value := callExpr.Args[1].GetConstExpr().GetBoolValue()
-				filter.HasCode = value
-			} else if idExpr.Name == "has_incomplete_tasks" {
-				value := callExpr.Args[1].GetConstExpr().GetBoolValue()
-				filter.HasIncompleteTasks = value
-			} else if idExpr.Name == "has_image" {
-				value := callExpr.Args[1].GetConstExpr().GetBoolValue()
-				filter.HasImage = value
-			} else if idExpr.Name == "pinned" {
-				value := callExpr.Args[1].GetConstExpr().GetBoolValue()
-				filter.Pinned = value
+// splitMemoFilterConjunction returns the operands of the top-level && of the expression.
+func splitMemoFilterConjunction(e *expr.Expr) []*expr.Expr {
+	callExpr := e.GetCallExpr()
+	if callExpr == nil || callExpr.Function != operators.LogicalAnd {
+		return []*expr.Expr{e}
+	}
+	list := []*expr.Expr{}
+	for _, arg := range callExpr.Args {
+		list = append(list, splitMemoFilterConjunction(arg)...)
+	}
+	return list
+}
+
+// parseMemoFilterDirective sets the directive or top-level creator compared in the expression,
+// returning false if the expression is a condition on the memos instead.
+func parseMemoFilterDirective(e *expr.Expr, filter *MemoFilter) (bool, error) {
+	callExpr := e.GetCallExpr()
+	if callExpr == nil || callExpr.Function != operators.Equals || len(callExpr.Args) != 2 {
+		return false, nil
+	}
+	idExpr := callExpr.Args[0].GetIdentExpr()
+	if idExpr == nil || (!memoFilterDirectives[idExpr.Name] && idExpr.Name != "creator") {
+		return false, nil
+	}
+	if idExpr.Name == "content_search" {
+		contentSearch := []string{}
+		for _, element := range callExpr.Args[1].GetListExpr().GetElements() {
+			if element.GetConstExpr() == nil {
+				return false, errors.New("content_search must be compared with a list of strings")
 			}
-			return
+			contentSearch = append(contentSearch, element.GetConstExpr().GetStringValue())
 		}
+		filter.ContentSearch = contentSearch
+		return true, nil
 	}
-	for _, arg := range callExpr.Args {
-		callExpr := arg.GetCallExpr()
-		if callExpr != nil {
-			findMemoField(callExpr, filter)
+
+	value := callExpr.Args[1].GetConstExpr()
+	if value == nil {
+		return false, errors.Errorf("%s must be compared with a constant", idExpr.Name)
+	}
+	switch idExpr.Name {
+	case "order_by_pinned":
+		filter.OrderByPinned = value.GetBoolValue()
+	case "order_by_time_asc":
+		filter.OrderByTimeAsc = value.GetBoolValue()
+	case "order_by_field":
+		orderByField := value.GetStringValue()
+		filter.OrderByField = &orderByField
+	case "display_time_before":
+		displayTimeBefore := value.GetInt64Value()
+		filter.DisplayTimeBefore = &displayTimeBefore
+	case "display_time_after":
+		displayTimeAfter := value.GetInt64Value()
+		filter.DisplayTimeAfter = &displayTimeAfter
+	case "creator":
+		creator := value.GetStringValue()
+		filter.Creator = &creator
+	case "random":
+		filter.Random = value.GetBoolValue()
+	case "limit":
+		limit := int(value.GetInt64Value())
+		filter.Limit = &limit
+	case "include_comments":
+		filter.IncludeComments = value.GetBoolValue()
+	}
+	return true, nil
+}
+
+// compileMemoFilterCondition compiles the expression to a predicate on the memos.
+func compileMemoFilterCondition(e *expr.Expr) (*store.MemoFilter, error) {
+	if idExpr := e.GetIdentExpr(); idExpr != nil {
+		// A bool attribute on its own matches the memos where it is true.
+		return compileMemoFilterComparison(idExpr.Name, store.MemoFilterOperatorEqual, &expr.Expr{
+			ExprKind: &expr.Expr_ConstExpr{ConstExpr: &expr.Constant{ConstantKind: &expr.Constant_BoolValue{BoolValue: true}}},
+		})
+	}
+	callExpr := e.GetCallExpr()
+	if callExpr == nil {
+		return nil, errors.New("unsupported expression, expected a condition")
+	}
+
+	switch callExpr.Function {
+	case operators.LogicalAnd, operators.LogicalOr:
+		children := []*store.MemoFilter{}
+		for _, arg := range callExpr.Args {
+			child, err := compileMemoFilterCondition(arg)
+			if err != nil {
+				return nil, err
+			}
+			children = append(children, child)
+		}
+		if callExpr.Function == operators.LogicalAnd {
+			return store.NewMemoFilterAnd(children...), nil
+		}
+		return store.NewMemoFilterOr(children...), nil
+	case operators.LogicalNot:
+		child, err := compileMemoFilterCondition(callExpr.Args[0])
+		if err != nil {
+			return nil, err
+		}
+		return store.NewMemoFilterNot(child), nil
+	case operators.In:
+		return compileMemoFilterIn(callExpr.Args[0], callExpr.Args[1])
+	}
+	if operator, ok := memoFilterLocationFunctions[callExpr.Function]; ok {
+		return compileMemoFilterLocation(callExpr.Function, operator, callExpr.Args)
+	}
+
+	operator, ok := memoFilterComparisonOperators[callExpr.Function]
+	if !ok || len(callExpr.Args) != 2 {
+		return nil, errors.Errorf("unsupported function %q", callExpr.Function)
+	}
+	left, right := callExpr.Args[0], callExpr.Args[1]
+	if left.GetIdentExpr() == nil && right.GetIdentExpr() != nil {
+		left, right, operator = right, left, mirroredMemoFilterOperators[operator]
+	}
+	if left.GetIdentExpr() == nil {
+		return nil, errors.New("a comparison must have an attribute on one side")
+	}
+	return compileMemoFilterComparison(left.GetIdentExpr().Name, operator, right)
+}
+
+// compileMemoFilterComparison compiles the comparison of the attribute with the value.
+func compileMemoFilterComparison(name string, operator store.MemoFilterOperator, value *expr.Expr) (*store.MemoFilter, error) {
+	if memoFilterDirectives[name] {
+		return nil, errors.Errorf("%s can only be used as a top-level condition", name)
+	}
+	switch name {
+	case "visibilities":
+		if operator != store.MemoFilterOperatorEqual && operator != store.MemoFilterOperatorNotEqual {
+			return nil, errors.New("visibilities only supports == and !=")
+		}
+		condition, err := compileMemoFilterIn(&expr.Expr{ExprKind: &expr.Expr_IdentExpr{IdentExpr: &expr.Expr_Ident{Name: "visibility"}}}, value)
+		if err != nil {
+			return nil, err
+		}
+		if operator == store.MemoFilterOperatorNotEqual {
+			return store.NewMemoFilterNot(condition), nil
+		}
+		return condition, nil
+	case "tag_search":
+		// tag_search matches the memos with all of the tags.
+		if operator != store.MemoFilterOperatorEqual && operator != store.MemoFilterOperatorNotEqual {
+			return nil, errors.New("tag_search only supports == and !=")
+		}
+		elements := value.GetListExpr().GetElements()
+		if len(elements) == 0 {
+			return nil, errors.New("tag_search must be compared with a non-empty list of tags")
+		}
+		children := []*store.MemoFilter{}
+		for _, element := range elements {
+			if element.GetConstExpr() == nil {
+				return nil, errors.New("tag_search must be compared with a list of strings")
+			}
+			children = append(children, store.NewMemoFilterCondition(store.MemoFilterFieldTag, store.MemoFilterOperatorEqual, element.GetConstExpr().GetStringValue()))
+		}
+		condition := store.NewMemoFilterAnd(children...)
+		if operator == store.MemoFilterOperatorNotEqual {
+			return store.NewMemoFilterNot(condition), nil
+		}
+		return condition, nil
+	}
+
+	field, ok := memoFilterFields[name]
+	if !ok {
+		return nil, errors.Errorf("unsupported attribute %q", name)
+	}
+	fieldValue, err := convertMemoFilterValue(name, field, value)
+	if err != nil {
+		return nil, err
+	}
+	return store.NewMemoFilterCondition(field, operator, fieldValue), nil
+}
+
+// compileMemoFilterIn compiles `attribute in [values]` and `"tag" in tag_search`.
+func compileMemoFilterIn(element, list *expr.Expr) (*store.MemoFilter, error) {
+	if list.GetIdentExpr() != nil && list.GetIdentExpr().Name == "tag_search" {
+		if element.GetConstExpr() == nil {
+			return nil, errors.New("only a constant tag can be tested in tag_search")
+		}
+		return store.NewMemoFilterCondition(store.MemoFilterFieldTag, store.MemoFilterOperatorEqual, element.GetConstExpr().GetStringValue()), nil
+	}
+	idExpr := element.GetIdentExpr()
+	if idExpr == nil || list.GetListExpr() == nil {
+		return nil, errors.New("in only supports an attribute in a list of constants")
+	}
+	field, ok := memoFilterFields[idExpr.Name]
+	if !ok {
+		return nil, errors.Errorf("unsupported attribute %q", idExpr.Name)
+	}
+	values := []any{}
+	for _, value := range list.GetListExpr().GetElements() {
+		fieldValue, err := convertMemoFilterValue(idExpr.Name, field, value)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, fieldValue)
+	}
+	return store.NewMemoFilterCondition(field, store.MemoFilterOperatorIn, values...), nil
+}
+
+// compileMemoFilterLocation compiles the call of a location function, whose arguments are number constants.
+func compileMemoFilterLocation(function string, operator store.MemoFilterOperator, args []*expr.Expr) (*store.MemoFilter, error) {
+	values := []any{}
+	for _, arg := range args {
+		value := arg.GetConstExpr()
+		switch value.GetConstantKind().(type) {
+		case *expr.Constant_DoubleValue:
+			values = append(values, value.GetDoubleValue())
+		case *expr.Constant_Int64Value:
+			values = append(values, float64(value.GetInt64Value()))
+		default:
+			return nil, errors.Errorf("the arguments of %s must be number constants", function)
+		}
+	}
+	return store.NewMemoFilterCondition(store.MemoFilterFieldLocation, operator, values...), nil
+}
+
+// convertMemoFilterValue converts the constant compared with the attribute to the value type of the memo filter field.
+func convertMemoFilterValue(name string, field store.MemoFilterField, e *expr.Expr) (any, error) {
+	value := e.GetConstExpr()
+	if value == nil {
+		return nil, errors.Errorf("%s must be compared with a constant", name)
+	}
+	switch field {
+	case store.MemoFilterFieldID:
+		return int32(value.GetInt64Value()), nil
+	case store.MemoFilterFieldCreatorID:
+		userID, err := ExtractUserIDFromName(value.GetStringValue())
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid user name")
+		}
+		return userID, nil
+	case store.MemoFilterFieldCreatedTs, store.MemoFilterFieldUpdatedTs:
+		return value.GetInt64Value(), nil
+	case store.MemoFilterFieldUID:
+		return value.GetStringValue(), nil
+	case store.MemoFilterFieldRowStatus:
+		rowStatus := store.RowStatus(value.GetStringValue())
+		if rowStatus != store.Normal && rowStatus != store.Archived {
+			return nil, errors.Errorf("invalid row status %q", rowStatus)
+		}
+		return rowStatus, nil
+	case store.MemoFilterFieldVisibility:
+		visibility := store.Visibility(value.GetStringValue())
+		if visibility != store.Public && visibility != store.Protected && visibility != store.Private {
+			return nil, errors.Errorf("invalid visibility %q", visibility)
 		}
+		return visibility, nil
+	default:
+		return value.GetBoolValue(), nil
 	}
 }
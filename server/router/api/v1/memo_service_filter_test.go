@@ -0,0 +1,81 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestParseMemoFilter(t *testing.T) {
+	tests := []struct {
+		filter    string
+		directive *MemoFilter
+		condition *store.MemoFilter
+	}{
+		{
+			filter:    `creator == "users/1" && row_status == "NORMAL" && order_by_pinned == true`,
+			directive: &MemoFilter{Creator: &[]string{"users/1"}[0], OrderByPinned: true},
+			condition: store.NewMemoFilterCondition(store.MemoFilterFieldRowStatus, store.MemoFilterOperatorEqual, store.Normal),
+		},
+		{
+			filter: `visibilities == ["PUBLIC", "PROTECTED"] && tag_search == ["a", "b"]`,
+			condition: store.NewMemoFilterAnd(
+				store.NewMemoFilterCondition(store.MemoFilterFieldVisibility, store.MemoFilterOperatorIn, store.Public, store.Protected),
+				store.NewMemoFilterAnd(
+					store.NewMemoFilterCondition(store.MemoFilterFieldTag, store.MemoFilterOperatorEqual, "a"),
+					store.NewMemoFilterCondition(store.MemoFilterFieldTag, store.MemoFilterOperatorEqual, "b"),
+				),
+			),
+		},
+		{
+			filter: `!("work" in tag_search) || has_link`,
+			condition: store.NewMemoFilterOr(
+				store.NewMemoFilterNot(store.NewMemoFilterCondition(store.MemoFilterFieldTag, store.MemoFilterOperatorEqual, "work")),
+				store.NewMemoFilterCondition(store.MemoFilterFieldHasLink, store.MemoFilterOperatorEqual, true),
+			),
+		},
+		{
+			filter: `1700000000 < create_time && id in [1, 2] && (pinned == false || creator in ["users/2"])`,
+			condition: store.NewMemoFilterAnd(
+				store.NewMemoFilterCondition(store.MemoFilterFieldCreatedTs, store.MemoFilterOperatorGreater, int64(1700000000)),
+				store.NewMemoFilterCondition(store.MemoFilterFieldID, store.MemoFilterOperatorIn, int32(1), int32(2)),
+				store.NewMemoFilterOr(
+					store.NewMemoFilterCondition(store.MemoFilterFieldPinned, store.MemoFilterOperatorEqual, false),
+					store.NewMemoFilterCondition(store.MemoFilterFieldCreatorID, store.MemoFilterOperatorIn, int32(2)),
+				),
+			),
+		},
+		{
+			filter: `location_within_box(-10, 170, 10.5, -170) && !location_within_radius(48.85, 2.35, 1000)`,
+			condition: store.NewMemoFilterAnd(
+				store.NewMemoFilterCondition(store.MemoFilterFieldLocation, store.MemoFilterOperatorWithinBox, -10.0, 170.0, 10.5, -170.0),
+				store.NewMemoFilterNot(store.NewMemoFilterCondition(store.MemoFilterFieldLocation, store.MemoFilterOperatorWithinRadius, 48.85, 2.35, 1000.0)),
+			),
+		},
+	}
+	for _, test := range tests {
+		filter, err := parseMemoFilter(test.filter)
+		require.NoError(t, err, test.filter)
+		if test.directive != nil {
+			require.Equal(t, test.directive.Creator, filter.Creator)
+			require.Equal(t, test.directive.OrderByPinned, filter.OrderByPinned)
+		}
+		require.Equal(t, test.condition, filter.Condition, test.filter)
+	}
+
+	for _, filter := range []string{
+		`has_link || order_by_pinned == true`,
+		`tag_search < ["a"]`,
+		`visibility == "SECRET"`,
+		`update_time == create_time`,
+		`location_within_box(10, 0, 5, 1)`,
+		`location_within_radius(91, 0, 1000)`,
+		`location_within_radius(0, 0, 0)`,
+		`location_within_radius(0, 0, create_time)`,
+	} {
+		_, err := parseMemoFilter(filter)
+		require.Error(t, err, filter)
+	}
+}
@@ -0,0 +1,176 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestUpdateMemoWithEtag(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user, userCtx := createTestingUser(ctx, t, s, "test")
+	memo, err := s.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "etag",
+		CreatorID:  user.ID,
+		Content:    "content",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	resource, err := s.Store.CreateResource(ctx, &store.Resource{
+		UID:       "image",
+		CreatorID: user.ID,
+		Filename:  "image.png",
+		Blob:      []byte("image"),
+		Type:      "image/png",
+		Size:      5,
+	})
+	require.NoError(t, err)
+	name := fmt.Sprintf("%s%d", MemoNamePrefix, memo.ID)
+
+	// The resources are set along with the content under the check of the etag.
+	updated, err := s.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo: &v1pb.Memo{
+			Name:      name,
+			Content:   "updated",
+			Resources: []*v1pb.Resource{{Name: fmt.Sprintf("%s%d", ResourceNamePrefix, resource.ID), Uid: resource.UID}},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content", "resources"}},
+		Etag:       getMemoEtag(memo),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "updated", updated.Content)
+	require.Len(t, updated.Resources, 1)
+	require.True(t, updated.Property.HasImage)
+	require.NotEqual(t, getMemoEtag(memo), updated.Etag)
+
+	// A stale etag changes nothing.
+	_, err = s.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: name, Pinned: true, Resources: []*v1pb.Resource{}},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"pinned", "resources"}},
+		Etag:       getMemoEtag(memo),
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	current, err := s.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: name})
+	require.NoError(t, err)
+	require.False(t, current.Pinned)
+	require.Len(t, current.Resources, 1)
+	require.Equal(t, updated.Etag, current.Etag)
+}
+
+func TestCreateMemoWithRelations(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user, userCtx := createTestingUser(ctx, t, s, "test")
+	linked, err := s.Store.CreateMemo(ctx, &store.Memo{UID: "linked", CreatorID: user.ID, Content: "linked", Visibility: store.Private})
+	require.NoError(t, err)
+	referenced, err := s.Store.CreateMemo(ctx, &store.Memo{UID: "referenced", CreatorID: user.ID, Content: "referenced", Visibility: store.Private})
+	require.NoError(t, err)
+
+	// The references in the content are related along with the relations of the request.
+	memo, err := s.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Content:    "See [[referenced]]",
+		Visibility: v1pb.Visibility_PRIVATE,
+		Relations: []*v1pb.MemoRelation{{
+			RelatedMemo: &v1pb.MemoRelation_Memo{Name: fmt.Sprintf("%s%d", MemoNamePrefix, linked.ID)},
+			Type:        v1pb.MemoRelation_REFERENCE,
+		}},
+	})
+	require.NoError(t, err)
+	id, err := ExtractMemoIDFromName(memo.Name)
+	require.NoError(t, err)
+	relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{MemoID: &id})
+	require.NoError(t, err)
+	relatedMemoIDs := []int32{}
+	for _, relation := range relations {
+		relatedMemoIDs = append(relatedMemoIDs, relation.RelatedMemoID)
+	}
+	require.ElementsMatch(t, []int32{linked.ID, referenced.ID}, relatedMemoIDs)
+}
+
+func TestCheckMemoEtagByID(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user, userCtx := createTestingUser(ctx, t, s, "test")
+	_, otherCtx := createTestingUser(ctx, t, s, "other")
+	memo, err := s.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "private",
+		CreatorID:  user.ID,
+		Content:    "secret",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	name := fmt.Sprintf("%s%d", MemoNamePrefix, memo.ID)
+
+	// Another user cannot learn anything about the memo from a wrong etag.
+	_, err = s.SetMemoRelations(otherCtx, &v1pb.SetMemoRelationsRequest{Name: name, Etag: "42"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.Empty(t, status.Convert(err).Details())
+	_, err = s.SetMemoResources(otherCtx, &v1pb.SetMemoResourcesRequest{Name: name, Etag: "42"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// The creator gets the current memo with the error.
+	_, err = s.SetMemoRelations(userCtx, &v1pb.SetMemoRelationsRequest{Name: name, Etag: "42"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	details := status.Convert(err).Details()
+	require.Len(t, details, 1)
+	require.Equal(t, "secret", details[0].(*v1pb.Memo).Content)
+}
+
+func TestDeleteMemo(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user, userCtx := createTestingUser(ctx, t, s, "test")
+	activities := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		payload := &struct {
+			ActivityType string `json:"activityType"`
+		}{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(payload))
+		activities = append(activities, payload.ActivityType)
+		_, err := w.Write([]byte(`{"code":0}`))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+	_, err := s.Store.CreateWebhook(ctx, &store.Webhook{CreatorID: user.ID, Name: "test", URL: server.URL})
+	require.NoError(t, err)
+	memo, err := s.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "delete",
+		CreatorID:  user.ID,
+		Content:    "content #tag",
+		Visibility: store.Private,
+		Payload:    &storepb.MemoPayload{Tags: []string{"tag"}},
+	})
+	require.NoError(t, err)
+	name := fmt.Sprintf("%s%d", MemoNamePrefix, memo.ID)
+
+	// A stale delete neither moves the memo to the trash nor dispatches the webhook.
+	_, err = s.DeleteMemo(userCtx, &v1pb.DeleteMemoRequest{Name: name, Etag: "42"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.Empty(t, activities)
+	_, err = s.DeleteMemo(userCtx, &v1pb.DeleteMemoRequest{Name: name, Etag: getMemoEtag(memo)})
+	require.NoError(t, err)
+	require.Equal(t, []string{"memos.memo.deleted"}, activities)
+
+	// Deleting the memos of a tag moves them to the trash too.
+	_, err = s.RestoreMemo(userCtx, &v1pb.RestoreMemoRequest{Name: name})
+	require.NoError(t, err)
+	_, err = s.DeleteMemoTag(userCtx, &v1pb.DeleteMemoTagRequest{Parent: "memos/-", Tag: "tag", DeleteRelatedMemos: true})
+	require.NoError(t, err)
+	trashed, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &memo.ID, Trashed: true})
+	require.NoError(t, err)
+	require.NotNil(t, trashed)
+	require.NotNil(t, trashed.TrashedTs)
+}
@@ -0,0 +1,167 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/util"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// memoShareTokenLength is the length of the tokens of the share links, long enough not to be guessed.
+const memoShareTokenLength = 32
+
+func (s *APIV1Service) CreateMemoShareLink(ctx context.Context, request *v1pb.CreateMemoShareLinkRequest) (*v1pb.MemoShareLink, error) {
+	memoID, err := ExtractMemoIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	user, err := s.getMemoForShareAccess(ctx, memoID)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := util.RandomString(memoShareTokenLength)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate token: %v", err)
+	}
+	create := &store.MemoShare{
+		MemoID:    memoID,
+		CreatorID: user.ID,
+		Token:     token,
+	}
+	if request.ExpireTime != nil {
+		expireTime := request.ExpireTime.AsTime()
+		if !expireTime.After(time.Now()) {
+			return nil, status.Errorf(codes.InvalidArgument, "expire time must be in the future")
+		}
+		expiresTs := expireTime.Unix()
+		create.ExpiresTs = &expiresTs
+	}
+	if request.Password != "" {
+		passwordHash, err := bcrypt.GenerateFromPassword([]byte(request.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate password hash: %v", err)
+		}
+		create.PasswordHash = string(passwordHash)
+	}
+
+	share, err := s.Store.CreateMemoShare(ctx, create)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create memo share: %v", err)
+	}
+	return convertMemoShareLinkFromStore(share), nil
+}
+
+func (s *APIV1Service) ListMemoShareLinks(ctx context.Context, request *v1pb.ListMemoShareLinksRequest) (*v1pb.ListMemoShareLinksResponse, error) {
+	memoID, err := ExtractMemoIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	if _, err := s.getMemoForShareAccess(ctx, memoID); err != nil {
+		return nil, err
+	}
+
+	shares, err := s.Store.ListMemoShares(ctx, &store.FindMemoShare{MemoID: &memoID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo shares: %v", err)
+	}
+	response := &v1pb.ListMemoShareLinksResponse{}
+	for _, share := range shares {
+		response.ShareLinks = append(response.ShareLinks, convertMemoShareLinkFromStore(share))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) RevokeMemoShareLink(ctx context.Context, request *v1pb.RevokeMemoShareLinkRequest) (*emptypb.Empty, error) {
+	memoID, shareID, err := ExtractMemoShareLinkIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid share link name: %v", err)
+	}
+	if _, err := s.getMemoForShareAccess(ctx, memoID); err != nil {
+		return nil, err
+	}
+	share, err := s.Store.GetMemoShare(ctx, &store.FindMemoShare{
+		ID:     &shareID,
+		MemoID: &memoID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo share: %v", err)
+	}
+	if share == nil {
+		return nil, status.Errorf(codes.NotFound, "share link not found")
+	}
+
+	if err := s.Store.DeleteMemoShare(ctx, &store.DeleteMemoShare{ID: &share.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete memo share: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getMemoForShareAccess returns the current user if they are allowed to manage the share links of the memo.
+func (s *APIV1Service) getMemoForShareAccess(ctx context.Context, memoID int32) (*store.User, error) {
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoID, ExcludeContent: true})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	// Only the creator can share the memo.
+	if memo.CreatorID != user.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return user, nil
+}
+
+// isMemoSharedInContext returns whether the request was authenticated with a share link of the memo.
+func isMemoSharedInContext(ctx context.Context, memoID int32) bool {
+	share, ok := ctx.Value(memoShareContextKey).(*store.MemoShare)
+	return ok && share.MemoID == memoID
+}
+
+// validateMemoShare returns an error if the share link is expired or the password does not match.
+func validateMemoShare(share *store.MemoShare, password string, now time.Time) error {
+	if share.ExpiresTs != nil && now.Unix() >= *share.ExpiresTs {
+		return errors.New("share link expired")
+	}
+	if share.PasswordHash != "" {
+		if err := bcrypt.CompareHashAndPassword([]byte(share.PasswordHash), []byte(password)); err != nil {
+			return errors.New("incorrect share link password")
+		}
+	}
+	return nil
+}
+
+func convertMemoShareLinkFromStore(share *store.MemoShare) *v1pb.MemoShareLink {
+	shareLink := &v1pb.MemoShareLink{
+		Name:              fmt.Sprintf("%s%d/%s%d", MemoNamePrefix, share.MemoID, ShareLinkNamePrefix, share.ID),
+		Token:             share.Token,
+		CreateTime:        timestamppb.New(time.Unix(share.CreatedTs, 0)),
+		PasswordProtected: share.PasswordHash != "",
+		AccessCount:       share.AccessCount,
+	}
+	if share.ExpiresTs != nil {
+		shareLink.ExpireTime = timestamppb.New(time.Unix(*share.ExpiresTs, 0))
+	}
+	if share.LastAccessedTs != nil {
+		shareLink.LastAccessTime = timestamppb.New(time.Unix(*share.LastAccessedTs, 0))
+	}
+	return shareLink
+}
@@ -0,0 +1,48 @@
+package v1
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestValidateMemoShare(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	require.NoError(t, err)
+	expiredTs, futureTs := now.Unix(), now.Unix()+60
+
+	require.NoError(t, validateMemoShare(&store.MemoShare{}, "", now))
+	require.NoError(t, validateMemoShare(&store.MemoShare{ExpiresTs: &futureTs}, "", now))
+	require.Error(t, validateMemoShare(&store.MemoShare{ExpiresTs: &expiredTs}, "", now))
+	require.NoError(t, validateMemoShare(&store.MemoShare{PasswordHash: string(passwordHash)}, "secret", now))
+	require.Error(t, validateMemoShare(&store.MemoShare{PasswordHash: string(passwordHash)}, "", now))
+	require.Error(t, validateMemoShare(&store.MemoShare{PasswordHash: string(passwordHash)}, "wrong", now))
+}
+
+func TestGetMemoShareMetadata(t *testing.T) {
+	request := httptest.NewRequest("GET", "/api/v1/memos/1", nil)
+	request.Header.Set("X-Memo-Share-Token", "token")
+	request.Header.Set("X-Memo-Share-Password", "secret")
+	md := getMemoShareMetadata(request)
+	require.Equal(t, []string{"token"}, md.Get(MemoShareTokenMetadataKey))
+	require.Equal(t, []string{"secret"}, md.Get(MemoSharePasswordMetadataKey))
+
+	request = httptest.NewRequest("GET", "/file/resources/1/photo.png?share_token=token", nil)
+	md = getMemoShareMetadata(request)
+	require.Equal(t, []string{"token"}, md.Get(MemoShareTokenMetadataKey))
+	require.Empty(t, md.Get(MemoSharePasswordMetadataKey))
+
+	// The password is not taken from the query.
+	request = httptest.NewRequest("GET", "/file/resources/1/photo.png?share_token=token&share_password=secret", nil)
+	md = getMemoShareMetadata(request)
+	require.Equal(t, []string{"token"}, md.Get(MemoShareTokenMetadataKey))
+	require.Empty(t, md.Get(MemoSharePasswordMetadataKey))
+
+	require.Empty(t, getMemoShareMetadata(httptest.NewRequest("GET", "/api/v1/memos/1", nil)))
+}
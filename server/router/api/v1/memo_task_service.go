@@ -0,0 +1,116 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ListTasks(ctx context.Context, request *v1pb.ListTasksRequest) (*v1pb.ListTasksResponse, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	// Tasks are private to the user.
+	if user.ID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	normalStatus := store.Normal
+	memoFind := &store.FindMemo{
+		CreatorID:       &userID,
+		RowStatus:       &normalStatus,
+		ExcludeComments: true,
+		PayloadFind: &store.FindMemoPayload{
+			HasTaskList:        true,
+			HasIncompleteTasks: request.ExcludeCompleted,
+		},
+	}
+	memos, err := s.Store.ListMemos(ctx, memoFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+
+	response := &v1pb.ListTasksResponse{
+		Tasks: []*v1pb.Task{},
+	}
+	for _, memo := range memos {
+		for _, item := range memopayload.ListTaskItems(memo.Content) {
+			if request.ExcludeCompleted && item.Complete {
+				continue
+			}
+			response.Tasks = append(response.Tasks, convertTaskFromItem(memo, item))
+		}
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) SetTaskStatus(ctx context.Context, request *v1pb.SetTaskStatusRequest) (*v1pb.Memo, error) {
+	memoID, line, err := ExtractMemoTaskLineFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid task name: %v", err)
+	}
+	if err := s.checkMemoEtagByID(ctx, memoID, request.Etag); err != nil {
+		return nil, err
+	}
+	// Only the tasks of the memos the user can see are looked up.
+	memo, err := s.GetMemo(ctx, &v1pb.GetMemoRequest{
+		Name: fmt.Sprintf("%s%d", MemoNamePrefix, memoID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var item *memopayload.TaskItem
+	for _, taskItem := range memopayload.ListTaskItems(memo.Content) {
+		if taskItem.Line == line {
+			item = taskItem
+			break
+		}
+	}
+	if item == nil {
+		return nil, status.Errorf(codes.NotFound, "task not found")
+	}
+	if item.Complete == request.Complete {
+		return memo, nil
+	}
+	content, err := memopayload.SetTaskItemComplete(memo.Content, line, request.Complete)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set task status: %v", err)
+	}
+
+	// The memo is updated only if its content is still the one the task was found in.
+	return s.UpdateMemo(ctx, &v1pb.UpdateMemoRequest{
+		Memo: &v1pb.Memo{
+			Name:    memo.Name,
+			Content: content,
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+		Etag:       memo.Etag,
+	})
+}
+
+func convertTaskFromItem(memo *store.Memo, item *memopayload.TaskItem) *v1pb.Task {
+	memoName := fmt.Sprintf("%s%d", MemoNamePrefix, memo.ID)
+	return &v1pb.Task{
+		Name:     fmt.Sprintf("%s/%s%d", memoName, TaskNamePrefix, item.Line),
+		Memo:     memoName,
+		Line:     int32(item.Line),
+		Content:  item.Content,
+		Complete: item.Complete,
+		DueDate:  item.DueDate,
+	}
+}
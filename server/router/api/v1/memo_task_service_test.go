@@ -0,0 +1,31 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+func TestConvertTaskFromItem(t *testing.T) {
+	memo := &store.Memo{ID: 12, Content: "Plan\n- [x] Ship it @due(2024-06-30)"}
+	items := memopayload.ListTaskItems(memo.Content)
+	require.Equal(t, 1, len(items))
+
+	task := convertTaskFromItem(memo, items[0])
+	require.Equal(t, "memos/12/tasks/1", task.Name)
+	require.Equal(t, "memos/12", task.Memo)
+	require.Equal(t, int32(1), task.Line)
+	require.Equal(t, "Ship it", task.Content)
+	require.True(t, task.Complete)
+	require.Equal(t, "2024-06-30", task.DueDate)
+
+	memoID, line, err := ExtractMemoTaskLineFromName(task.Name)
+	require.NoError(t, err)
+	require.Equal(t, int32(12), memoID)
+	require.Equal(t, 1, line)
+	_, _, err = ExtractMemoTaskLineFromName("memos/12/tasks/-1")
+	require.Error(t, err)
+}
@@ -0,0 +1,149 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ListTrashedMemos(ctx context.Context, request *v1pb.ListTrashedMemosRequest) (*v1pb.ListTrashedMemosResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	var limit, offset int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		offset = int(pageToken.Offset)
+	} else {
+		limit = int(request.PageSize)
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	limitPlusOne := limit + 1
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID: &user.ID,
+		Trashed:   true,
+		Limit:     &limitPlusOne,
+		Offset:    &offset,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list trashed memos: %v", err)
+	}
+
+	memoMessages := []*v1pb.Memo{}
+	nextPageToken := ""
+	if len(memos) == limitPlusOne {
+		memos = memos[:limit]
+		nextPageToken, err = getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
+	for _, memo := range memos {
+		memoMessage, err := s.convertMemoFromStore(ctx, memo, v1pb.MemoView_MEMO_VIEW_FULL)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert memo")
+		}
+		memoMessages = append(memoMessages, memoMessage)
+	}
+
+	return &v1pb.ListTrashedMemosResponse{
+		Memos:         memoMessages,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *APIV1Service) RestoreMemo(ctx context.Context, request *v1pb.RestoreMemoRequest) (*v1pb.Memo, error) {
+	id, err := ExtractMemoIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+		ID:      &id,
+		Trashed: true,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found in trash")
+	}
+
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	// Only the creator or admin can restore the memo.
+	if memo.CreatorID != user.ID && !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if err := s.checkMemoEtag(ctx, memo, request.Etag); err != nil {
+		return nil, err
+	}
+
+	restored := int64(0)
+	update := &store.UpdateMemo{
+		ID:        id,
+		TrashedTs: &restored,
+	}
+	if request.Etag != "" {
+		update.ExpectedVersion = &memo.Version
+	}
+	if err := s.Store.UpdateMemo(ctx, update); err != nil {
+		if errors.Is(err, store.ErrMemoVersionMismatch) {
+			return nil, s.checkMemoEtagByID(ctx, id, request.Etag)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to restore memo")
+	}
+
+	memo, err = s.Store.GetMemo(ctx, &store.FindMemo{ID: &id})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get memo")
+	}
+	memoMessage, err := s.convertMemoFromStore(ctx, memo, v1pb.MemoView_MEMO_VIEW_FULL)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert memo")
+	}
+	return memoMessage, nil
+}
+
+func (s *APIV1Service) EmptyTrash(ctx context.Context, _ *v1pb.EmptyTrashRequest) (*emptypb.Empty, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:      &user.ID,
+		Trashed:        true,
+		ExcludeContent: true,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list trashed memos: %v", err)
+	}
+	for _, memo := range memos {
+		if err := s.Store.PurgeMemo(ctx, memo.ID); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to delete memo: %v", err)
+		}
+	}
+	return &emptypb.Empty{}, nil
+}
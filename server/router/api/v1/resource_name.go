@@ -2,6 +2,7 @@ package v1
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -13,11 +14,19 @@ const (
 	WorkspaceSettingNamePrefix = "settings/"
 	UserNamePrefix             = "users/"
 	MemoNamePrefix             = "memos/"
+	MemoRevisionNamePrefix     = "revisions/"
 	ResourceNamePrefix         = "resources/"
 	InboxNamePrefix            = "inboxes/"
 	StorageNamePrefix          = "storages/"
 	IdentityProviderNamePrefix = "identityProviders/"
 	ActivityNamePrefix         = "activities/"
+	JobNamePrefix              = "jobs/"
+	JobRunNamePrefix           = "runs/"
+	TagNamePrefix              = "tags/"
+	ShareLinkNamePrefix        = "shareLinks/"
+	ShortcutNamePrefix         = "shortcuts/"
+	MemoRuleNamePrefix         = "memoRules/"
+	TaskNamePrefix             = "tasks/"
 )
 
 // GetNameParentTokens returns the tokens from a resource name.
@@ -74,6 +83,83 @@ func ExtractMemoIDFromName(name string) (int32, error) {
 	return id, nil
 }
 
+// ExtractMemoRevisionIDFromName returns the memo ID and revision ID from a memo revision name.
+func ExtractMemoRevisionIDFromName(name string) (int32, int32, error) {
+	tokens, err := GetNameParentTokens(name, MemoNamePrefix, MemoRevisionNamePrefix)
+	if err != nil {
+		return 0, 0, err
+	}
+	memoID, err := util.ConvertStringToInt32(tokens[0])
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid memo ID %q", tokens[0])
+	}
+	revisionID, err := util.ConvertStringToInt32(tokens[1])
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid revision ID %q", tokens[1])
+	}
+	return memoID, revisionID, nil
+}
+
+// ExtractMemoShareLinkIDFromName returns the memo ID and share link ID from a share link name.
+func ExtractMemoShareLinkIDFromName(name string) (int32, int32, error) {
+	tokens, err := GetNameParentTokens(name, MemoNamePrefix, ShareLinkNamePrefix)
+	if err != nil {
+		return 0, 0, err
+	}
+	memoID, err := util.ConvertStringToInt32(tokens[0])
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid memo ID %q", tokens[0])
+	}
+	shareID, err := util.ConvertStringToInt32(tokens[1])
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid share link ID %q", tokens[1])
+	}
+	return memoID, shareID, nil
+}
+
+// ExtractMemoTaskLineFromName returns the memo ID and line of the task from a task name.
+func ExtractMemoTaskLineFromName(name string) (int32, int, error) {
+	tokens, err := GetNameParentTokens(name, MemoNamePrefix, TaskNamePrefix)
+	if err != nil {
+		return 0, 0, err
+	}
+	memoID, err := util.ConvertStringToInt32(tokens[0])
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid memo ID %q", tokens[0])
+	}
+	line, err := strconv.Atoi(tokens[1])
+	if err != nil || line < 0 {
+		return 0, 0, errors.Errorf("invalid task line %q", tokens[1])
+	}
+	return memoID, line, nil
+}
+
+// ExtractShortcutIDFromName returns the user ID and shortcut ID from a shortcut name.
+func ExtractShortcutIDFromName(name string) (int32, string, error) {
+	tokens, err := GetNameParentTokens(name, UserNamePrefix, ShortcutNamePrefix)
+	if err != nil {
+		return 0, "", err
+	}
+	userID, err := util.ConvertStringToInt32(tokens[0])
+	if err != nil {
+		return 0, "", errors.Errorf("invalid user ID %q", tokens[0])
+	}
+	return userID, tokens[1], nil
+}
+
+// ExtractMemoRuleIDFromName returns the user ID and memo rule ID from a memo rule name.
+func ExtractMemoRuleIDFromName(name string) (int32, string, error) {
+	tokens, err := GetNameParentTokens(name, UserNamePrefix, MemoRuleNamePrefix)
+	if err != nil {
+		return 0, "", err
+	}
+	userID, err := util.ConvertStringToInt32(tokens[0])
+	if err != nil {
+		return 0, "", errors.Errorf("invalid user ID %q", tokens[0])
+	}
+	return userID, tokens[1], nil
+}
+
 // ExtractResourceIDFromName returns the resource ID from a resource name.
 func ExtractResourceIDFromName(name string) (int32, error) {
 	tokens, err := GetNameParentTokens(name, ResourceNamePrefix)
@@ -136,3 +222,12 @@ func ExtractActivityIDFromName(name string) (int32, error) {
 	}
 	return id, nil
 }
+
+// ExtractJobNameFromName returns the job name from a resource name.
+func ExtractJobNameFromName(name string) (string, error) {
+	tokens, err := GetNameParentTokens(name, JobNamePrefix)
+	if err != nil {
+		return "", err
+	}
+	return tokens[0], nil
+}
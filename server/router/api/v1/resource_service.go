@@ -90,19 +90,45 @@ func (s *APIV1Service) CreateResource(ctx context.Context, request *v1pb.CreateR
 	return s.convertResourceFromStore(ctx, resource), nil
 }
 
-func (s *APIV1Service) ListResources(ctx context.Context, _ *v1pb.ListResourcesRequest) (*v1pb.ListResourcesResponse, error) {
+func (s *APIV1Service) ListResources(ctx context.Context, request *v1pb.ListResourcesRequest) (*v1pb.ListResourcesResponse, error) {
 	user, err := s.GetCurrentUser(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
 	}
-	resources, err := s.Store.ListResources(ctx, &store.FindResource{
+	resourceFind := &store.FindResource{
 		CreatorID: &user.ID,
-	})
+	}
+	// All the resources are listed unless a page is requested.
+	limit := int(request.PageSize)
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		resourceFind.Cursor = convertPageCursorToStore(pageToken.Cursor)
+	}
+	if limit > 0 {
+		limitPlusOne := limit + 1
+		resourceFind.Limit = &limitPlusOne
+	}
+	resources, err := s.Store.ListResources(ctx, resourceFind)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list resources: %v", err)
 	}
 
 	response := &v1pb.ListResourcesResponse{}
+	if limit > 0 && len(resources) == limit+1 {
+		resources = resources[:limit]
+		lastResource := resources[limit-1]
+		response.NextPageToken, err = getCursorPageToken(limit, &store.Cursor{
+			Ts: lastResource.UpdatedTs,
+			ID: lastResource.ID,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
 	for _, resource := range resources {
 		response.Resources = append(response.Resources, s.convertResourceFromStore(ctx, resource))
 	}
@@ -166,7 +192,27 @@ func (s *APIV1Service) GetResourceBinary(ctx context.Context, request *v1pb.GetR
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to find memo by ID: %v", resource.MemoID)
 		}
-		if memo != nil && memo.Visibility != store.Public {
+		if memo == nil {
+			// Only the creator can access the resources of a memo in trash.
+			trashedMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+				ID:      resource.MemoID,
+				Trashed: true,
+			})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to find memo by ID: %v", resource.MemoID)
+			}
+			if trashedMemo == nil {
+				return nil, status.Errorf(codes.NotFound, "memo not found")
+			}
+			user, err := s.GetCurrentUser(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+			}
+			if user == nil || user.ID != trashedMemo.CreatorID {
+				return nil, status.Errorf(codes.Unauthenticated, "unauthorized access")
+			}
+		}
+		if memo != nil && memo.Visibility != store.Public && !isMemoSharedInContext(ctx, memo.ID) {
 			user, err := s.GetCurrentUser(ctx)
 			if err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
@@ -175,13 +221,19 @@ func (s *APIV1Service) GetResourceBinary(ctx context.Context, request *v1pb.GetR
 				return nil, status.Errorf(codes.Unauthenticated, "unauthorized access")
 			}
 			if memo.Visibility == store.Private && user.ID != resource.CreatorID {
-				return nil, status.Errorf(codes.Unauthenticated, "unauthorized access")
+				isCollaborator, err := s.hasMemoCollaboratorRole(ctx, memo.ID, user.ID, store.MemoCollaboratorViewer)
+				if err != nil {
+					return nil, status.Errorf(codes.Internal, "failed to get memo collaborator: %v", err)
+				}
+				if !isCollaborator {
+					return nil, status.Errorf(codes.Unauthenticated, "unauthorized access")
+				}
 			}
 		}
 	}
 
 	if request.Thumbnail && util.HasPrefixes(resource.Type, SupportedThumbnailMimeTypes...) {
-		thumbnailBlob, err := s.getOrGenerateThumbnail(resource)
+		thumbnailBlob, err := s.getOrGenerateThumbnail(ctx, resource)
 		if err != nil {
 			// thumbnail failures are logged as warnings and not cosidered critical failures as
 			// a resource image can be used in its place.
@@ -194,7 +246,7 @@ func (s *APIV1Service) GetResourceBinary(ctx context.Context, request *v1pb.GetR
 		}
 	}
 
-	blob, err := s.GetResourceBlob(resource)
+	blob, err := s.GetResourceBlob(ctx, resource)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get resource blob: %v", err)
 	}
@@ -383,9 +435,36 @@ func SaveResourceBlob(ctx context.Context, s *store.Store, create *store.Resourc
 	return nil
 }
 
-func (s *APIV1Service) GetResourceBlob(resource *store.Resource) ([]byte, error) {
+// GetResourceBlob returns the blob of the resource from the database, local storage or S3.
+// The resource must be found with GetBlob for the blobs stored in the database.
+func (s *APIV1Service) GetResourceBlob(ctx context.Context, resource *store.Resource) ([]byte, error) {
 	blob := resource.Blob
-	if resource.StorageType == storepb.ResourceStorageType_LOCAL {
+	if resource.StorageType == storepb.ResourceStorageType_S3 {
+		s3ObjectPayload := resource.Payload.GetS3Object()
+		if s3ObjectPayload == nil {
+			return nil, errors.New("s3 object payload not found")
+		}
+		// The resources uploaded with the workspace storage setting do not keep their own S3 config.
+		s3Config := s3ObjectPayload.S3Config
+		if s3Config == nil {
+			workspaceStorageSetting, err := s.Store.GetWorkspaceStorageSetting(ctx)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get workspace storage setting")
+			}
+			if workspaceStorageSetting.S3Config == nil {
+				return nil, errors.New("s3 config not found")
+			}
+			s3Config = workspaceStorageSetting.S3Config
+		}
+		s3Client, err := s3.NewClient(ctx, s3Config)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create s3 client")
+		}
+		blob, err = s3Client.GetObject(ctx, s3ObjectPayload.Key)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get s3 object")
+		}
+	} else if resource.StorageType == storepb.ResourceStorageType_LOCAL {
 		resourcePath := filepath.FromSlash(resource.Reference)
 		if !filepath.IsAbs(resourcePath) {
 			resourcePath = filepath.Join(s.Profile.Data, resourcePath)
@@ -413,7 +492,7 @@ const (
 )
 
 // getOrGenerateThumbnail returns the thumbnail image of the resource.
-func (s *APIV1Service) getOrGenerateThumbnail(resource *store.Resource) ([]byte, error) {
+func (s *APIV1Service) getOrGenerateThumbnail(ctx context.Context, resource *store.Resource) ([]byte, error) {
 	thumbnailCacheFolder := filepath.Join(s.Profile.Data, ThumbnailCacheFolder)
 	if err := os.MkdirAll(thumbnailCacheFolder, os.ModePerm); err != nil {
 		return nil, errors.Wrap(err, "failed to create thumbnail cache folder")
@@ -425,7 +504,7 @@ func (s *APIV1Service) getOrGenerateThumbnail(resource *store.Resource) ([]byte,
 		}
 
 		// If thumbnail image does not exist, generate and save the thumbnail image.
-		blob, err := s.GetResourceBlob(resource)
+		blob, err := s.GetResourceBlob(ctx, resource)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to get resource blob")
 		}
@@ -0,0 +1,63 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestGetResourceBinaryOfTrashedMemo(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user, userCtx := createTestingUser(ctx, t, s, "alice")
+	_, otherCtx := createTestingUser(ctx, t, s, "bob")
+
+	memo, err := s.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "trashed-private",
+		CreatorID:  user.ID,
+		Content:    "A private memo with an attachment",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	resource, err := s.Store.CreateResource(ctx, &store.Resource{
+		UID:       "attachment",
+		CreatorID: user.ID,
+		Filename:  "secret.txt",
+		Blob:      []byte("secret"),
+		Type:      "text/plain",
+		Size:      6,
+		MemoID:    &memo.ID,
+	})
+	require.NoError(t, err)
+	trashedTs := time.Now().Unix()
+	require.NoError(t, s.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, TrashedTs: &trashedTs}))
+
+	request := &v1pb.GetResourceBinaryRequest{Name: fmt.Sprintf("%s%d", ResourceNamePrefix, resource.ID)}
+	_, err = s.GetResourceBinary(ctx, request)
+	require.Error(t, err)
+	_, err = s.GetResourceBinary(otherCtx, request)
+	require.Error(t, err)
+	body, err := s.GetResourceBinary(userCtx, request)
+	require.NoError(t, err)
+	require.Equal(t, []byte("secret"), body.Data)
+}
+
+func TestGetResourceBlobWithoutS3Config(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	// The resource uses the workspace storage setting, which has no S3 config.
+	_, err := s.GetResourceBlob(ctx, &store.Resource{
+		StorageType: storepb.ResourceStorageType_S3,
+		Payload: &storepb.ResourcePayload{
+			Payload: &storepb.ResourcePayload_S3Object_{S3Object: &storepb.ResourcePayload_S3Object{Key: "key"}},
+		},
+	})
+	require.ErrorContains(t, err, "s3 config not found")
+}
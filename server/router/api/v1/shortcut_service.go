@@ -0,0 +1,260 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/lithammer/shortuuid/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func (s *APIV1Service) ListShortcuts(ctx context.Context, request *v1pb.ListShortcutsRequest) (*v1pb.ListShortcutsResponse, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	shortcuts, err := s.getUserShortcuts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return convertShortcutsFromStore(userID, shortcuts), nil
+}
+
+func (s *APIV1Service) CreateShortcut(ctx context.Context, request *v1pb.CreateShortcutRequest) (*v1pb.Shortcut, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	if request.Shortcut == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "shortcut is required")
+	}
+	shortcuts, err := s.getUserShortcuts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	shortcut := &storepb.ShortcutsUserSetting_Shortcut{
+		Id:     shortuuid.New(),
+		Title:  strings.TrimSpace(request.Shortcut.Title),
+		Filter: request.Shortcut.Filter,
+	}
+	if err := validateShortcut(shortcut); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid shortcut: %v", err)
+	}
+	if err := s.upsertUserShortcuts(ctx, userID, append(slices.Clone(shortcuts), shortcut)); err != nil {
+		return nil, err
+	}
+	return convertShortcutFromStore(userID, shortcut), nil
+}
+
+func (s *APIV1Service) UpdateShortcut(ctx context.Context, request *v1pb.UpdateShortcutRequest) (*v1pb.Shortcut, error) {
+	if request.Shortcut == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "shortcut is required")
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+	userID, shortcutID, err := ExtractShortcutIDFromName(request.Shortcut.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid shortcut name: %v", err)
+	}
+	shortcuts, err := s.getUserShortcuts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	index := slices.IndexFunc(shortcuts, func(shortcut *storepb.ShortcutsUserSetting_Shortcut) bool {
+		return shortcut.Id == shortcutID
+	})
+	if index < 0 {
+		return nil, status.Errorf(codes.NotFound, "shortcut not found")
+	}
+
+	shortcut := &storepb.ShortcutsUserSetting_Shortcut{
+		Id:     shortcuts[index].Id,
+		Title:  shortcuts[index].Title,
+		Filter: shortcuts[index].Filter,
+	}
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "title":
+			shortcut.Title = strings.TrimSpace(request.Shortcut.Title)
+		case "filter":
+			shortcut.Filter = request.Shortcut.Filter
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid update path: %s", path)
+		}
+	}
+	if err := validateShortcut(shortcut); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid shortcut: %v", err)
+	}
+	shortcuts = slices.Clone(shortcuts)
+	shortcuts[index] = shortcut
+	if err := s.upsertUserShortcuts(ctx, userID, shortcuts); err != nil {
+		return nil, err
+	}
+	return convertShortcutFromStore(userID, shortcut), nil
+}
+
+func (s *APIV1Service) DeleteShortcut(ctx context.Context, request *v1pb.DeleteShortcutRequest) (*emptypb.Empty, error) {
+	userID, shortcutID, err := ExtractShortcutIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid shortcut name: %v", err)
+	}
+	shortcuts, err := s.getUserShortcuts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	index := slices.IndexFunc(shortcuts, func(shortcut *storepb.ShortcutsUserSetting_Shortcut) bool {
+		return shortcut.Id == shortcutID
+	})
+	if index < 0 {
+		return nil, status.Errorf(codes.NotFound, "shortcut not found")
+	}
+
+	if err := s.upsertUserShortcuts(ctx, userID, slices.Delete(slices.Clone(shortcuts), index, index+1)); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) ReorderShortcuts(ctx context.Context, request *v1pb.ReorderShortcutsRequest) (*v1pb.ListShortcutsResponse, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	shortcuts, err := s.getUserShortcuts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	// The new order must name every shortcut of the user exactly once.
+	if len(request.Names) != len(shortcuts) {
+		return nil, status.Errorf(codes.InvalidArgument, "expected %d shortcut names, but got %d", len(shortcuts), len(request.Names))
+	}
+
+	reordered := []*storepb.ShortcutsUserSetting_Shortcut{}
+	for _, name := range request.Names {
+		shortcutUserID, shortcutID, err := ExtractShortcutIDFromName(name)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid shortcut name: %v", err)
+		}
+		index := slices.IndexFunc(shortcuts, func(shortcut *storepb.ShortcutsUserSetting_Shortcut) bool {
+			return shortcut.Id == shortcutID
+		})
+		if shortcutUserID != userID || index < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "shortcut %q not found", name)
+		}
+		if slices.Contains(reordered, shortcuts[index]) {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate shortcut %q", name)
+		}
+		reordered = append(reordered, shortcuts[index])
+	}
+	if err := s.upsertUserShortcuts(ctx, userID, reordered); err != nil {
+		return nil, err
+	}
+	return convertShortcutsFromStore(userID, reordered), nil
+}
+
+// getUserShortcuts returns the shortcuts of the user if they are the current user.
+// The returned slice is shared with the store cache and must not be modified.
+func (s *APIV1Service) getUserShortcuts(ctx context.Context, userID int32) ([]*storepb.ShortcutsUserSetting_Shortcut, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	// Shortcuts are private to the user.
+	if user.ID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	shortcuts, err := s.Store.GetUserShortcuts(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user shortcuts: %v", err)
+	}
+	return shortcuts, nil
+}
+
+func (s *APIV1Service) upsertUserShortcuts(ctx context.Context, userID int32, shortcuts []*storepb.ShortcutsUserSetting_Shortcut) error {
+	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSettingKey_SHORTCUTS,
+		Value: &storepb.UserSetting_Shortcuts{
+			Shortcuts: &storepb.ShortcutsUserSetting{
+				Shortcuts: shortcuts,
+			},
+		},
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+	}
+	return nil
+}
+
+// getShortcutFilter returns the filter of the shortcut of the current user with the name.
+func (s *APIV1Service) getShortcutFilter(ctx context.Context, name string) (string, error) {
+	userID, shortcutID, err := ExtractShortcutIDFromName(name)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "invalid shortcut name: %v", err)
+	}
+	shortcuts, err := s.getUserShortcuts(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	for _, shortcut := range shortcuts {
+		if shortcut.Id == shortcutID {
+			return shortcut.Filter, nil
+		}
+	}
+	return "", status.Errorf(codes.NotFound, "shortcut not found")
+}
+
+// validateShortcut returns an error if the shortcut has no title or its filter is not a valid memo filter.
+func validateShortcut(shortcut *storepb.ShortcutsUserSetting_Shortcut) error {
+	if shortcut.Title == "" {
+		return errors.New("title is required")
+	}
+	if strings.TrimSpace(shortcut.Filter) == "" {
+		return errors.New("filter is required")
+	}
+	if _, err := parseMemoFilter(shortcut.Filter); err != nil {
+		return errors.Wrap(err, "invalid filter")
+	}
+	return nil
+}
+
+// joinMemoFilters returns the conjunction of the filters, skipping the empty ones.
+func joinMemoFilters(filters ...string) string {
+	conjuncts := []string{}
+	for _, filter := range filters {
+		if strings.TrimSpace(filter) != "" {
+			conjuncts = append(conjuncts, fmt.Sprintf("(%s)", filter))
+		}
+	}
+	return strings.Join(conjuncts, " && ")
+}
+
+func convertShortcutsFromStore(userID int32, shortcuts []*storepb.ShortcutsUserSetting_Shortcut) *v1pb.ListShortcutsResponse {
+	response := &v1pb.ListShortcutsResponse{
+		Shortcuts: []*v1pb.Shortcut{},
+	}
+	for _, shortcut := range shortcuts {
+		response.Shortcuts = append(response.Shortcuts, convertShortcutFromStore(userID, shortcut))
+	}
+	return response
+}
+
+func convertShortcutFromStore(userID int32, shortcut *storepb.ShortcutsUserSetting_Shortcut) *v1pb.Shortcut {
+	return &v1pb.Shortcut{
+		Name:   fmt.Sprintf("%s%d/%s%s", UserNamePrefix, userID, ShortcutNamePrefix, shortcut.Id),
+		Title:  shortcut.Title,
+		Filter: shortcut.Filter,
+	}
+}
@@ -0,0 +1,57 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestValidateShortcut(t *testing.T) {
+	tests := []struct {
+		shortcut *storepb.ShortcutsUserSetting_Shortcut
+		valid    bool
+	}{
+		{
+			shortcut: &storepb.ShortcutsUserSetting_Shortcut{Title: "Work", Filter: `tag_search == ["work"] && has_incomplete_tasks`},
+			valid:    true,
+		},
+		{
+			shortcut: &storepb.ShortcutsUserSetting_Shortcut{Title: "", Filter: `has_link`},
+			valid:    false,
+		},
+		{
+			shortcut: &storepb.ShortcutsUserSetting_Shortcut{Title: "Empty", Filter: " "},
+			valid:    false,
+		},
+		{
+			shortcut: &storepb.ShortcutsUserSetting_Shortcut{Title: "Unknown", Filter: `unknown_field == true`},
+			valid:    false,
+		},
+		{
+			shortcut: &storepb.ShortcutsUserSetting_Shortcut{Title: "Nested directive", Filter: `has_link || random == true`},
+			valid:    false,
+		},
+	}
+	for _, test := range tests {
+		err := validateShortcut(test.shortcut)
+		if test.valid {
+			require.NoError(t, err, test.shortcut.Filter)
+		} else {
+			require.Error(t, err, test.shortcut.Filter)
+		}
+	}
+}
+
+func TestJoinMemoFilters(t *testing.T) {
+	require.Equal(t, "", joinMemoFilters("", " "))
+	require.Equal(t, "(has_link)", joinMemoFilters("has_link", ""))
+	require.Equal(t, `(has_link || pinned) && (tag_search == ["work"])`, joinMemoFilters("has_link || pinned", `tag_search == ["work"]`))
+
+	// The directives of both filters still apply to the joined filter.
+	filter, err := parseMemoFilter(joinMemoFilters(`has_link && order_by_pinned == true`, `content_search == ["go"]`))
+	require.NoError(t, err)
+	require.True(t, filter.OrderByPinned)
+	require.Equal(t, []string{"go"}, filter.ContentSearch)
+}
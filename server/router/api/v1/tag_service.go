@@ -4,6 +4,8 @@ import (
 	"context"
 	"crypto/sha256"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc/codes"
@@ -72,6 +74,95 @@ func (s *APIV1Service) ListTagsWithEmoji(ctx context.Context, _ *v1pb.ListTagsWi
 	return response, nil
 }
 
+// ListTagTree lists the tags of the memos of the current user as a tree of their path segments.
+func (s *APIV1Service) ListTagTree(ctx context.Context, _ *v1pb.ListTagTreeRequest) (*v1pb.ListTagTreeResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not found")
+	}
+
+	memoTags, err := s.Store.ListMemoTags(ctx, &store.FindMemoStats{CreatorID: user.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo tags: %v", err)
+	}
+	tags, err := s.Store.ListTags(ctx, &store.FindTag{CreatorID: &user.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list tags: %v", err)
+	}
+	return &v1pb.ListTagTreeResponse{
+		Nodes: buildTagTree(memoTags, tags),
+	}, nil
+}
+
+// buildTagTree returns the top-level nodes of the tree of the tags of the memos, with the emoji and
+// pinned time of the tags with metadata. Each memo is counted once by a node however many of its
+// tags are under the node.
+func buildTagTree(memoTags []*store.MemoTag, tags []*store.Tag) []*v1pb.TagTreeNode {
+	roots := []*v1pb.TagTreeNode{}
+	nodes := map[string]*v1pb.TagTreeNode{}
+	lastUsedTs := map[string]int64{}
+	countedTags := map[int32]map[string]bool{}
+	for _, memoTag := range memoTags {
+		segments := strings.FieldsFunc(memoTag.Tag, func(r rune) bool { return r == '/' })
+		if countedTags[memoTag.MemoID] == nil {
+			countedTags[memoTag.MemoID] = map[string]bool{}
+		}
+		var parent *v1pb.TagTreeNode
+		for i, segment := range segments {
+			tag := strings.Join(segments[:i+1], "/")
+			node, ok := nodes[tag]
+			if !ok {
+				node = &v1pb.TagTreeNode{
+					Name:     segment,
+					Tag:      tag,
+					Children: []*v1pb.TagTreeNode{},
+				}
+				nodes[tag] = node
+				if parent == nil {
+					roots = append(roots, node)
+				} else {
+					parent.Children = append(parent.Children, node)
+				}
+			}
+			if !countedTags[memoTag.MemoID][tag] {
+				countedTags[memoTag.MemoID][tag] = true
+				node.MemoCount++
+				lastUsedTs[tag] = max(lastUsedTs[tag], memoTag.UpdatedTs)
+			}
+			parent = node
+		}
+		if parent != nil {
+			parent.DirectMemoCount++
+		}
+	}
+
+	for tag, node := range nodes {
+		node.LastUsedTime = timestamppb.New(time.Unix(lastUsedTs[tag], 0))
+	}
+	for _, tag := range tags {
+		if node, ok := nodes[tag.TagName]; ok {
+			node.Emoji = tag.Emoji
+			if tag.PinnedTs != nil {
+				node.PinnedTime = timestamppb.New(time.Unix(*tag.PinnedTs, 0))
+			}
+		}
+	}
+	sortTagTreeNodes(roots)
+	return roots
+}
+
+func sortTagTreeNodes(nodes []*v1pb.TagTreeNode) {
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Name < nodes[j].Name
+	})
+	for _, node := range nodes {
+		sortTagTreeNodes(node.Children)
+	}
+}
+
 // UpdateTag updates tag metadata (emoji, pinned status, etc.).
 func (s *APIV1Service) UpdateTag(ctx context.Context, request *v1pb.UpdateTagRequest) (*v1pb.Tag, error) {
 	user, err := s.GetCurrentUser(ctx)
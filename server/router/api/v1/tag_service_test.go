@@ -0,0 +1,59 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestBuildTagTree(t *testing.T) {
+	pinnedTs := int64(500)
+	memoTags := []*store.MemoTag{
+		{MemoID: 1, Tag: "work/projects/alpha", UpdatedTs: 100},
+		{MemoID: 1, Tag: "work/projects/beta", UpdatedTs: 100},
+		{MemoID: 2, Tag: "work", UpdatedTs: 300},
+		{MemoID: 3, Tag: "go", UpdatedTs: 200},
+		{MemoID: 3, Tag: "work/projects/alpha", UpdatedTs: 200},
+	}
+	tags := []*store.Tag{
+		{TagName: "work/projects", Emoji: "🚧", PinnedTs: &pinnedTs},
+		{TagName: "unused", Emoji: "🙈"},
+	}
+
+	roots := buildTagTree(memoTags, tags)
+	require.Equal(t, 2, len(roots))
+	require.Equal(t, "go", roots[0].Name)
+	require.Equal(t, int32(1), roots[0].DirectMemoCount)
+	require.Equal(t, int64(200), roots[0].LastUsedTime.AsTime().Unix())
+
+	work := roots[1]
+	require.Equal(t, "work", work.Tag)
+	require.Equal(t, int32(1), work.DirectMemoCount)
+	require.Equal(t, int32(3), work.MemoCount)
+	require.Equal(t, int64(300), work.LastUsedTime.AsTime().Unix())
+	require.Equal(t, 1, len(work.Children))
+
+	// The memo with both projects is counted once by their parent.
+	projects := work.Children[0]
+	require.Equal(t, "projects", projects.Name)
+	require.Equal(t, "work/projects", projects.Tag)
+	require.Equal(t, int32(0), projects.DirectMemoCount)
+	require.Equal(t, int32(2), projects.MemoCount)
+	require.Equal(t, int64(200), projects.LastUsedTime.AsTime().Unix())
+	require.Equal(t, "🚧", projects.Emoji)
+	require.Equal(t, pinnedTs, projects.PinnedTime.AsTime().Unix())
+
+	names := []string{}
+	for _, child := range projects.Children {
+		names = append(names, child.Name)
+	}
+	require.Equal(t, []string{"alpha", "beta"}, names)
+	require.Equal(t, int32(2), projects.Children[0].DirectMemoCount)
+	require.Equal(t, int32(2), projects.Children[0].MemoCount)
+	require.Nil(t, projects.Children[1].PinnedTime)
+
+	require.Equal(t, []*v1pb.TagTreeNode{}, buildTagTree(nil, tags))
+}
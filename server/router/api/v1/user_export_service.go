@@ -0,0 +1,207 @@
+package v1
+
+import (
+	"archive/zip"
+	"bufio"
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// exportChunkSize is the size of the chunks of the streamed archive.
+const exportChunkSize = 64 * 1024
+
+func (s *APIV1Service) ExportUserData(request *v1pb.ExportUserDataRequest, stream v1pb.UserService_ExportUserDataServer) error {
+	ctx := stream.Context()
+	userID, err := ExtractUserIDFromName(request.Name)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	// Only the user or admin can export the data of the user.
+	if currentUser.ID != userID && !isSuperUser(currentUser) {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return status.Errorf(codes.NotFound, "user not found")
+	}
+
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:      &user.ID,
+		OrderByTimeAsc: true,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+	memoUIDs := map[int32]string{}
+	for _, memo := range memos {
+		memoUIDs[memo.ID] = memo.UID
+	}
+
+	writer := bufio.NewWriterSize(&httpBodyStreamWriter{stream: stream, contentType: "application/zip"}, exportChunkSize)
+	archive := zip.NewWriter(writer)
+	for _, memo := range memos {
+		if err := s.exportMemo(ctx, archive, memo, memoUIDs); err != nil {
+			return status.Errorf(codes.Internal, "failed to export memo %s: %v", memo.UID, err)
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return status.Errorf(codes.Internal, "failed to close archive: %v", err)
+	}
+	if err := writer.Flush(); err != nil {
+		return status.Errorf(codes.Internal, "failed to send archive: %v", err)
+	}
+	return nil
+}
+
+// exportMemo writes the Markdown file and the resource blobs of the memo to the archive.
+// memoUIDs maps the IDs of the exported memos to their UIDs.
+func (s *APIV1Service) exportMemo(ctx context.Context, archive *zip.Writer, memo *store.Memo, memoUIDs map[int32]string) error {
+	frontMatter := &memoArchiveFrontMatter{
+		UID:        memo.UID,
+		Visibility: memo.Visibility.String(),
+		RowStatus:  string(memo.RowStatus),
+		Pinned:     memo.Pinned,
+		Tags:       memo.Payload.GetTags(),
+		CreateTime: time.Unix(memo.CreatedTs, 0).UTC(),
+		UpdateTime: time.Unix(memo.UpdatedTs, 0).UTC(),
+	}
+	if location := memo.Payload.GetLocation(); location != nil {
+		frontMatter.Location = &memoArchiveLocation{
+			Placeholder: location.Placeholder,
+			Latitude:    location.Latitude,
+			Longitude:   location.Longitude,
+		}
+	}
+
+	relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{MemoID: &memo.ID})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memo relations")
+	}
+	for _, relation := range relations {
+		relatedMemoUID, ok := memoUIDs[relation.RelatedMemoID]
+		if !ok {
+			relatedMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &relation.RelatedMemoID, ExcludeContent: true})
+			if err != nil {
+				return errors.Wrap(err, "failed to get related memo")
+			}
+			if relatedMemo == nil {
+				continue
+			}
+			relatedMemoUID = relatedMemo.UID
+		}
+		frontMatter.Relations = append(frontMatter.Relations, &memoArchiveRelation{
+			Type: string(relation.Type),
+			Memo: relatedMemoUID,
+		})
+	}
+
+	contentID := fmt.Sprintf("%s%d", MemoNamePrefix, memo.ID)
+	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{ContentID: &contentID})
+	if err != nil {
+		return errors.Wrap(err, "failed to list reactions")
+	}
+	for _, reaction := range reactions {
+		creator, err := s.Store.GetUser(ctx, &store.FindUser{ID: &reaction.CreatorID})
+		if err != nil {
+			return errors.Wrap(err, "failed to get reaction creator")
+		}
+		if creator == nil {
+			continue
+		}
+		frontMatter.Reactions = append(frontMatter.Reactions, &memoArchiveReaction{
+			Reaction: reaction.ReactionType,
+			Creator:  creator.Username,
+		})
+	}
+
+	resources, err := s.Store.ListResources(ctx, &store.FindResource{MemoID: &memo.ID})
+	if err != nil {
+		return errors.Wrap(err, "failed to list resources")
+	}
+	for _, resource := range resources {
+		archiveResource := &memoArchiveResource{
+			Filename: resource.Filename,
+			Type:     resource.Type,
+		}
+		if resource.StorageType == storepb.ResourceStorageType_EXTERNAL {
+			archiveResource.Link = resource.Reference
+			frontMatter.Resources = append(frontMatter.Resources, archiveResource)
+			continue
+		}
+		// The blobs are fetched one by one so that only one of them is held in memory.
+		resource, err = s.Store.GetResource(ctx, &store.FindResource{ID: &resource.ID, GetBlob: true})
+		if err != nil {
+			return errors.Wrap(err, "failed to get resource")
+		}
+		blob, err := s.GetResourceBlob(ctx, resource)
+		if err != nil {
+			// A missing blob should not make the rest of the data impossible to export.
+			slog.Warn("failed to get resource blob for export", slog.String("resource", resource.UID), slog.Any("error", err))
+			continue
+		}
+		archiveResource.Path = getMemoArchiveResourcePath(resource.UID, resource.Filename)
+		if err := writeArchiveFile(archive, archiveResource.Path, blob, time.Unix(resource.UpdatedTs, 0)); err != nil {
+			return errors.Wrap(err, "failed to write resource")
+		}
+		frontMatter.Resources = append(frontMatter.Resources, archiveResource)
+	}
+
+	markdown, err := marshalMemoArchiveMarkdown(frontMatter, memo.Content)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal memo")
+	}
+	if err := writeArchiveFile(archive, getMemoArchiveMemoPath(memo.UID), markdown, time.Unix(memo.UpdatedTs, 0)); err != nil {
+		return errors.Wrap(err, "failed to write memo")
+	}
+	return nil
+}
+
+func writeArchiveFile(archive *zip.Writer, name string, data []byte, modified time.Time) error {
+	file, err := archive.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: modified,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = file.Write(data)
+	return err
+}
+
+// httpBodyStreamWriter sends the written bytes as the chunks of a HttpBody stream.
+type httpBodyStreamWriter struct {
+	stream      v1pb.UserService_ExportUserDataServer
+	contentType string
+}
+
+func (w *httpBodyStreamWriter) Write(p []byte) (int, error) {
+	if err := w.stream.Send(&httpbody.HttpBody{
+		ContentType: w.contentType,
+		Data:        p,
+	}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
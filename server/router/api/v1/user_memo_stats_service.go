@@ -0,0 +1,93 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// memoStatsDateLayout is the layout of the dates in the keys of the memo stats.
+const memoStatsDateLayout = "2006-01-02"
+
+func (s *APIV1Service) GetUserMemoStats(ctx context.Context, request *v1pb.GetUserMemoStatsRequest) (*v1pb.UserMemoStats, error) {
+	userID, err := ExtractUserIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	location := time.UTC
+	if request.Timezone != "" {
+		location, err = time.LoadLocation(request.Timezone)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid timezone %q", request.Timezone)
+		}
+	}
+
+	memoStatsFind := &store.FindMemoStats{
+		CreatorID: user.ID,
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	// Only the user can see the stats of their private memos.
+	if currentUser == nil {
+		memoStatsFind.VisibilityList = []store.Visibility{store.Public}
+	} else if currentUser.ID != user.ID {
+		memoStatsFind.VisibilityList = []store.Visibility{store.Public, store.Protected}
+	}
+	memoStats, err := s.Store.GetMemoStats(ctx, memoStatsFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo stats: %v", err)
+	}
+	return convertUserMemoStatsFromStore(user.ID, memoStats, location, request.WordCountPeriod), nil
+}
+
+func convertUserMemoStatsFromStore(userID int32, memoStats *store.MemoStats, location *time.Location, wordCountPeriod v1pb.GetUserMemoStatsRequest_Period) *v1pb.UserMemoStats {
+	userMemoStats := &v1pb.UserMemoStats{
+		Name:            fmt.Sprintf("%s%d", UserNamePrefix, userID),
+		DailyMemoCounts: map[string]int32{},
+		TagCounts:       memoStats.TagCounts,
+		WordCounts:      map[string]int64{},
+	}
+	for _, bucket := range memoStats.Buckets {
+		bucketTime := time.Unix(bucket.Ts, 0).In(location)
+		userMemoStats.MemoCount += bucket.MemoCount
+		userMemoStats.DailyMemoCounts[bucketTime.Format(memoStatsDateLayout)] += bucket.MemoCount
+		userMemoStats.WordCounts[getMemoStatsPeriodStart(bucketTime, wordCountPeriod).Format(memoStatsDateLayout)] += bucket.WordCount
+		userMemoStats.LinkCount += bucket.LinkCount
+		userMemoStats.CodeCount += bucket.CodeCount
+		userMemoStats.TaskListCount += bucket.TaskListCount
+		userMemoStats.ImageCount += bucket.ImageCount
+		userMemoStats.IncompleteTaskCount += bucket.IncompleteTaskCount
+	}
+	return userMemoStats
+}
+
+// getMemoStatsPeriodStart returns the first day of the period the time is in.
+func getMemoStatsPeriodStart(t time.Time, period v1pb.GetUserMemoStatsRequest_Period) time.Time {
+	year, month, day := t.Date()
+	switch period {
+	case v1pb.GetUserMemoStatsRequest_WEEK:
+		// Weekday counts from Sunday, while weeks start on Monday.
+		return time.Date(year, month, day-(int(t.Weekday())+6)%7, 0, 0, 0, 0, t.Location())
+	case v1pb.GetUserMemoStatsRequest_MONTH:
+		return time.Date(year, month, 1, 0, 0, 0, 0, t.Location())
+	case v1pb.GetUserMemoStatsRequest_YEAR:
+		return time.Date(year, time.January, 1, 0, 0, 0, 0, t.Location())
+	default:
+		return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+	}
+}
@@ -0,0 +1,48 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestGetMemoStatsPeriodStart(t *testing.T) {
+	// 2024-03-14 is a Thursday.
+	date := time.Date(2024, time.March, 14, 15, 30, 0, 0, time.UTC)
+	require.Equal(t, "2024-03-14", getMemoStatsPeriodStart(date, v1pb.GetUserMemoStatsRequest_PERIOD_UNSPECIFIED).Format(memoStatsDateLayout))
+	require.Equal(t, "2024-03-11", getMemoStatsPeriodStart(date, v1pb.GetUserMemoStatsRequest_WEEK).Format(memoStatsDateLayout))
+	require.Equal(t, "2024-03-01", getMemoStatsPeriodStart(date, v1pb.GetUserMemoStatsRequest_MONTH).Format(memoStatsDateLayout))
+	require.Equal(t, "2024-01-01", getMemoStatsPeriodStart(date, v1pb.GetUserMemoStatsRequest_YEAR).Format(memoStatsDateLayout))
+	// A Sunday belongs to the week started on the Monday before.
+	sunday := time.Date(2024, time.March, 17, 23, 0, 0, 0, time.UTC)
+	require.Equal(t, "2024-03-11", getMemoStatsPeriodStart(sunday, v1pb.GetUserMemoStatsRequest_WEEK).Format(memoStatsDateLayout))
+}
+
+func TestConvertUserMemoStatsFromStore(t *testing.T) {
+	// 2024-03-14 23:45 UTC is already 2024-03-15 in Kolkata, at UTC+05:30.
+	ts := time.Date(2024, time.March, 14, 23, 45, 0, 0, time.UTC).Unix()
+	memoStats := &store.MemoStats{
+		Buckets: []*store.MemoStatsBucket{
+			{Ts: ts - store.MemoStatsBucketSeconds, MemoCount: 1, WordCount: 10, LinkCount: 1},
+			{Ts: ts, MemoCount: 2, WordCount: 5, IncompleteTaskCount: 3},
+		},
+		TagCounts: map[string]int32{"work": 2},
+	}
+	location := time.FixedZone("IST", 5*60*60+30*60)
+	stats := convertUserMemoStatsFromStore(1, memoStats, location, v1pb.GetUserMemoStatsRequest_MONTH)
+	require.Equal(t, "users/1", stats.Name)
+	require.Equal(t, int32(3), stats.MemoCount)
+	require.Equal(t, map[string]int32{"2024-03-15": 3}, stats.DailyMemoCounts)
+	require.Equal(t, map[string]int64{"2024-03-01": 15}, stats.WordCounts)
+	require.Equal(t, map[string]int32{"work": 2}, stats.TagCounts)
+	require.Equal(t, int32(1), stats.LinkCount)
+	require.Equal(t, int32(3), stats.IncompleteTaskCount)
+
+	stats = convertUserMemoStatsFromStore(1, memoStats, time.UTC, v1pb.GetUserMemoStatsRequest_DAY)
+	require.Equal(t, map[string]int32{"2024-03-14": 3}, stats.DailyMemoCounts)
+	require.Equal(t, map[string]int64{"2024-03-14": 15}, stats.WordCounts)
+}
@@ -318,6 +318,8 @@ func (s *APIV1Service) GetUserSetting(ctx context.Context, _ *v1pb.GetUserSettin
 			userSettingMessage.Appearance = setting.GetAppearance()
 		} else if setting.Key == storepb.UserSettingKey_MEMO_VISIBILITY {
 			userSettingMessage.MemoVisibility = setting.GetMemoVisibility()
+		} else if setting.Key == storepb.UserSettingKey_MEMO_SLUG {
+			userSettingMessage.MemoSlug = setting.GetMemoSlug()
 		} else if setting.Key == storepb.UserSettingKey_REVIEW_SETTING {
 			storedReviewSetting := setting.GetReviewSetting()
 			if storedReviewSetting != nil {
@@ -373,6 +375,16 @@ func (s *APIV1Service) UpdateUserSetting(ctx context.Context, request *v1pb.Upda
 			}); err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
 			}
+		} else if field == "memo_slug" {
+			if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+				UserId: user.ID,
+				Key:    storepb.UserSettingKey_MEMO_SLUG,
+				Value: &storepb.UserSetting_MemoSlug{
+					MemoSlug: request.Setting.MemoSlug,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+			}
 		} else if field == "review_setting" {
 			reviewSetting := request.Setting.ReviewSetting
 			if reviewSetting == nil {
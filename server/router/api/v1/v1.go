@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"net/http"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
@@ -11,10 +12,13 @@ import (
 	"github.com/labstack/echo/v4/middleware"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	"github.com/usememos/memos/server/profile"
+	"github.com/usememos/memos/server/scheduler"
 	"github.com/usememos/memos/store"
 )
 
@@ -32,20 +36,25 @@ type APIV1Service struct {
 	v1pb.UnimplementedIdentityProviderServiceServer
 	v1pb.UnimplementedTagServiceServer
 	v1pb.UnimplementedReviewServiceServer
+	v1pb.UnimplementedJobServiceServer
+	v1pb.UnimplementedShortcutServiceServer
+	v1pb.UnimplementedMemoRuleServiceServer
 
-	Secret  string
-	Profile *profile.Profile
-	Store   *store.Store
+	Secret    string
+	Profile   *profile.Profile
+	Store     *store.Store
+	Scheduler *scheduler.Scheduler
 
 	grpcServer *grpc.Server
 }
 
-func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store, grpcServer *grpc.Server) *APIV1Service {
+func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store, scheduler *scheduler.Scheduler, grpcServer *grpc.Server) *APIV1Service {
 	grpc.EnableTracing = true
 	apiv1Service := &APIV1Service{
 		Secret:     secret,
 		Profile:    profile,
 		Store:      store,
+		Scheduler:  scheduler,
 		grpcServer: grpcServer,
 	}
 	v1pb.RegisterWorkspaceServiceServer(grpcServer, apiv1Service)
@@ -61,6 +70,9 @@ func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store
 	v1pb.RegisterIdentityProviderServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterTagServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterReviewServiceServer(grpcServer, apiv1Service)
+	v1pb.RegisterJobServiceServer(grpcServer, apiv1Service)
+	v1pb.RegisterShortcutServiceServer(grpcServer, apiv1Service)
+	v1pb.RegisterMemoRuleServiceServer(grpcServer, apiv1Service)
 	reflection.Register(grpcServer)
 	return apiv1Service
 }
@@ -76,7 +88,23 @@ func (s *APIV1Service) RegisterGateway(ctx context.Context, echoServer *echo.Ech
 		return err
 	}
 
-	gwMux := runtime.NewServeMux()
+	gwMux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &binaryStreamMarshaler{
+			HTTPBodyMarshaler: &runtime.HTTPBodyMarshaler{
+				Marshaler: &runtime.JSONPb{
+					MarshalOptions: protojson.MarshalOptions{
+						EmitUnpopulated: true,
+					},
+					UnmarshalOptions: protojson.UnmarshalOptions{
+						DiscardUnknown: true,
+					},
+				},
+			},
+		}),
+		runtime.WithMetadata(func(_ context.Context, request *http.Request) metadata.MD {
+			return getMemoShareMetadata(request)
+		}),
+	)
 	if err := v1pb.RegisterWorkspaceServiceHandler(ctx, gwMux, conn); err != nil {
 		return err
 	}
@@ -116,6 +144,15 @@ func (s *APIV1Service) RegisterGateway(ctx context.Context, echoServer *echo.Ech
 	if err := v1pb.RegisterReviewServiceHandler(ctx, gwMux, conn); err != nil {
 		return err
 	}
+	if err := v1pb.RegisterJobServiceHandler(ctx, gwMux, conn); err != nil {
+		return err
+	}
+	if err := v1pb.RegisterShortcutServiceHandler(ctx, gwMux, conn); err != nil {
+		return err
+	}
+	if err := v1pb.RegisterMemoRuleServiceHandler(ctx, gwMux, conn); err != nil {
+		return err
+	}
 	gwGroup := echoServer.Group("")
 	gwGroup.Use(middleware.CORS())
 	handler := echo.WrapHandler(gwMux)
@@ -135,3 +172,13 @@ func (s *APIV1Service) RegisterGateway(ctx context.Context, echoServer *echo.Ech
 
 	return nil
 }
+
+// binaryStreamMarshaler is the default marshaler of the gateway, except that the chunks of
+// a streamed HttpBody are written without delimiters so that binary streams are not corrupted.
+type binaryStreamMarshaler struct {
+	*runtime.HTTPBodyMarshaler
+}
+
+func (*binaryStreamMarshaler) Delimiter() []byte {
+	return nil
+}
@@ -0,0 +1,46 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	// sqlite driver.
+	_ "modernc.org/sqlite"
+
+	"github.com/usememos/memos/server/profile"
+	"github.com/usememos/memos/store"
+	"github.com/usememos/memos/store/db"
+)
+
+// newTestingService returns a service backed by an in-memory store.
+func newTestingService(ctx context.Context, t *testing.T) *APIV1Service {
+	profile := &profile.Profile{
+		Mode:   "prod",
+		Driver: "sqlite",
+		DSN:    ":memory:",
+	}
+	dbDriver, err := db.NewDBDriver(profile)
+	require.NoError(t, err)
+	stores := store.New(dbDriver, profile)
+	require.NoError(t, stores.Migrate(ctx))
+	t.Cleanup(func() {
+		stores.Close()
+	})
+	return &APIV1Service{
+		Profile: profile,
+		Store:   stores,
+	}
+}
+
+// createTestingUser creates a user with the username and returns a context authenticated as the user.
+func createTestingUser(ctx context.Context, t *testing.T, s *APIV1Service, username string) (*store.User, context.Context) {
+	user, err := s.Store.CreateUser(ctx, &store.User{
+		Username: username,
+		Role:     store.RoleUser,
+		Email:    username + "@test.com",
+	})
+	require.NoError(t, err)
+	return user, context.WithValue(ctx, usernameContextKey, username)
+}
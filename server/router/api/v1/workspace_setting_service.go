@@ -251,6 +251,8 @@ func convertWorkspaceMemoRelatedSettingFromStore(setting *storepb.WorkspaceMemoR
 		EnableShareToX:           setting.EnableShareToX,
 		DisableReactions:         setting.DisableReactions,
 		DisableSaveAsImage:       setting.DisableSaveAsImage,
+		MemoRevisionLimit:        setting.MemoRevisionLimit,
+		TrashRetentionDays:       setting.TrashRetentionDays,
 	}
 }
 
@@ -273,6 +275,8 @@ func convertWorkspaceMemoRelatedSettingToStore(setting *v1pb.WorkspaceMemoRelate
 		EnableShareToX:           setting.EnableShareToX,
 		DisableReactions:         setting.DisableReactions,
 		DisableSaveAsImage:       setting.DisableSaveAsImage,
+		MemoRevisionLimit:        setting.MemoRevisionLimit,
+		TrashRetentionDays:       setting.TrashRetentionDays,
 	}
 }
 
@@ -5,6 +5,7 @@ import (
 	"embed"
 	"io/fs"
 	"net/http"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
@@ -29,7 +30,7 @@ func NewFrontendService(profile *profile.Profile, store *store.Store) *FrontendS
 	}
 }
 
-func (*FrontendService) Serve(_ context.Context, e *echo.Echo) {
+func (s *FrontendService) Serve(_ context.Context, e *echo.Echo) {
 	skipper := func(c echo.Context) bool {
 		return util.HasPrefixes(c.Path(), "/api", "/memos.api.v1")
 	}
@@ -47,6 +48,9 @@ func (*FrontendService) Serve(_ context.Context, e *echo.Echo) {
 		HTML5:      false, // Disable fallback to index.html
 	}))
 
+	// Redirect the links to memos by their previous uids before the main app is served.
+	e.Use(s.redirectMemoAlias)
+
 	// Route to serve the main app with HTML5 fallback for SPA behavior.
 	e.Use(middleware.StaticWithConfig(middleware.StaticConfig{
 		Filesystem: getFileSystem("dist"),
@@ -55,6 +59,30 @@ func (*FrontendService) Serve(_ context.Context, e *echo.Echo) {
 	}))
 }
 
+// redirectMemoAlias permanently redirects the links to a memo by one of its previous uids to its current uid.
+func (s *FrontendService) redirectMemoAlias(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		uid, ok := strings.CutPrefix(c.Request().URL.Path, "/m/")
+		if !ok || !util.UIDMatcher.MatchString(uid) {
+			return next(c)
+		}
+		ctx := c.Request().Context()
+		alias, err := s.Store.GetMemoAlias(ctx, &store.FindMemoAlias{UID: &uid})
+		if err != nil || alias == nil {
+			return next(c)
+		}
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &alias.MemoID, ExcludeContent: true})
+		if err != nil || memo == nil {
+			return next(c)
+		}
+		target := "/m/" + memo.UID
+		if query := c.Request().URL.RawQuery; query != "" {
+			target += "?" + query
+		}
+		return c.Redirect(http.StatusMovedPermanently, target)
+	}
+}
+
 func getFileSystem(path string) http.FileSystem {
 	fs, err := fs.Sub(embeddedFiles, path)
 	if err != nil {
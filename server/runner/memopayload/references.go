@@ -0,0 +1,170 @@
+package memopayload
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/internal/util"
+	"github.com/usememos/memos/store"
+)
+
+// The references of a memo payload are either memo names in the format memos/{id} or memo uids.
+const memoNamePrefix = "memos/"
+
+var (
+	// memoNameRegexp matches the memo names in the text of the content, such as memos/101.
+	memoNameRegexp = regexp.MustCompile(`(?:^|[^\w/])(memos/\d+)\b`)
+	// memoURLPathRegexp matches the path of the page of a memo.
+	memoURLPathRegexp = regexp.MustCompile(`^/m/([a-zA-Z0-9-]+)/?$`)
+)
+
+// getMemoNameReferences returns the memo names in the text.
+func getMemoNameReferences(text string) []string {
+	references := []string{}
+	for _, match := range memoNameRegexp.FindAllStringSubmatch(text, -1) {
+		references = append(references, match[1])
+	}
+	return references
+}
+
+// getResourceNameReference returns the reference of the resource name of a [[reference]] or an ![[embed]],
+// or an empty string if it does not name a memo.
+func getResourceNameReference(resourceName string) string {
+	if strings.HasPrefix(resourceName, memoNamePrefix) {
+		if _, err := strconv.ParseInt(strings.TrimPrefix(resourceName, memoNamePrefix), 10, 32); err == nil {
+			return resourceName
+		}
+		resourceName = strings.TrimPrefix(resourceName, memoNamePrefix)
+	}
+	if !util.UIDMatcher.MatchString(resourceName) {
+		return ""
+	}
+	return resourceName
+}
+
+// getURLReference returns the uid of the memo page the URL links to, or an empty string.
+func getURLReference(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	matches := memoURLPathRegexp.FindStringSubmatch(u.Path)
+	if len(matches) == 0 {
+		return ""
+	}
+	return matches[1]
+}
+
+// GetMemoByReference returns the memo of a reference of the payload, or nil if it does not exist.
+func GetMemoByReference(ctx context.Context, stores *store.Store, reference string) (*store.Memo, error) {
+	find := &store.FindMemo{ExcludeContent: true}
+	if strings.HasPrefix(reference, memoNamePrefix) {
+		id, err := strconv.ParseInt(strings.TrimPrefix(reference, memoNamePrefix), 10, 32)
+		if err != nil {
+			return nil, nil
+		}
+		memoID := int32(id)
+		find.ID = &memoID
+	} else {
+		find.UID = &reference
+	}
+	memo, err := stores.GetMemo(ctx, find)
+	if err != nil || memo != nil || find.UID == nil {
+		return memo, err
+	}
+	// A reference by a previous uid of a memo still leads to the memo.
+	alias, err := stores.GetMemoAlias(ctx, &store.FindMemoAlias{UID: &reference})
+	if err != nil || alias == nil {
+		return nil, err
+	}
+	return stores.GetMemo(ctx, &store.FindMemo{ID: &alias.MemoID, ExcludeContent: true})
+}
+
+// SyncMemoReferences keeps the REFERENCE relations of the memo in sync with the references of its payload.
+// The relations to the memos that are only in previousReferences, the references before the content
+// was changed, are deleted, so that the relations set explicitly with SetMemoRelations are kept.
+func SyncMemoReferences(ctx context.Context, stores *store.Store, memo *store.Memo, previousReferences []string) error {
+	referenceType := store.MemoRelationReference
+	relations, err := stores.ListMemoRelations(ctx, &store.FindMemoRelation{
+		MemoID: &memo.ID,
+		Type:   &referenceType,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memo relations")
+	}
+	relatedMemoIDs := []int32{}
+	for _, relation := range relations {
+		relatedMemoIDs = append(relatedMemoIDs, relation.RelatedMemoID)
+	}
+	referencedMemoIDs := []int32{}
+	for _, reference := range memo.Payload.GetReferences() {
+		referencedMemo, err := GetMemoByReference(ctx, stores, reference)
+		if err != nil {
+			return errors.Wrap(err, "failed to get referenced memo")
+		}
+		if referencedMemo == nil || referencedMemo.ID == memo.ID || slices.Contains(referencedMemoIDs, referencedMemo.ID) {
+			continue
+		}
+		// A reference to a memo the creator cannot see must not expose it through the relation.
+		visible, err := IsMemoVisibleToCreator(ctx, stores, referencedMemo, memo.CreatorID)
+		if err != nil {
+			return errors.Wrap(err, "failed to check referenced memo visibility")
+		}
+		if !visible {
+			continue
+		}
+		referencedMemoIDs = append(referencedMemoIDs, referencedMemo.ID)
+		if slices.Contains(relatedMemoIDs, referencedMemo.ID) {
+			continue
+		}
+		if _, err := stores.UpsertMemoRelation(ctx, &store.MemoRelation{
+			MemoID:        memo.ID,
+			RelatedMemoID: referencedMemo.ID,
+			Type:          referenceType,
+		}); err != nil {
+			return errors.Wrap(err, "failed to upsert memo relation")
+		}
+	}
+
+	for _, reference := range previousReferences {
+		if slices.Contains(memo.Payload.GetReferences(), reference) {
+			continue
+		}
+		referencedMemo, err := GetMemoByReference(ctx, stores, reference)
+		if err != nil {
+			return errors.Wrap(err, "failed to get referenced memo")
+		}
+		if referencedMemo == nil || slices.Contains(referencedMemoIDs, referencedMemo.ID) {
+			continue
+		}
+		if err := stores.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{
+			MemoID:        &memo.ID,
+			RelatedMemoID: &referencedMemo.ID,
+			Type:          &referenceType,
+		}); err != nil {
+			return errors.Wrap(err, "failed to delete memo relation")
+		}
+	}
+	return nil
+}
+
+// IsMemoVisibleToCreator reports whether the user with the creator ID can see the memo.
+func IsMemoVisibleToCreator(ctx context.Context, stores *store.Store, memo *store.Memo, creatorID int32) (bool, error) {
+	if memo.Visibility != store.Private || memo.CreatorID == creatorID {
+		return true, nil
+	}
+	collaborator, err := stores.GetMemoCollaborator(ctx, &store.FindMemoCollaborator{
+		MemoID: &memo.ID,
+		UserID: &creatorID,
+	})
+	if err != nil {
+		return false, err
+	}
+	return collaborator != nil, nil
+}
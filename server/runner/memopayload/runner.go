@@ -5,6 +5,7 @@ import (
 	"log/slog"
 	"slices"
 	"strings"
+	"unicode"
 
 	"github.com/pkg/errors"
 	"github.com/usememos/gomark/ast"
@@ -25,15 +26,19 @@ func NewRunner(store *store.Store) *Runner {
 	}
 }
 
-// RunOnce rebuilds the payload of all memos.
-func (r *Runner) RunOnce(ctx context.Context) {
+// DefaultSchedule leaves the runner unscheduled, so it only runs after the server starts or when triggered.
+const DefaultSchedule = ""
+
+// RunOnce rebuilds the payload of all memos, syncs the REFERENCE relations with the references in their content,
+// and indexes the terms of their content.
+func (r *Runner) RunOnce(ctx context.Context) error {
 	memos, err := r.Store.ListMemos(ctx, &store.FindMemo{})
 	if err != nil {
-		slog.Error("failed to list memos", "err", err)
-		return
+		return errors.Wrap(err, "failed to list memos")
 	}
 
 	for _, memo := range memos {
+		previousReferences := memo.Payload.GetReferences()
 		if err := RebuildMemoPayload(memo); err != nil {
 			slog.Error("failed to rebuild memo payload", "err", err)
 			continue
@@ -55,8 +60,16 @@ func (r *Runner) RunOnce(ctx context.Context) {
 			Payload: memo.Payload,
 		}); err != nil {
 			slog.Error("failed to update memo", "err", err)
+			continue
+		}
+		if err := SyncMemoReferences(ctx, r.Store, memo, previousReferences); err != nil {
+			slog.Error("failed to sync memo references", "err", err)
+		}
+		if err := r.Store.IndexMemoTerms(ctx, memo.ID, memo.Content); err != nil {
+			slog.Error("failed to index memo terms", "err", err)
 		}
 	}
+	return nil
 }
 
 func RebuildMemoPayload(memo *store.Memo) error {
@@ -78,28 +91,77 @@ func RebuildMemoPayload(memo *store.Memo) error {
 			if !slices.Contains(tags, tag) {
 				tags = append(tags, tag)
 			}
-		case *ast.Link, *ast.AutoLink:
+		case *ast.Link:
 			property.HasLink = true
-		case *ast.TaskListItem:
-			property.HasTaskList = true
-			if !n.Complete {
-				property.HasIncompleteTasks = true
+			references = appendReference(references, getURLReference(n.URL))
+		case *ast.AutoLink:
+			property.HasLink = true
+			references = appendReference(references, getURLReference(n.URL))
+		case *ast.Text:
+			for _, reference := range getMemoNameReferences(n.Content) {
+				references = appendReference(references, reference)
 			}
 		case *ast.Code, *ast.CodeBlock:
 			property.HasCode = true
 		case *ast.Image:
 			property.HasImage = true
 		case *ast.EmbeddedContent:
-			// TODO: validate references.
-			references = append(references, n.ResourceName)
+			references = appendReference(references, getResourceNameReference(n.ResourceName))
+		case *ast.ReferencedContent:
+			references = appendReference(references, getResourceNameReference(n.ResourceName))
 		}
 	})
+	// The tasks are the items ListTaskItems finds, so the counts match the tasks that can be checked,
+	// which leaves out the ones in a blockquote.
+	for _, item := range ListTaskItems(memo.Content) {
+		property.HasTaskList = true
+		if !item.Complete {
+			property.HasIncompleteTasks = true
+			property.IncompleteTaskCount++
+			if dueTs := getTaskDueTs(item); dueTs != 0 {
+				property.TaskDueTs = append(property.TaskDueTs, dueTs)
+			}
+		}
+	}
+	property.WordCount = CountWords(memo.Content)
+	property.Simhash = GetContentSimhash(memo.Content)
 	memo.Payload.Tags = tags
 	memo.Payload.References = references
 	memo.Payload.Property = property
 	return nil
 }
 
+// CountWords returns the number of words in the content.
+// Every character of the scripts written without spaces between words counts as a word.
+func CountWords(content string) int32 {
+	count := int32(0)
+	inWord := false
+	for _, r := range content {
+		switch {
+		case unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Thai):
+			count++
+			inWord = false
+		case unicode.IsLetter(r) || unicode.IsNumber(r):
+			if !inWord {
+				count++
+				inWord = true
+			}
+		case r == '\'' || r == '’' || r == '-':
+			// Apostrophes and hyphens join the parts of a word.
+		default:
+			inWord = false
+		}
+	}
+	return count
+}
+
+func appendReference(references []string, reference string) []string {
+	if reference == "" || slices.Contains(references, reference) {
+		return references
+	}
+	return append(references, reference)
+}
+
 func TraverseASTNodes(nodes []ast.Node, fn func(ast.Node)) {
 	for _, node := range nodes {
 		fn(node)
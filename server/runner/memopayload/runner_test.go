@@ -0,0 +1,55 @@
+package memopayload
+
+import (
+	"math/bits"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestRebuildMemoPayloadReferences(t *testing.T) {
+	memo := &store.Memo{
+		Content: "See [[abc]], ![[memos/12]] and [link](/m/def).\nAlso memos/7, https://example.com/m/ghi and [[resources/1]].\n[[abc]]",
+	}
+	require.NoError(t, RebuildMemoPayload(memo))
+	require.Equal(t, []string{"abc", "memos/12", "def", "memos/7", "ghi"}, memo.Payload.References)
+}
+
+func TestRebuildMemoPayloadProperty(t *testing.T) {
+	memo := &store.Memo{
+		Content: "Plan the week #work\n- [ ] Write the well-known report\n- [x] Send it\n- [ ] Review",
+	}
+	require.NoError(t, RebuildMemoPayload(memo))
+	require.True(t, memo.Payload.Property.HasIncompleteTasks)
+	require.Equal(t, int32(2), memo.Payload.Property.IncompleteTaskCount)
+	require.Equal(t, int32(12), memo.Payload.Property.WordCount)
+}
+
+func TestCountWords(t *testing.T) {
+	tests := []struct {
+		content string
+		count   int32
+	}{
+		{content: "", count: 0},
+		{content: "  hello,   world!  ", count: 2},
+		{content: "it's a well-known fact in 2024", count: 6},
+		{content: "今天天气很好", count: 6},
+		{content: "读 memos 的文档", count: 5},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.count, CountWords(test.content), test.content)
+	}
+}
+
+func TestGetContentSimhash(t *testing.T) {
+	distance := func(a, b string) int {
+		return bits.OnesCount64(GetContentSimhash(a) ^ GetContentSimhash(b))
+	}
+	content := "Meeting notes: discuss the quarterly roadmap, hiring plan, budget review and the offsite agenda with the team"
+	require.Equal(t, 0, distance(content, "Meeting notes - discuss the quarterly roadmap, hiring plan, budget review and the offsite agenda with the team!"))
+	require.LessOrEqual(t, distance(content, content+" tomorrow"), 10)
+	require.Greater(t, distance(content, "Grocery list: apples, oranges, bread, cheese, coffee beans and olive oil"), 10)
+	require.Equal(t, uint64(0), GetContentSimhash("the 42"))
+}
@@ -0,0 +1,36 @@
+package memopayload
+
+import (
+	"hash/fnv"
+
+	"github.com/usememos/memos/store"
+)
+
+// GetContentSimhash returns the SimHash fingerprint of the terms of the content weighted by their frequencies,
+// or 0 if the content has no terms.
+func GetContentSimhash(content string) uint64 {
+	terms := store.GetMemoTerms(content)
+	if len(terms) == 0 {
+		return 0
+	}
+	weights := [64]int64{}
+	for term, frequency := range terms {
+		hash := fnv.New64a()
+		hash.Write([]byte(term))
+		termHash := hash.Sum64()
+		for i := range weights {
+			if termHash&(1<<i) != 0 {
+				weights[i] += int64(frequency)
+			} else {
+				weights[i] -= int64(frequency)
+			}
+		}
+	}
+	simhash := uint64(0)
+	for i, weight := range weights {
+		if weight > 0 {
+			simhash |= 1 << i
+		}
+	}
+	return simhash
+}
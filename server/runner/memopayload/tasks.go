@@ -0,0 +1,128 @@
+package memopayload
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/usememos/gomark/ast"
+	"github.com/usememos/gomark/parser"
+	"github.com/usememos/gomark/parser/tokenizer"
+	"github.com/usememos/gomark/restore"
+)
+
+// TaskItem is an item of a task list in the content of a memo.
+type TaskItem struct {
+	// Line is the index of the line of the item in the content.
+	Line     int
+	Complete bool
+	// Content is the text of the item without its due date.
+	Content string
+	// DueDate is the date of the @due(YYYY-MM-DD) in the item, empty if there is none.
+	DueDate string
+
+	indent int
+}
+
+// taskBlockParsers are the block parsers of gomark in the order it tries them,
+// so the content is split into the same blocks as when it is parsed.
+var taskBlockParsers = []parser.BlockParser{
+	parser.NewCodeBlockParser(),
+	parser.NewTableParser(),
+	parser.NewHorizontalRuleParser(),
+	parser.NewHeadingParser(),
+	parser.NewBlockquoteParser(),
+	parser.NewOrderedListItemParser(),
+	parser.NewTaskListItemParser(),
+	parser.NewUnorderedListItemParser(),
+	parser.NewMathBlockParser(),
+	parser.NewEmbeddedContentParser(),
+	parser.NewParagraphParser(),
+	parser.NewLineBreakParser(),
+}
+
+var taskDueDatePattern = regexp.MustCompile(`@due\(([^)]*)\)`)
+
+// ListTaskItems returns the items of the task lists in the content, in order.
+// Like gomark, it does not parse the lines of a blockquote as list items, so a quoted task is not an item.
+func ListTaskItems(content string) []*TaskItem {
+	tokens := tokenizer.Tokenize(content)
+	items := []*TaskItem{}
+	line := 0
+	for len(tokens) > 0 {
+		size := 0
+		for _, blockParser := range taskBlockParsers {
+			var node ast.Node
+			node, size = blockParser.Match(tokens)
+			if node == nil || size == 0 {
+				continue
+			}
+			if taskListItem, ok := node.(*ast.TaskListItem); ok {
+				items = append(items, newTaskItem(line, taskListItem))
+			}
+			break
+		}
+		if size == 0 {
+			break
+		}
+		for _, token := range tokens[:size] {
+			if token.Type == tokenizer.NewLine {
+				line++
+			}
+		}
+		tokens = tokens[size:]
+	}
+	return items
+}
+
+func newTaskItem(line int, taskListItem *ast.TaskListItem) *TaskItem {
+	item := &TaskItem{
+		Line:     line,
+		Complete: taskListItem.Complete,
+		Content:  restore.Restore(taskListItem.Children),
+		indent:   taskListItem.Indent,
+	}
+	if matches := taskDueDatePattern.FindStringSubmatch(item.Content); matches != nil {
+		if _, err := time.Parse(time.DateOnly, matches[1]); err == nil {
+			item.DueDate = matches[1]
+			item.Content = strings.Join(strings.Fields(strings.Replace(item.Content, matches[0], "", 1)), " ")
+		}
+	}
+	return item
+}
+
+// getTaskDueTs returns the start of the due date of the task in UTC, or 0 if it has no due date.
+// The timezone of the creator is not known to the server, so the due date is not resolved in it,
+// and neither in the timezone of the server. A reminder at a local time is set with the remind time of the memo instead.
+func getTaskDueTs(item *TaskItem) int64 {
+	dueDate, err := time.Parse(time.DateOnly, item.DueDate)
+	if err != nil {
+		return 0
+	}
+	return dueDate.Unix()
+}
+
+// SetTaskItemComplete returns the content with the item of the task list on the line checked or unchecked.
+func SetTaskItemComplete(content string, line int, complete bool) (string, error) {
+	var item *TaskItem
+	for _, taskItem := range ListTaskItems(content) {
+		if taskItem.Line == line {
+			item = taskItem
+			break
+		}
+	}
+	if item == nil {
+		return "", errors.Errorf("no task on line %d", line)
+	}
+
+	lines := strings.Split(content, "\n")
+	// The checkbox follows the indent, the list symbol, a space and the opening bracket.
+	checkbox := item.indent + 3
+	mark := " "
+	if complete {
+		mark = "x"
+	}
+	lines[line] = lines[line][:checkbox] + mark + lines[line][checkbox+1:]
+	return strings.Join(lines, "\n"), nil
+}
@@ -0,0 +1,73 @@
+package memopayload
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestListTaskItems(t *testing.T) {
+	content := "# Plan\n\n- [ ] Write the **report** @due(2024-05-01)\n  - [x] Outline\n```\n- [ ] not a task\n```\n* [ ] Review @due(soon)\n- plain item"
+	items := ListTaskItems(content)
+	require.Equal(t, 3, len(items))
+	require.Equal(t, 2, items[0].Line)
+	require.False(t, items[0].Complete)
+	require.Equal(t, "Write the **report**", items[0].Content)
+	require.Equal(t, "2024-05-01", items[0].DueDate)
+	require.Equal(t, 3, items[1].Line)
+	require.True(t, items[1].Complete)
+	require.Equal(t, "Outline", items[1].Content)
+	// An invalid due date is left in the content.
+	require.Equal(t, 7, items[2].Line)
+	require.Equal(t, "Review @due(soon)", items[2].Content)
+	require.Equal(t, "", items[2].DueDate)
+}
+
+func TestSetTaskItemComplete(t *testing.T) {
+	content := "Todo\n- [ ] a\n  + [x] b\n```\n- [ ] c\n```"
+	updated, err := SetTaskItemComplete(content, 1, true)
+	require.NoError(t, err)
+	require.Equal(t, "Todo\n- [x] a\n  + [x] b\n```\n- [ ] c\n```", updated)
+	updated, err = SetTaskItemComplete(updated, 2, false)
+	require.NoError(t, err)
+	require.Equal(t, "Todo\n- [x] a\n  + [ ] b\n```\n- [ ] c\n```", updated)
+	// Lines in code blocks and lines without tasks are not tasks.
+	_, err = SetTaskItemComplete(content, 4, true)
+	require.Error(t, err)
+	_, err = SetTaskItemComplete(content, 0, true)
+	require.Error(t, err)
+}
+
+func TestRebuildMemoPayloadTaskDueTs(t *testing.T) {
+	memo := &store.Memo{
+		Content: "- [ ] Pay rent @due(2024-05-01)\n- [x] Book flight @due(2024-04-01)\n- [ ] Call @due(someday)",
+	}
+	require.NoError(t, RebuildMemoPayload(memo))
+	// Only the incomplete tasks with a valid due date are due, at the start of the date in UTC.
+	require.Equal(t, []int64{time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC).Unix()}, memo.Payload.Property.TaskDueTs)
+
+	// The due date does not depend on the timezone of the server.
+	local := time.Local
+	time.Local = time.FixedZone("UTC+9", 9*60*60)
+	defer func() {
+		time.Local = local
+	}()
+	require.NoError(t, RebuildMemoPayload(memo))
+	require.Equal(t, []int64{time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC).Unix()}, memo.Payload.Property.TaskDueTs)
+}
+
+func TestQuotedTaskItems(t *testing.T) {
+	// A quoted task is neither listed, checked nor counted in the payload.
+	content := "> - [ ] quoted\n- [ ] task"
+	items := ListTaskItems(content)
+	require.Len(t, items, 1)
+	require.Equal(t, 1, items[0].Line)
+	_, err := SetTaskItemComplete(content, 0, true)
+	require.Error(t, err)
+	memo := &store.Memo{Content: content}
+	require.NoError(t, RebuildMemoPayload(memo))
+	require.Equal(t, int32(1), memo.Payload.Property.IncompleteTaskCount)
+}
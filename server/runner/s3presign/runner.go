@@ -5,6 +5,7 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/pkg/errors"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/usememos/memos/plugin/storage/s3"
@@ -22,31 +23,17 @@ func NewRunner(store *store.Store) *Runner {
 	}
 }
 
-// Schedule runner every 12 hours.
-const runnerInterval = time.Hour * 12
+// DefaultSchedule runs the runner every 12 hours.
+const DefaultSchedule = "@every 12h"
 
-func (r *Runner) Run(ctx context.Context) {
-	ticker := time.NewTicker(runnerInterval)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ticker.C:
-			r.RunOnce(ctx)
-		case <-ctx.Done():
-			return
-		}
-	}
-}
-
-func (r *Runner) RunOnce(ctx context.Context) {
-	r.CheckAndPresign(ctx)
+func (r *Runner) RunOnce(ctx context.Context) error {
+	return r.CheckAndPresign(ctx)
 }
 
-func (r *Runner) CheckAndPresign(ctx context.Context) {
+func (r *Runner) CheckAndPresign(ctx context.Context) error {
 	workspaceStorageSetting, err := r.Store.GetWorkspaceStorageSetting(ctx)
 	if err != nil {
-		return
+		return errors.Wrap(err, "failed to get workspace storage setting")
 	}
 
 	s3StorageType := storepb.ResourceStorageType_S3
@@ -55,7 +42,7 @@ func (r *Runner) CheckAndPresign(ctx context.Context) {
 		StorageType: &s3StorageType,
 	})
 	if err != nil {
-		return
+		return errors.Wrap(err, "failed to list resources")
 	}
 
 	for _, resource := range resources {
@@ -89,7 +76,7 @@ func (r *Runner) CheckAndPresign(ctx context.Context) {
 
 		presignURL, err := s3Client.PresignGetObject(ctx, s3ObjectPayload.Key)
 		if err != nil {
-			return
+			return errors.Wrap(err, "failed to presign object")
 		}
 		s3ObjectPayload.S3Config = s3Config
 		s3ObjectPayload.LastPresignedTime = timestamppb.New(time.Now())
@@ -102,7 +89,8 @@ func (r *Runner) CheckAndPresign(ctx context.Context) {
 				},
 			},
 		}); err != nil {
-			return
+			return errors.Wrap(err, "failed to update resource")
 		}
 	}
+	return nil
 }
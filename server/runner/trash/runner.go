@@ -0,0 +1,54 @@
+package trash
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+type Runner struct {
+	Store *store.Store
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+// DefaultSchedule runs the runner every hour.
+const DefaultSchedule = "@every 1h"
+
+func (r *Runner) RunOnce(ctx context.Context) error {
+	return r.PurgeExpiredMemos(ctx)
+}
+
+// PurgeExpiredMemos permanently deletes the memos that have been in the trash longer than the retention period.
+func (r *Runner) PurgeExpiredMemos(ctx context.Context) error {
+	workspaceMemoRelatedSetting, err := r.Store.GetWorkspaceMemoRelatedSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get workspace memo related setting")
+	}
+
+	retention := time.Duration(workspaceMemoRelatedSetting.TrashRetentionDays) * 24 * time.Hour
+	trashedTsBefore := time.Now().Add(-retention).Unix()
+	memos, err := r.Store.ListMemos(ctx, &store.FindMemo{
+		Trashed:         true,
+		TrashedTsBefore: &trashedTsBefore,
+		ExcludeContent:  true,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list expired trashed memos")
+	}
+
+	for _, memo := range memos {
+		if err := r.Store.PurgeMemo(ctx, memo.ID); err != nil {
+			slog.Error("failed to purge trashed memo", "memo_id", memo.ID, "error", err)
+		}
+	}
+	return nil
+}
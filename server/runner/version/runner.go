@@ -6,10 +6,8 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
-	"time"
 
 	"github.com/pkg/errors"
-	"golang.org/x/exp/slog"
 
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/server/profile"
@@ -29,34 +27,20 @@ func NewRunner(store *store.Store, profile *profile.Profile) *Runner {
 	}
 }
 
-// Schedule checker every 8 hours.
-const runnerInterval = time.Hour * 8
+// DefaultSchedule runs the checker every 8 hours.
+const DefaultSchedule = "@every 8h"
 
-func (r *Runner) Run(ctx context.Context) {
-	ticker := time.NewTicker(runnerInterval)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ticker.C:
-			r.RunOnce(ctx)
-		case <-ctx.Done():
-			return
-		}
-	}
-}
-
-func (r *Runner) RunOnce(ctx context.Context) {
-	r.Check(ctx)
+func (r *Runner) RunOnce(ctx context.Context) error {
+	return r.Check(ctx)
 }
 
-func (r *Runner) Check(ctx context.Context) {
+func (r *Runner) Check(ctx context.Context) error {
 	latestVersion, err := r.GetLatestVersion()
 	if err != nil {
-		return
+		return err
 	}
 	if !version.IsVersionGreaterThan(latestVersion, version.GetCurrentVersion(r.Profile.Mode)) {
-		return
+		return nil
 	}
 
 	versionUpdateActivityType := store.ActivityTypeVersionUpdate
@@ -64,7 +48,7 @@ func (r *Runner) Check(ctx context.Context) {
 		Type: &versionUpdateActivityType,
 	})
 	if err != nil {
-		return
+		return errors.Wrap(err, "failed to list activities")
 	}
 
 	shouldNotify := true
@@ -76,7 +60,7 @@ func (r *Runner) Check(ctx context.Context) {
 	}
 
 	if !shouldNotify {
-		return
+		return nil
 	}
 
 	// Create version update activity and inbox message.
@@ -91,7 +75,7 @@ func (r *Runner) Check(ctx context.Context) {
 		},
 	}
 	if _, err := r.Store.CreateActivity(ctx, activity); err != nil {
-		return
+		return errors.Wrap(err, "failed to create activity")
 	}
 
 	hostUserRole := store.RoleHost
@@ -99,10 +83,10 @@ func (r *Runner) Check(ctx context.Context) {
 		Role: &hostUserRole,
 	})
 	if err != nil {
-		return
+		return errors.Wrap(err, "failed to list host users")
 	}
 	if len(users) == 0 {
-		return
+		return nil
 	}
 
 	hostUser := users[0]
@@ -115,8 +99,9 @@ func (r *Runner) Check(ctx context.Context) {
 			ActivityId: &activity.ID,
 		},
 	}); err != nil {
-		slog.Error("failed to create inbox", slog.String("error", err.Error()))
+		return errors.Wrap(err, "failed to create inbox")
 	}
+	return nil
 }
 
 func (*Runner) GetLatestVersion() (string, error) {
@@ -0,0 +1,321 @@
+// Package scheduler runs the named background jobs on cron schedules and records the history of their runs.
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/cron"
+	"github.com/usememos/memos/store"
+)
+
+// MaxJobRuns is the number of the latest runs kept in the history of each job.
+const MaxJobRuns = 100
+
+var (
+	// ErrJobNotFound is returned when no job is registered with the given name.
+	ErrJobNotFound = errors.New("job not found")
+	// ErrJobRunning is returned when a job is triggered while a previous run is still in progress.
+	ErrJobRunning = errors.New("job is already running")
+	// ErrNothingToDo is returned by the run of a job that found nothing to do. Such a run succeeds,
+	// and it is not kept in the history unless it was triggered.
+	ErrNothingToDo = errors.New("nothing to do")
+)
+
+// Job is a named background job.
+type Job struct {
+	Name        string
+	Description string
+	// DefaultSchedule is the cron spec used until the schedule is changed.
+	// An empty schedule means the job only runs after the server starts or when triggered.
+	DefaultSchedule string
+	Run             func(ctx context.Context) error
+}
+
+// JobStatus is a snapshot of a registered job.
+type JobStatus struct {
+	Job      *Job
+	Schedule string
+	// NextRunTime is zero if the job is not scheduled.
+	NextRunTime time.Time
+	Running     bool
+}
+
+type registeredJob struct {
+	job      *Job
+	schedule string
+	entryID  cron.EntryID
+	running  bool
+}
+
+type Scheduler struct {
+	Store *store.Store
+
+	cron *cron.Cron
+	// ctx is the context of the job runs, which is canceled when the server stops.
+	ctx context.Context
+	wg  sync.WaitGroup
+
+	mu   sync.Mutex
+	jobs []*registeredJob
+}
+
+func NewScheduler(store *store.Store) *Scheduler {
+	return &Scheduler{
+		Store: store,
+		cron:  cron.New(cron.WithChain(cron.Recover(cron.DefaultLogger))),
+		ctx:   context.Background(),
+	}
+}
+
+// Register adds a job to the scheduler. It must be called before Start.
+func (s *Scheduler) Register(job *Job) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.jobs = append(s.jobs, &registeredJob{
+		job:      job,
+		schedule: job.DefaultSchedule,
+	})
+}
+
+// Start applies the persisted schedules, runs every job once and then starts the cron scheduler.
+func (s *Scheduler) Start(ctx context.Context) error {
+	jobs, err := s.Store.ListJobs(ctx, &store.FindJob{})
+	if err != nil {
+		return errors.Wrap(err, "failed to list jobs")
+	}
+	schedules := map[string]string{}
+	for _, job := range jobs {
+		schedules[job.Name] = job.Schedule
+	}
+
+	s.mu.Lock()
+	s.ctx = ctx
+	for _, rj := range s.jobs {
+		if schedule, ok := schedules[rj.job.Name]; ok {
+			rj.schedule = schedule
+		}
+		if err := s.schedule(rj); err != nil {
+			slog.Warn("invalid job schedule, falling back to the default", "job", rj.job.Name, "schedule", rj.schedule, "error", err)
+			rj.schedule = rj.job.DefaultSchedule
+			if err := s.schedule(rj); err != nil {
+				s.mu.Unlock()
+				return errors.Wrapf(err, "invalid default schedule of job %s", rj.job.Name)
+			}
+		}
+	}
+	jobList := append([]*registeredJob{}, s.jobs...)
+	s.mu.Unlock()
+
+	for _, rj := range jobList {
+		if s.begin(rj) {
+			s.execute(rj)
+		}
+	}
+	s.cron.Start()
+	return nil
+}
+
+// Stop stops scheduling new runs and waits for the running jobs until ctx is done.
+func (s *Scheduler) Stop(ctx context.Context) {
+	cronCtx := s.cron.Stop()
+	done := make(chan struct{})
+	go func() {
+		<-cronCtx.Done()
+		s.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+}
+
+func (s *Scheduler) ListJobs() []*JobStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	list := []*JobStatus{}
+	for _, rj := range s.jobs {
+		list = append(list, s.status(rj))
+	}
+	return list
+}
+
+func (s *Scheduler) GetJob(name string) (*JobStatus, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rj := s.find(name)
+	if rj == nil {
+		return nil, ErrJobNotFound
+	}
+	return s.status(rj), nil
+}
+
+// UpdateSchedule persists the new schedule of a job and reschedules it.
+func (s *Scheduler) UpdateSchedule(ctx context.Context, name, schedule string) (*JobStatus, error) {
+	if schedule != "" {
+		if _, err := cron.ParseStandard(schedule); err != nil {
+			return nil, errors.Wrap(err, "invalid schedule")
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rj := s.find(name)
+	if rj == nil {
+		return nil, ErrJobNotFound
+	}
+	if _, err := s.Store.UpsertJob(ctx, &store.Job{
+		Name:     name,
+		Schedule: schedule,
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to upsert job")
+	}
+	rj.schedule = schedule
+	if err := s.schedule(rj); err != nil {
+		return nil, err
+	}
+	return s.status(rj), nil
+}
+
+// Trigger starts a run of the job in the background and returns the run record.
+func (s *Scheduler) Trigger(ctx context.Context, name string) (*store.JobRun, error) {
+	s.mu.Lock()
+	rj := s.find(name)
+	if rj == nil {
+		s.mu.Unlock()
+		return nil, ErrJobNotFound
+	}
+	if rj.running {
+		s.mu.Unlock()
+		return nil, ErrJobRunning
+	}
+	rj.running = true
+	s.mu.Unlock()
+
+	jobRun, err := s.Store.CreateJobRun(ctx, &store.JobRun{JobName: name})
+	if err != nil {
+		s.end(rj)
+		return nil, errors.Wrap(err, "failed to create job run")
+	}
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		_ = s.run(rj, jobRun)
+		s.prune(rj)
+	}()
+	return jobRun, nil
+}
+
+// schedule replaces the cron entry of the job with one for its current schedule.
+// The caller must hold s.mu.
+func (s *Scheduler) schedule(rj *registeredJob) error {
+	if rj.entryID != 0 {
+		s.cron.Remove(rj.entryID)
+		rj.entryID = 0
+	}
+	if rj.schedule == "" {
+		return nil
+	}
+	entryID, err := s.cron.AddFunc(rj.schedule, func() {
+		if !s.begin(rj) {
+			slog.Warn("skip job run since the previous run is still in progress", "job", rj.job.Name)
+			return
+		}
+		s.execute(rj)
+	})
+	if err != nil {
+		return err
+	}
+	rj.entryID = entryID
+	return nil
+}
+
+// begin marks the job as running, returning false if it is already running.
+func (s *Scheduler) begin(rj *registeredJob) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if rj.running {
+		return false
+	}
+	rj.running = true
+	return true
+}
+
+func (s *Scheduler) end(rj *registeredJob) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rj.running = false
+}
+
+// execute records a new run of the job and runs it. The job must have been marked as running.
+func (s *Scheduler) execute(rj *registeredJob) {
+	jobRun, err := s.Store.CreateJobRun(s.ctx, &store.JobRun{JobName: rj.job.Name})
+	if err != nil {
+		slog.Error("failed to create job run", "job", rj.job.Name, "error", err)
+		s.end(rj)
+		return
+	}
+	if errors.Is(s.run(rj, jobRun), ErrNothingToDo) {
+		if err := s.Store.DeleteJobRun(s.ctx, &store.DeleteJobRun{ID: jobRun.ID}); err != nil {
+			slog.Error("failed to delete job run", "job", rj.job.Name, "error", err)
+		}
+		return
+	}
+	s.prune(rj)
+}
+
+// run runs the job, records the end of the run and returns the error of the job.
+func (s *Scheduler) run(rj *registeredJob, jobRun *store.JobRun) error {
+	defer s.end(rj)
+
+	runErr := rj.job.Run(s.ctx)
+	errMessage := ""
+	if runErr != nil && !errors.Is(runErr, ErrNothingToDo) {
+		errMessage = runErr.Error()
+		slog.Error("job run failed", "job", rj.job.Name, "error", runErr)
+	}
+	endedTs := time.Now().Unix()
+	if err := s.Store.UpdateJobRun(s.ctx, &store.UpdateJobRun{
+		ID:      jobRun.ID,
+		EndedTs: &endedTs,
+		Error:   &errMessage,
+	}); err != nil {
+		slog.Error("failed to update job run", "job", rj.job.Name, "error", err)
+	}
+	return runErr
+}
+
+// prune deletes the runs of the job beyond the MaxJobRuns latest ones.
+func (s *Scheduler) prune(rj *registeredJob) {
+	if err := s.Store.PruneJobRuns(s.ctx, rj.job.Name, MaxJobRuns); err != nil {
+		slog.Error("failed to prune job runs", "job", rj.job.Name, "error", err)
+	}
+}
+
+// find returns the registered job with the given name. The caller must hold s.mu.
+func (s *Scheduler) find(name string) *registeredJob {
+	for _, rj := range s.jobs {
+		if rj.job.Name == name {
+			return rj
+		}
+	}
+	return nil
+}
+
+// status returns a snapshot of the job. The caller must hold s.mu.
+func (s *Scheduler) status(rj *registeredJob) *JobStatus {
+	jobStatus := &JobStatus{
+		Job:      rj.job,
+		Schedule: rj.schedule,
+		Running:  rj.running,
+	}
+	if rj.entryID != 0 {
+		jobStatus.NextRunTime = s.cron.Entry(rj.entryID).Next
+	}
+	return jobStatus
+}
@@ -0,0 +1,90 @@
+package scheduler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	// sqlite driver.
+	_ "modernc.org/sqlite"
+
+	"github.com/usememos/memos/server/profile"
+	"github.com/usememos/memos/store"
+	"github.com/usememos/memos/store/db"
+)
+
+func newTestingStore(ctx context.Context, t *testing.T) *store.Store {
+	profile := &profile.Profile{
+		Mode:   "prod",
+		Driver: "sqlite",
+		DSN:    ":memory:",
+	}
+	dbDriver, err := db.NewDBDriver(profile)
+	require.NoError(t, err)
+	stores := store.New(dbDriver, profile)
+	require.NoError(t, stores.Migrate(ctx))
+	t.Cleanup(func() {
+		stores.Close()
+	})
+	return stores
+}
+
+func TestSchedulerPrunesJobRuns(t *testing.T) {
+	ctx := context.Background()
+	stores := newTestingStore(ctx, t)
+
+	jobName := "test_job"
+	for i := 0; i < MaxJobRuns; i++ {
+		_, err := stores.CreateJobRun(ctx, &store.JobRun{
+			JobName:   jobName,
+			StartedTs: int64(i + 1),
+		})
+		require.NoError(t, err)
+	}
+
+	scheduler := NewScheduler(stores)
+	scheduler.Register(&Job{
+		Name: jobName,
+		Run: func(context.Context) error {
+			return nil
+		},
+	})
+	// The job runs once when the scheduler starts.
+	require.NoError(t, scheduler.Start(ctx))
+	scheduler.Stop(ctx)
+
+	jobRuns, err := stores.ListJobRuns(ctx, &store.FindJobRun{JobName: &jobName})
+	require.NoError(t, err)
+	require.Equal(t, MaxJobRuns, len(jobRuns))
+	require.NotNil(t, jobRuns[0].EndedTs)
+	// The oldest run is pruned.
+	require.Equal(t, int64(2), jobRuns[len(jobRuns)-1].StartedTs)
+}
+
+func TestSchedulerSkipsEmptyJobRuns(t *testing.T) {
+	ctx := context.Background()
+	stores := newTestingStore(ctx, t)
+
+	jobName := "test_job"
+	scheduler := NewScheduler(stores)
+	scheduler.Register(&Job{
+		Name: jobName,
+		Run: func(context.Context) error {
+			return ErrNothingToDo
+		},
+	})
+	require.NoError(t, scheduler.Start(ctx))
+	jobRuns, err := stores.ListJobRuns(ctx, &store.FindJobRun{JobName: &jobName})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(jobRuns))
+
+	// A triggered run is kept as a successful run.
+	jobRun, err := scheduler.Trigger(ctx, jobName)
+	require.NoError(t, err)
+	scheduler.Stop(ctx)
+	jobRun, err = stores.GetJobRun(ctx, &store.FindJobRun{ID: &jobRun.ID})
+	require.NoError(t, err)
+	require.NotNil(t, jobRun.EndedTs)
+	require.Empty(t, jobRun.Error)
+}
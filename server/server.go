@@ -24,7 +24,9 @@ import (
 	"github.com/usememos/memos/server/router/rss"
 	"github.com/usememos/memos/server/runner/memopayload"
 	"github.com/usememos/memos/server/runner/s3presign"
+	"github.com/usememos/memos/server/runner/trash"
 	"github.com/usememos/memos/server/runner/version"
+	"github.com/usememos/memos/server/scheduler"
 	"github.com/usememos/memos/store"
 )
 
@@ -33,6 +35,7 @@ type Server struct {
 	Profile *profile.Profile
 	Store   *store.Store
 
+	scheduler  *scheduler.Scheduler
 	echoServer *echo.Echo
 	grpcServer *grpc.Server
 }
@@ -81,10 +84,16 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 			apiv1.NewLoggerInterceptor().LoggerInterceptor,
 			grpcrecovery.UnaryServerInterceptor(),
 			apiv1.NewGRPCAuthInterceptor(store, secret).AuthenticationInterceptor,
+		),
+		grpc.ChainStreamInterceptor(
+			grpcrecovery.StreamServerInterceptor(),
+			apiv1.NewGRPCAuthInterceptor(store, secret).StreamAuthenticationInterceptor,
 		))
 	s.grpcServer = grpcServer
 
-	apiV1Service := apiv1.NewAPIV1Service(s.Secret, profile, store, grpcServer)
+	s.scheduler = scheduler.NewScheduler(store)
+	apiV1Service := apiv1.NewAPIV1Service(s.Secret, profile, store, s.scheduler, grpcServer)
+	s.registerJobs(apiV1Service)
 	// Register gRPC gateway as api v1.
 	if err := apiV1Service.RegisterGateway(ctx, echoServer); err != nil {
 		return nil, errors.Wrap(err, "failed to register gRPC gateway")
@@ -119,7 +128,9 @@ func (s *Server) Start(ctx context.Context) error {
 			slog.Error("mux server listen error", "error", err)
 		}
 	}()
-	s.StartBackgroundRunners(ctx)
+	if err := s.scheduler.Start(ctx); err != nil {
+		return errors.Wrap(err, "failed to start job scheduler")
+	}
 
 	return nil
 }
@@ -128,6 +139,9 @@ func (s *Server) Shutdown(ctx context.Context) {
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
+	// Stop background jobs.
+	s.scheduler.Stop(ctx)
+
 	// Shutdown echo server.
 	if err := s.echoServer.Shutdown(ctx); err != nil {
 		slog.Error("failed to shutdown server", slog.String("error", err.Error()))
@@ -141,17 +155,44 @@ func (s *Server) Shutdown(ctx context.Context) {
 	slog.Info("memos stopped properly")
 }
 
-func (s *Server) StartBackgroundRunners(ctx context.Context) {
-	s3presignRunner := s3presign.NewRunner(s.Store)
-	s3presignRunner.RunOnce(ctx)
-	versionRunner := version.NewRunner(s.Store, s.Profile)
-	versionRunner.RunOnce(ctx)
-	memopayloadRunner := memopayload.NewRunner(s.Store)
-	// Rebuild all memos' payload after server starts.
-	memopayloadRunner.RunOnce(ctx)
-
-	go s3presignRunner.Run(ctx)
-	go versionRunner.Run(ctx)
+// registerJobs registers the background runners as named jobs of the scheduler.
+func (s *Server) registerJobs(apiV1Service *apiv1.APIV1Service) {
+	s.scheduler.Register(&scheduler.Job{
+		Name:            "s3presign",
+		Description:     "Refresh the presigned URLs of resources stored in S3.",
+		DefaultSchedule: s3presign.DefaultSchedule,
+		Run:             s3presign.NewRunner(s.Store).RunOnce,
+	})
+	s.scheduler.Register(&scheduler.Job{
+		Name:            "version",
+		Description:     "Check for a new version and notify the host.",
+		DefaultSchedule: version.DefaultSchedule,
+		Run:             version.NewRunner(s.Store, s.Profile).RunOnce,
+	})
+	s.scheduler.Register(&scheduler.Job{
+		Name:            "memopayload",
+		Description:     "Rebuild the payload of all memos.",
+		DefaultSchedule: memopayload.DefaultSchedule,
+		Run:             memopayload.NewRunner(s.Store).RunOnce,
+	})
+	s.scheduler.Register(&scheduler.Job{
+		Name:            "trash",
+		Description:     "Purge the memos that have been in the trash longer than the retention period.",
+		DefaultSchedule: trash.DefaultSchedule,
+		Run:             trash.NewRunner(s.Store).RunOnce,
+	})
+	s.scheduler.Register(&scheduler.Job{
+		Name:            "memopublish",
+		Description:     "Publish the scheduled memos that are due.",
+		DefaultSchedule: apiv1.DefaultMemoPublishSchedule,
+		Run:             apiV1Service.PublishScheduledMemos,
+	})
+	s.scheduler.Register(&scheduler.Job{
+		Name:            "memoreminder",
+		Description:     "Remind the creators of the memos whose reminders are due.",
+		DefaultSchedule: apiv1.DefaultMemoReminderSchedule,
+		Run:             apiV1Service.SendMemoReminders,
+	})
 }
 
 func (s *Server) getOrUpsertWorkspaceBasicSetting(ctx context.Context) (*storepb.WorkspaceBasicSetting, error) {
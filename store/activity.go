@@ -9,8 +9,10 @@ import (
 type ActivityType string
 
 const (
-	ActivityTypeMemoComment   ActivityType = "MEMO_COMMENT"
-	ActivityTypeVersionUpdate ActivityType = "VERSION_UPDATE"
+	ActivityTypeMemoComment      ActivityType = "MEMO_COMMENT"
+	ActivityTypeVersionUpdate    ActivityType = "VERSION_UPDATE"
+	ActivityTypeMemoCollaborator ActivityType = "MEMO_COLLABORATOR"
+	ActivityTypeMemoReminder     ActivityType = "MEMO_REMINDER"
 )
 
 func (t ActivityType) String() string {
@@ -22,3 +22,13 @@ const (
 func (r RowStatus) String() string {
 	return string(r)
 }
+
+// Cursor is the sort key of the last row of a page, used for keyset pagination.
+// The rows after it in the order of the find are listed.
+type Cursor struct {
+	// Pinned is only used for memos ordered by pinned.
+	Pinned bool
+	// Ts is the timestamp the rows are ordered by.
+	Ts int64
+	ID int32
+}
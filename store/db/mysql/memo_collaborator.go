@@ -0,0 +1,78 @@
+package mysql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertMemoCollaborator(ctx context.Context, upsert *store.MemoCollaborator) (*store.MemoCollaborator, error) {
+	stmt := "INSERT INTO `memo_collaborator` (`memo_id`, `user_id`, `role`) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE `role` = ?"
+	if _, err := d.db.ExecContext(ctx, stmt, upsert.MemoID, upsert.UserID, upsert.Role, upsert.Role); err != nil {
+		return nil, err
+	}
+	list, err := d.ListMemoCollaborators(ctx, &store.FindMemoCollaborator{MemoID: &upsert.MemoID, UserID: &upsert.UserID})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) != 1 {
+		return nil, errors.Errorf("failed to upsert memo collaborator")
+	}
+	return list[0], nil
+}
+
+func (d *DB) ListMemoCollaborators(ctx context.Context, find *store.FindMemoCollaborator) ([]*store.MemoCollaborator, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.MemoID != nil {
+		where, args = append(where, "`memo_id` = ?"), append(args, *find.MemoID)
+	}
+	if find.UserID != nil {
+		where, args = append(where, "`user_id` = ?"), append(args, *find.UserID)
+	}
+
+	query := "SELECT `memo_id`, `user_id`, `role`, `created_ts` FROM `memo_collaborator` WHERE " + strings.Join(where, " AND ") + " ORDER BY `created_ts` ASC, `user_id` ASC"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoCollaborator{}
+	for rows.Next() {
+		collaborator := &store.MemoCollaborator{}
+		if err := rows.Scan(
+			&collaborator.MemoID,
+			&collaborator.UserID,
+			&collaborator.Role,
+			&collaborator.CreatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, collaborator)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteMemoCollaborator(ctx context.Context, delete *store.DeleteMemoCollaborator) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.MemoID != nil {
+		where, args = append(where, "`memo_id` = ?"), append(args, *delete.MemoID)
+	}
+	if delete.UserID != nil {
+		where, args = append(where, "`user_id` = ?"), append(args, *delete.UserID)
+	}
+
+	stmt := "DELETE FROM `memo_collaborator` WHERE " + strings.Join(where, " AND ")
+	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+		return err
+	}
+	return nil
+}
@@ -8,13 +8,18 @@ import (
 )
 
 func (d *DB) UpsertMemoOrganizer(ctx context.Context, upsert *store.MemoOrganizer) (*store.MemoOrganizer, error) {
-	stmt := "INSERT INTO `memo_organizer` (`memo_id`, `user_id`, `pinned`) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE `pinned` = ?"
-	if _, err := d.db.ExecContext(ctx, stmt, upsert.MemoID, upsert.UserID, upsert.Pinned, upsert.Pinned); err != nil {
+	stmt, args := buildUpsertMemoOrganizerStmt(upsert)
+	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
 		return nil, err
 	}
 	return upsert, nil
 }
 
+func buildUpsertMemoOrganizerStmt(upsert *store.MemoOrganizer) (string, []any) {
+	stmt := "INSERT INTO `memo_organizer` (`memo_id`, `user_id`, `pinned`) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE `pinned` = ?"
+	return stmt, []any{upsert.MemoID, upsert.UserID, upsert.Pinned, upsert.Pinned}
+}
+
 func (d *DB) ListMemoOrganizer(ctx context.Context, find *store.FindMemoOrganizer) ([]*store.MemoOrganizer, error) {
 	where, args := []string{"1 = 1"}, []any{}
 	if find.MemoID != 0 {
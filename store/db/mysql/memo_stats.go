@@ -0,0 +1,118 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) GetMemoStats(ctx context.Context, find *store.FindMemoStats) (*store.MemoStats, error) {
+	where, args := buildMemoStatsWhere(find)
+
+	stats := &store.MemoStats{
+		Buckets:   []*store.MemoStatsBucket{},
+		TagCounts: map[string]int32{},
+	}
+	query := fmt.Sprintf("SELECT FLOOR(UNIX_TIMESTAMP(`memo`.`created_ts`) / %d) * %d AS `bucket`, COUNT(*), ", store.MemoStatsBucketSeconds, store.MemoStatsBucketSeconds) +
+		"IFNULL(SUM(CAST(JSON_EXTRACT(`memo`.`payload`, '$.property.wordCount') AS SIGNED)), 0), " +
+		"COUNT(CASE WHEN JSON_EXTRACT(`memo`.`payload`, '$.property.hasLink') IS TRUE THEN 1 END), " +
+		"COUNT(CASE WHEN JSON_EXTRACT(`memo`.`payload`, '$.property.hasCode') IS TRUE THEN 1 END), " +
+		"COUNT(CASE WHEN JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') IS TRUE THEN 1 END), " +
+		"COUNT(CASE WHEN JSON_EXTRACT(`memo`.`payload`, '$.property.hasImage') IS TRUE THEN 1 END), " +
+		"IFNULL(SUM(CAST(JSON_EXTRACT(`memo`.`payload`, '$.property.incompleteTaskCount') AS SIGNED)), 0) " +
+		"FROM `memo` WHERE " + strings.Join(where, " AND ") + " GROUP BY `bucket` ORDER BY `bucket`"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		bucket := &store.MemoStatsBucket{}
+		if err := rows.Scan(
+			&bucket.Ts,
+			&bucket.MemoCount,
+			&bucket.WordCount,
+			&bucket.LinkCount,
+			&bucket.CodeCount,
+			&bucket.TaskListCount,
+			&bucket.ImageCount,
+			&bucket.IncompleteTaskCount,
+		); err != nil {
+			return nil, err
+		}
+		stats.Buckets = append(stats.Buckets, bucket)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	query = "SELECT `tag`.`name`, COUNT(*) FROM `memo`, JSON_TABLE(`memo`.`payload`, '$.tags[*]' COLUMNS (`name` VARCHAR(256) PATH '$')) AS `tag` WHERE " + strings.Join(where, " AND ") + " GROUP BY `tag`.`name`"
+	tagRows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer tagRows.Close()
+	for tagRows.Next() {
+		var tag string
+		var count int32
+		if err := tagRows.Scan(&tag, &count); err != nil {
+			return nil, err
+		}
+		stats.TagCounts[tag] = count
+	}
+	if err := tagRows.Err(); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+func (d *DB) ListMemoTags(ctx context.Context, find *store.FindMemoStats) ([]*store.MemoTag, error) {
+	where, args := buildMemoStatsWhere(find)
+	query := "SELECT `memo`.`id`, `tag`.`name`, UNIX_TIMESTAMP(`memo`.`updated_ts`) FROM `memo`, JSON_TABLE(`memo`.`payload`, '$.tags[*]' COLUMNS (`name` VARCHAR(256) PATH '$')) AS `tag` WHERE " + strings.Join(where, " AND ") + " ORDER BY `memo`.`id`"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoTag{}
+	for rows.Next() {
+		memoTag := &store.MemoTag{}
+		if err := rows.Scan(
+			&memoTag.MemoID,
+			&memoTag.Tag,
+			&memoTag.UpdatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, memoTag)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// buildMemoStatsWhere returns the conditions of the normal memos of the find, excluding comments and memos in trash.
+func buildMemoStatsWhere(find *store.FindMemoStats) ([]string, []any) {
+	where, args := []string{
+		"`memo`.`creator_id` = ?",
+		"`memo`.`row_status` = ?",
+		"`memo`.`trashed_ts` IS NULL",
+		"NOT EXISTS (SELECT 1 FROM `memo_relation` WHERE `memo_relation`.`memo_id` = `memo`.`id` AND `memo_relation`.`type` = 'COMMENT')",
+	}, []any{find.CreatorID, store.Normal}
+	if v := find.VisibilityList; len(v) != 0 {
+		placeholder := []string{}
+		for _, visibility := range v {
+			placeholder = append(placeholder, "?")
+			args = append(args, visibility.String())
+		}
+		where = append(where, fmt.Sprintf("`memo`.`visibility` IN (%s)", strings.Join(placeholder, ",")))
+	}
+	return where, args
+}
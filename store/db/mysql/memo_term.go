@@ -0,0 +1,136 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) SetMemoTerms(ctx context.Context, memoID int32, terms []*store.MemoTerm) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM `memo_term` WHERE `memo_id` = ?", memoID); err != nil {
+		return err
+	}
+	for _, term := range terms {
+		if _, err := tx.ExecContext(ctx, "INSERT INTO `memo_term` (`memo_id`, `term`, `frequency`) VALUES (?, ?, ?)", memoID, term.Term, term.Frequency); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (d *DB) ListMemoTerms(ctx context.Context, find *store.FindMemoTerm) ([]*store.MemoTerm, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.MemoIDList; len(v) != 0 {
+		placeholder := []string{}
+		for _, id := range v {
+			placeholder = append(placeholder, "?")
+			args = append(args, id)
+		}
+		where = append(where, fmt.Sprintf("`memo_id` IN (%s)", strings.Join(placeholder, ",")))
+	}
+	if v := find.TermList; len(v) != 0 {
+		placeholder := []string{}
+		for _, term := range v {
+			placeholder = append(placeholder, "?")
+			args = append(args, term)
+		}
+		where = append(where, fmt.Sprintf("`term` IN (%s)", strings.Join(placeholder, ",")))
+	}
+	if condition, conditionArgs := buildMemoTermVisibilityCondition(find.VisibilityList, find.UserID); condition != "" {
+		where, args = append(where, condition), append(args, conditionArgs...)
+	}
+
+	query := "SELECT `memo_id`, `term`, `frequency` FROM `memo_term` WHERE " + strings.Join(where, " AND ") + " ORDER BY `memo_id` ASC, `term` ASC"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoTerm{}
+	for rows.Next() {
+		term := &store.MemoTerm{}
+		if err := rows.Scan(
+			&term.MemoID,
+			&term.Term,
+			&term.Frequency,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, term)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) GetMemoTermStats(ctx context.Context, find *store.FindMemoTermStats) (*store.MemoTermStats, error) {
+	stats := &store.MemoTermStats{
+		MemoTermCounts: map[int32]int64{},
+	}
+	query, args := "SELECT COUNT(DISTINCT `memo_id`), IFNULL(SUM(`frequency`), 0) FROM `memo_term`", []any{}
+	if condition, conditionArgs := buildMemoTermVisibilityCondition(find.VisibilityList, find.UserID); condition != "" {
+		query, args = query+" WHERE "+condition, conditionArgs
+	}
+	if err := d.db.QueryRowContext(ctx, query, args...).Scan(&stats.MemoCount, &stats.TermCount); err != nil {
+		return nil, err
+	}
+	if len(find.MemoIDList) == 0 {
+		return stats, nil
+	}
+
+	placeholder, args := []string{}, []any{}
+	for _, id := range find.MemoIDList {
+		placeholder = append(placeholder, "?")
+		args = append(args, id)
+	}
+	query = fmt.Sprintf("SELECT `memo_id`, SUM(`frequency`) FROM `memo_term` WHERE `memo_id` IN (%s) GROUP BY `memo_id`", strings.Join(placeholder, ","))
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var memoID int32
+		var termCount int64
+		if err := rows.Scan(&memoID, &termCount); err != nil {
+			return nil, err
+		}
+		stats.MemoTermCounts[memoID] = termCount
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// buildMemoTermVisibilityCondition returns the condition restricting the terms to the memos with any of the visibilities,
+// the memos of the user and the memos the user is a collaborator of, or an empty string if the visibilities are not set.
+func buildMemoTermVisibilityCondition(visibilityList []store.Visibility, userID *int32) (string, []any) {
+	if len(visibilityList) == 0 {
+		return "", nil
+	}
+	placeholder, args := []string{}, []any{}
+	for _, visibility := range visibilityList {
+		placeholder = append(placeholder, "?")
+		args = append(args, visibility.String())
+	}
+	condition := fmt.Sprintf("`visibility` IN (%s)", strings.Join(placeholder, ","))
+	if userID != nil {
+		condition += " OR `creator_id` = ? OR `id` IN (SELECT `memo_id` FROM `memo_collaborator` WHERE `user_id` = ?)"
+		args = append(args, *userID, *userID)
+	}
+	return fmt.Sprintf("`memo_id` IN (SELECT `id` FROM `memo` WHERE %s)", condition), args
+}
@@ -0,0 +1,21 @@
+package mysql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildContentSearchCondition(t *testing.T) {
+	condition, args := buildContentSearchCondition("Running notes")
+	require.Equal(t, "MATCH(`memo`.`content`) AGAINST (? IN BOOLEAN MODE)", condition)
+	require.Equal(t, []any{"+running* +notes*"}, args)
+	require.NotContains(t, condition, "LIKE")
+
+	// The terms the index cannot match fall back to LIKE.
+	for _, term := range []string{"旅行", "go", "the"} {
+		condition, args = buildContentSearchCondition(term)
+		require.Equal(t, "`memo`.`content` LIKE ?", condition)
+		require.Equal(t, []any{"%" + term + "%"}, args)
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"strings"
 
 	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/usememos/memos/store"
 )
 
 var (
@@ -24,3 +26,26 @@ func placeholders(n int) string {
 	}
 	return strings.Join(list, ", ")
 }
+
+// buildCursorCondition returns the condition of the rows after the cursor, for rows ordered by
+// the pinned column descending if any, then by the ts and id columns in the same direction.
+func buildCursorCondition(cursor *store.Cursor, pinnedColumn, tsColumn, idColumn string, asc bool, args []any) (string, []any) {
+	operator := "<"
+	if asc {
+		operator = ">"
+	}
+	n := len(args)
+	if pinnedColumn != "" {
+		n += 2
+	}
+	condition := fmt.Sprintf("(%s %s %s OR (%s = %s AND %s %s %s))", tsColumn, operator, placeholder(n+1), tsColumn, placeholder(n+2), idColumn, operator, placeholder(n+3))
+	if pinnedColumn == "" {
+		return condition, append(args, cursor.Ts, cursor.Ts, cursor.ID)
+	}
+	pinned := 0
+	if cursor.Pinned {
+		pinned = 1
+	}
+	condition = fmt.Sprintf("(%s < %s OR (%s = %s AND %s))", pinnedColumn, placeholder(n-1), pinnedColumn, placeholder(n), condition)
+	return condition, append(args, pinned, pinned, cursor.Ts, cursor.Ts, cursor.ID)
+}
@@ -0,0 +1,141 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertJob(ctx context.Context, upsert *store.Job) (*store.Job, error) {
+	stmt := "INSERT INTO job (name, schedule, updated_ts) VALUES ($1, $2, $3) ON CONFLICT(name) DO UPDATE SET schedule = EXCLUDED.schedule, updated_ts = EXCLUDED.updated_ts"
+	if _, err := d.db.ExecContext(ctx, stmt, upsert.Name, upsert.Schedule, upsert.UpdatedTs); err != nil {
+		return nil, err
+	}
+
+	return upsert, nil
+}
+
+func (d *DB) ListJobs(ctx context.Context, find *store.FindJob) ([]*store.Job, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.Name != nil {
+		where, args = append(where, "name = "+placeholder(len(args)+1)), append(args, *find.Name)
+	}
+
+	query := "SELECT name, schedule, updated_ts FROM job WHERE " + strings.Join(where, " AND ") + " ORDER BY name ASC"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.Job{}
+	for rows.Next() {
+		job := &store.Job{}
+		if err := rows.Scan(
+			&job.Name,
+			&job.Schedule,
+			&job.UpdatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, job)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) CreateJobRun(ctx context.Context, create *store.JobRun) (*store.JobRun, error) {
+	fields := []string{"job_name"}
+	args := []any{create.JobName}
+
+	if create.StartedTs != 0 {
+		fields = append(fields, "started_ts")
+		args = append(args, create.StartedTs)
+	}
+
+	stmt := "INSERT INTO job_run (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, started_ts"
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+		&create.ID,
+		&create.StartedTs,
+	); err != nil {
+		return nil, err
+	}
+
+	return create, nil
+}
+
+func (d *DB) ListJobRuns(ctx context.Context, find *store.FindJobRun) ([]*store.JobRun, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *find.ID)
+	}
+	if find.JobName != nil {
+		where, args = append(where, "job_name = "+placeholder(len(args)+1)), append(args, *find.JobName)
+	}
+
+	query := "SELECT id, job_name, started_ts, ended_ts, error FROM job_run WHERE " + strings.Join(where, " AND ") + " ORDER BY started_ts DESC, id DESC"
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+		if find.Offset != nil {
+			query = fmt.Sprintf("%s OFFSET %d", query, *find.Offset)
+		}
+	}
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.JobRun{}
+	for rows.Next() {
+		jobRun := &store.JobRun{}
+		if err := rows.Scan(
+			&jobRun.ID,
+			&jobRun.JobName,
+			&jobRun.StartedTs,
+			&jobRun.EndedTs,
+			&jobRun.Error,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, jobRun)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateJobRun(ctx context.Context, update *store.UpdateJobRun) error {
+	set, args := []string{}, []any{}
+	if v := update.EndedTs; v != nil {
+		set, args = append(set, "ended_ts = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.Error; v != nil {
+		set, args = append(set, "error = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if len(set) == 0 {
+		return nil
+	}
+	args = append(args, update.ID)
+
+	stmt := "UPDATE job_run SET " + strings.Join(set, ", ") + " WHERE id = " + placeholder(len(args))
+	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (d *DB) DeleteJobRun(ctx context.Context, delete *store.DeleteJobRun) error {
+	_, err := d.db.ExecContext(ctx, "DELETE FROM job_run WHERE id = $1", delete.ID)
+	return err
+}
@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"strings"
 
@@ -32,6 +33,14 @@ func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, e
 		fields = append(fields, "updated_ts")
 		args = append(args, create.UpdatedTs)
 	}
+	if create.PublishTs != nil {
+		fields = append(fields, "publish_ts", "publish_visibility")
+		args = append(args, *create.PublishTs, create.PublishVisibility)
+	}
+	if create.RemindTs != nil {
+		fields = append(fields, "remind_ts")
+		args = append(args, *create.RemindTs)
+	}
 
 	stmt := "INSERT INTO memo (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts, updated_ts, row_status"
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
@@ -55,6 +64,14 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if v := find.UID; v != nil {
 		where, args = append(where, "memo.uid = "+placeholder(len(args)+1)), append(args, *v)
 	}
+	if v := find.IDList; len(v) != 0 {
+		holders := []string{}
+		for _, id := range v {
+			holders = append(holders, placeholder(len(args)+1))
+			args = append(args, id)
+		}
+		where = append(where, fmt.Sprintf("memo.id IN (%s)", strings.Join(holders, ", ")))
+	}
 	if v := find.CreatorID; v != nil {
 		where, args = append(where, "memo.creator_id = "+placeholder(len(args)+1)), append(args, *v)
 	}
@@ -73,10 +90,10 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if v := find.UpdatedTsAfter; v != nil {
 		where, args = append(where, "memo.updated_ts > "+placeholder(len(args)+1)), append(args, *v)
 	}
-	if v := find.ContentSearch; len(v) != 0 {
-		for _, s := range v {
-			where, args = append(where, "memo.content ILIKE "+placeholder(len(args)+1)), append(args, fmt.Sprintf("%%%s%%", s))
-		}
+	searchWords := store.SplitContentSearchWords(find.ContentSearch)
+	for _, term := range find.ContentSearch {
+		condition, conditionArgs := buildContentSearchCondition(term, len(args))
+		where, args = append(where, condition), append(args, conditionArgs...)
 	}
 	if v := find.VisibilityList; len(v) != 0 {
 		holders := []string{}
@@ -84,7 +101,12 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			holders = append(holders, placeholder(len(args)+1))
 			args = append(args, visibility.String())
 		}
-		where = append(where, fmt.Sprintf("memo.visibility in (%s)", strings.Join(holders, ", ")))
+		condition := fmt.Sprintf("memo.visibility in (%s)", strings.Join(holders, ", "))
+		if v := find.CollaboratorID; v != nil {
+			condition = fmt.Sprintf("(%s OR memo.id IN (SELECT memo_id FROM memo_collaborator WHERE user_id = %s))", condition, placeholder(len(args)+1))
+			args = append(args, *v)
+		}
+		where = append(where, condition)
 	}
 	if v := find.Pinned; v != nil {
 		where, args = append(where, "COALESCE(memo_organizer.pinned, 0) = "+placeholder(len(args)+1)), append(args, *v)
@@ -117,11 +139,50 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if find.ExcludeComments {
 		where = append(where, "memo_relation.related_memo_id IS NULL")
 	}
+	if find.Trashed {
+		where = append(where, "memo.trashed_ts IS NOT NULL")
+	} else {
+		where = append(where, "memo.trashed_ts IS NULL")
+	}
+	if v := find.TrashedTsBefore; v != nil {
+		where, args = append(where, "memo.trashed_ts < "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.PublishTsBefore; v != nil {
+		where, args = append(where, "memo.publish_ts < "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.RemindTsBefore; v != nil {
+		where, args = append(where, "memo.remind_ts < "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.Filter; v != nil {
+		condition, filterArgs, err := buildMemoFilterCondition(v, args)
+		if err != nil {
+			return nil, err
+		}
+		where, args = append(where, condition), filterArgs
+	}
+	if v := find.ParentID; v != nil {
+		where, args = append(where, "memo_relation.related_memo_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.Cursor; v != nil {
+		pinnedColumn := ""
+		if find.OrderByPinned {
+			pinnedColumn = "COALESCE(memo_organizer.pinned, 0)"
+		}
+		tsColumn := "memo.created_ts"
+		if find.OrderByUpdatedTs {
+			tsColumn = "memo.updated_ts"
+		}
+		condition, cursorArgs := buildCursorCondition(v, pinnedColumn, tsColumn, "memo.id", find.OrderByTimeAsc, args)
+		where, args = append(where, condition), cursorArgs
+	}
 
 	orders := []string{}
 	if find.OrderByPinned {
 		orders = append(orders, "pinned DESC")
 	}
+	if len(searchWords) != 0 {
+		orders, args = append(orders, fmt.Sprintf("ts_rank(memo.content_tsv, to_tsquery('english', %s)) DESC", placeholder(len(args)+1))), append(args, buildTextSearchQuery(searchWords))
+	}
 	order := "DESC"
 	if find.OrderByTimeAsc {
 		order = "ASC"
@@ -145,11 +206,20 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 		`memo.row_status AS row_status`,
 		`memo.visibility AS visibility`,
 		`memo.payload AS payload`,
+		`memo.trashed_ts AS trashed_ts`,
+		`memo.publish_ts AS publish_ts`,
+		`memo.publish_visibility AS publish_visibility`,
+		`memo.version AS version`,
+		`memo.remind_ts AS remind_ts`,
 		`COALESCE(memo_organizer.pinned, 0) AS pinned`,
 		`memo_relation.related_memo_id AS parent_id`,
 	}
 	if !find.ExcludeContent {
-		fields = append(fields, `memo.content AS content`)
+		if find.ContentPrefixLength > 0 {
+			fields = append(fields, fmt.Sprintf("LEFT(memo.content, %d) AS content", find.ContentPrefixLength))
+		} else {
+			fields = append(fields, `memo.content AS content`)
+		}
 	}
 
 	query := `SELECT ` + strings.Join(fields, ", ") + `
@@ -184,6 +254,11 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			&memo.RowStatus,
 			&memo.Visibility,
 			&payloadBytes,
+			&memo.TrashedTs,
+			&memo.PublishTs,
+			&memo.PublishVisibility,
+			&memo.Version,
+			&memo.RemindTs,
 			&memo.Pinned,
 			&memo.ParentID,
 		}
@@ -198,6 +273,9 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			return nil, errors.Wrap(err, "failed to unmarshal payload")
 		}
 		memo.Payload = payload
+		if len(searchWords) != 0 && !find.ExcludeContent {
+			memo.SearchSnippet = store.BuildSearchSnippet(memo.Content, searchWords)
+		}
 		list = append(list, &memo)
 	}
 
@@ -222,6 +300,127 @@ func (d *DB) GetMemo(ctx context.Context, find *store.FindMemo) (*store.Memo, er
 }
 
 func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
+	stmt, args, err := buildUpdateMemoStmt(update)
+	if err != nil {
+		return err
+	}
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+	if update.ExpectedVersion != nil {
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return store.ErrMemoVersionMismatch
+		}
+	}
+	return nil
+}
+
+func (d *DB) BatchUpdateMemos(ctx context.Context, updates []*store.UpdateMemo, organizers []*store.MemoOrganizer) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, update := range updates {
+		if err := updateMemoTx(ctx, tx, update); err != nil {
+			return err
+		}
+	}
+	for _, organizer := range organizers {
+		stmt, args := buildUpsertMemoOrganizerStmt(organizer)
+		if _, err := tx.ExecContext(ctx, stmt, args...); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (d *DB) MergeMemos(ctx context.Context, merge *store.MergeMemos) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	memoID := merge.Update.ID
+	memoIDs := []any{memoID}
+	for _, merged := range merge.Merged {
+		memoIDs = append(memoIDs, merged.Update.ID)
+	}
+	// buildMemoIDList returns the list of the IDs of the memos of the merge, with placeholders following the given number of arguments.
+	buildMemoIDList := func(argCount int) string {
+		holders := []string{}
+		for i := range memoIDs {
+			holders = append(holders, placeholder(argCount+i+1))
+		}
+		return strings.Join(holders, ", ")
+	}
+	for _, merged := range merge.Merged {
+		mergedID := merged.Update.ID
+		if _, err := tx.ExecContext(ctx, "UPDATE resource SET memo_id = $1 WHERE memo_id = $2", memoID, mergedID); err != nil {
+			return err
+		}
+		stmt := "INSERT INTO memo_relation (memo_id, related_memo_id, type) SELECT CAST($1 AS INTEGER), related_memo_id, type FROM memo_relation WHERE memo_id = $2 AND type != $3 AND related_memo_id NOT IN (" + buildMemoIDList(3) + ") ON CONFLICT DO NOTHING"
+		if _, err := tx.ExecContext(ctx, stmt, append([]any{memoID, mergedID, store.MemoRelationComment}, memoIDs...)...); err != nil {
+			return err
+		}
+		stmt = "INSERT INTO memo_relation (memo_id, related_memo_id, type) SELECT memo_id, CAST($1 AS INTEGER), type FROM memo_relation WHERE related_memo_id = $2 AND memo_id NOT IN (" + buildMemoIDList(2) + ") ON CONFLICT DO NOTHING"
+		if _, err := tx.ExecContext(ctx, stmt, append([]any{memoID, mergedID}, memoIDs...)...); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, "DELETE FROM memo_relation WHERE related_memo_id = $1", mergedID); err != nil {
+			return err
+		}
+		stmt = "UPDATE reaction SET content_id = $1 WHERE content_id = $2 AND NOT EXISTS (SELECT 1 FROM reaction AS existing WHERE existing.content_id = $1 AND existing.creator_id = reaction.creator_id AND existing.reaction_type = reaction.reaction_type)"
+		if _, err := tx.ExecContext(ctx, stmt, merge.ContentID, merged.ContentID); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, "DELETE FROM reaction WHERE content_id = $1", merged.ContentID); err != nil {
+			return err
+		}
+		if err := updateMemoTx(ctx, tx, merged.Update); err != nil {
+			return err
+		}
+	}
+	if err := updateMemoTx(ctx, tx, merge.Update); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// updateMemoTx applies the update of the memo in the transaction, failing with store.ErrMemoVersionMismatch
+// if the memo is not at the expected version of the update.
+func updateMemoTx(ctx context.Context, tx *sql.Tx, update *store.UpdateMemo) error {
+	stmt, args, err := buildUpdateMemoStmt(update)
+	if err != nil {
+		return err
+	}
+	result, err := tx.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+	if update.ExpectedVersion != nil {
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return store.ErrMemoVersionMismatch
+		}
+	}
+	return nil
+}
+
+// buildUpdateMemoStmt builds the statement updating the memo, which is shared by the single and the batch updates.
+func buildUpdateMemoStmt(update *store.UpdateMemo) (string, []any, error) {
 	set, args := []string{}, []any{}
 	if v := update.UID; v != nil {
 		set, args = append(set, "uid = "+placeholder(len(args)+1)), append(args, *v)
@@ -241,20 +440,52 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 	if v := update.Visibility; v != nil {
 		set, args = append(set, "visibility = "+placeholder(len(args)+1)), append(args, *v)
 	}
+	if v := update.TrashedTs; v != nil {
+		if *v == 0 {
+			set = append(set, "trashed_ts = NULL")
+		} else {
+			set, args = append(set, "trashed_ts = "+placeholder(len(args)+1)), append(args, *v)
+		}
+	}
+	if v := update.PublishTs; v != nil {
+		if *v == 0 {
+			set = append(set, "publish_ts = NULL")
+		} else {
+			set, args = append(set, "publish_ts = "+placeholder(len(args)+1)), append(args, *v)
+		}
+	}
+	if v := update.PublishVisibility; v != nil {
+		set, args = append(set, "publish_visibility = "+placeholder(len(args)+1)), append(args, *v)
+	}
+
+	// The version is incremented on every update, so that concurrent updates can be detected.
+	// Setting only the payload or the reminder is not an edit of the memo itself, such as when
+	// the properties derived from its resources or the next reminder are refreshed.
+	if len(set) > 0 || (update.Payload == nil && update.RemindTs == nil) {
+		set = append(set, "version = version + 1")
+	}
 	if v := update.Payload; v != nil {
 		payloadBytes, err := protojson.Marshal(v)
 		if err != nil {
-			return err
+			return "", nil, err
 		}
 		set, args = append(set, "payload = "+placeholder(len(args)+1)), append(args, string(payloadBytes))
 	}
+	if v := update.RemindTs; v != nil {
+		if *v == 0 {
+			set = append(set, "remind_ts = NULL")
+		} else {
+			set, args = append(set, "remind_ts = "+placeholder(len(args)+1)), append(args, *v)
+		}
+	}
 
 	stmt := `UPDATE memo SET ` + strings.Join(set, ", ") + ` WHERE id = ` + placeholder(len(args)+1)
 	args = append(args, update.ID)
-	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
-		return err
+	if v := update.ExpectedVersion; v != nil {
+		stmt += ` AND version = ` + placeholder(len(args)+1)
+		args = append(args, *v)
 	}
-	return nil
+	return stmt, args, nil
 }
 
 func (d *DB) DeleteMemo(ctx context.Context, delete *store.DeleteMemo) error {
@@ -269,3 +500,56 @@ func (d *DB) DeleteMemo(ctx context.Context, delete *store.DeleteMemo) error {
 	}
 	return nil
 }
+
+// PurgeMemos deletes the memos along with the rows that depend on them in one transaction.
+// The blobs of their resources are left to the caller.
+func (d *DB) PurgeMemos(ctx context.Context, memoIDs []int32) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	args := []any{}
+	for _, id := range memoIDs {
+		args = append(args, id)
+	}
+	memoIDList := placeholders(len(memoIDs))
+	// The memos are deleted last, after the rows that depend on them.
+	stmts := []string{
+		fmt.Sprintf("DELETE FROM memo_relation WHERE memo_id IN (%s) OR related_memo_id IN (%s)", memoIDList, memoIDList),
+		fmt.Sprintf("DELETE FROM memo_revision WHERE memo_id IN (%s)", memoIDList),
+		fmt.Sprintf("DELETE FROM memo_share WHERE memo_id IN (%s)", memoIDList),
+		fmt.Sprintf("DELETE FROM memo_collaborator WHERE memo_id IN (%s)", memoIDList),
+		fmt.Sprintf("DELETE FROM memo_alias WHERE memo_id IN (%s)", memoIDList),
+		fmt.Sprintf("DELETE FROM memo_term WHERE memo_id IN (%s)", memoIDList),
+		fmt.Sprintf("DELETE FROM resource WHERE memo_id IN (%s)", memoIDList),
+		fmt.Sprintf("DELETE FROM memo WHERE id IN (%s)", memoIDList),
+	}
+	for _, stmt := range stmts {
+		if _, err := tx.ExecContext(ctx, stmt, args...); err != nil {
+			return errors.Wrap(err, "failed to purge memos")
+		}
+	}
+
+	return tx.Commit()
+}
+
+// buildContentSearchCondition returns the condition of the memos whose content matches the search term,
+// with its placeholders following the given number of arguments.
+// Only the terms the index cannot match are matched with ILIKE, which scans the whole table.
+func buildContentSearchCondition(term string, argCount int) (string, []any) {
+	if store.IsFullTextSearchable(term) {
+		return fmt.Sprintf("memo.content_tsv @@ to_tsquery('english', %s)", placeholder(argCount+1)), []any{buildTextSearchQuery(store.SplitContentSearchWords([]string{term}))}
+	}
+	return "memo.content ILIKE " + placeholder(argCount+1), []any{fmt.Sprintf("%%%s%%", term)}
+}
+
+// buildTextSearchQuery builds a tsquery that requires every word, matching words by prefix.
+func buildTextSearchQuery(words []string) string {
+	terms := []string{}
+	for _, word := range words {
+		terms = append(terms, word+":*")
+	}
+	return strings.Join(terms, " & ")
+}
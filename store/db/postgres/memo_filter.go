@@ -0,0 +1,135 @@
+package postgres
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+// buildMemoFilterCondition renders the memo filter to a condition on the memo query, appending its arguments to args.
+// The placeholders are numbered after the arguments already in args.
+func buildMemoFilterCondition(filter *store.MemoFilter, args []any) (string, []any, error) {
+	switch filter.Operator {
+	case store.MemoFilterOperatorAnd, store.MemoFilterOperatorOr:
+		conditions := []string{}
+		for _, child := range filter.Children {
+			condition, childArgs, err := buildMemoFilterCondition(child, args)
+			if err != nil {
+				return "", nil, err
+			}
+			conditions, args = append(conditions, condition), childArgs
+		}
+		return "(" + strings.Join(conditions, fmt.Sprintf(" %s ", filter.Operator)) + ")", args, nil
+	case store.MemoFilterOperatorNot:
+		condition, args, err := buildMemoFilterCondition(filter.Children[0], args)
+		if err != nil {
+			return "", nil, err
+		}
+		return "NOT (" + condition + ")", args, nil
+	}
+
+	switch filter.Field {
+	case store.MemoFilterFieldTag:
+		return buildMemoFilterTagCondition(filter, args)
+	case store.MemoFilterFieldLocation:
+		return buildMemoFilterLocationCondition(filter, args)
+	case store.MemoFilterFieldPinned, store.MemoFilterFieldHasLink, store.MemoFilterFieldHasTaskList, store.MemoFilterFieldHasCode, store.MemoFilterFieldHasIncompleteTasks, store.MemoFilterFieldHasImage:
+		condition := memoFilterFlagConditions[filter.Field]
+		if filter.Values[0].(bool) != (filter.Operator == store.MemoFilterOperatorEqual) {
+			condition = "NOT (" + condition + ")"
+		}
+		return condition, args, nil
+	}
+
+	column, ok := memoFilterColumns[filter.Field]
+	if !ok {
+		return "", nil, errors.Errorf("unsupported memo filter field %q", filter.Field)
+	}
+	if filter.Operator == store.MemoFilterOperatorIn {
+		if len(filter.Values) == 0 {
+			return "1 = 0", args, nil
+		}
+		holders := []string{}
+		for _, value := range filter.Values {
+			holders, args = append(holders, placeholder(len(args)+1)), append(args, value)
+		}
+		return fmt.Sprintf("%s IN (%s)", column, strings.Join(holders, ", ")), args, nil
+	}
+	return fmt.Sprintf("%s %s %s", column, filter.Operator, placeholder(len(args)+1)), append(args, filter.Values[0]), nil
+}
+
+// buildMemoFilterTagCondition matches the memos with the tag or any of its sub-tags.
+// The tags are missing from the payload when the memo has none, which EXISTS treats as no match.
+func buildMemoFilterTagCondition(filter *store.MemoFilter, args []any) (string, []any, error) {
+	conditions := []string{}
+	for _, value := range filter.Values {
+		tag := value.(string)
+		conditions = append(conditions, "EXISTS (SELECT 1 FROM jsonb_array_elements(COALESCE(memo.payload->'tags', '[]'::jsonb)) AS tag WHERE tag::text = "+placeholder(len(args)+1)+" OR tag::text LIKE "+placeholder(len(args)+2)+")")
+		args = append(args, fmt.Sprintf(`"%s"`, tag), fmt.Sprintf(`"%s/%%"`, tag))
+	}
+	if len(conditions) == 0 {
+		return "1 = 0", args, nil
+	}
+	condition := "(" + strings.Join(conditions, " OR ") + ")"
+	if filter.Operator == store.MemoFilterOperatorNotEqual {
+		condition = "NOT " + condition
+	}
+	return condition, args, nil
+}
+
+// buildMemoFilterLocationCondition matches the memos by the coordinates of their location.
+// The condition is false rather than NULL for the memos without coordinates, so that it still holds a value under NOT.
+func buildMemoFilterLocationCondition(filter *store.MemoFilter, args []any) (string, []any, error) {
+	condition := memoFilterHasCoordinates
+	switch filter.Operator {
+	case store.MemoFilterOperatorWithinBox:
+		south, west, north, east := filter.Values[0].(float64), filter.Values[1].(float64), filter.Values[2].(float64), filter.Values[3].(float64)
+		condition, args = condition+fmt.Sprintf(" AND %s BETWEEN %s AND %s", memoFilterLatitude, placeholder(len(args)+1), placeholder(len(args)+2)), append(args, south, north)
+		if west <= east {
+			condition, args = condition+fmt.Sprintf(" AND %s BETWEEN %s AND %s", memoFilterLongitude, placeholder(len(args)+1), placeholder(len(args)+2)), append(args, west, east)
+		} else {
+			// The box crosses the antimeridian.
+			condition, args = condition+fmt.Sprintf(" AND (%s >= %s OR %s <= %s)", memoFilterLongitude, placeholder(len(args)+1), memoFilterLongitude, placeholder(len(args)+2)), append(args, west, east)
+		}
+	case store.MemoFilterOperatorWithinRadius:
+		latitude, longitude, radius := filter.Values[0].(float64), filter.Values[1].(float64), filter.Values[2].(float64)
+		// The haversine formula of the distance from the center.
+		condition += fmt.Sprintf(
+			" AND POWER(SIN(RADIANS(%s - %s) / 2), 2) + COS(RADIANS(%s)) * COS(RADIANS(%s)) * POWER(SIN(RADIANS(%s - %s) / 2), 2) <= %s",
+			memoFilterLatitude, placeholder(len(args)+1), placeholder(len(args)+2), memoFilterLatitude, memoFilterLongitude, placeholder(len(args)+3), placeholder(len(args)+4),
+		)
+		args = append(args, latitude, latitude, longitude, store.GetDistanceHaversine(radius))
+	default:
+		return "", nil, errors.Errorf("unsupported memo filter operator %q for %s", filter.Operator, filter.Field)
+	}
+	return "(" + condition + ")", args, nil
+}
+
+// Zero coordinates are missing from the payload, so a memo has coordinates if either is present.
+const (
+	memoFilterHasCoordinates = "(memo.payload->'location'->'latitude' IS NOT NULL OR memo.payload->'location'->'longitude' IS NOT NULL)"
+	memoFilterLatitude       = "COALESCE((memo.payload->'location'->>'latitude')::DOUBLE PRECISION, 0)"
+	memoFilterLongitude      = "COALESCE((memo.payload->'location'->>'longitude')::DOUBLE PRECISION, 0)"
+)
+
+var memoFilterColumns = map[store.MemoFilterField]string{
+	store.MemoFilterFieldID:         "memo.id",
+	store.MemoFilterFieldUID:        "memo.uid",
+	store.MemoFilterFieldCreatorID:  "memo.creator_id",
+	store.MemoFilterFieldCreatedTs:  "memo.created_ts",
+	store.MemoFilterFieldUpdatedTs:  "memo.updated_ts",
+	store.MemoFilterFieldRowStatus:  "memo.row_status",
+	store.MemoFilterFieldVisibility: "memo.visibility",
+}
+
+var memoFilterFlagConditions = map[store.MemoFilterField]string{
+	store.MemoFilterFieldPinned:             "COALESCE(memo_organizer.pinned, 0) = 1",
+	store.MemoFilterFieldHasLink:            "(memo.payload->'property'->>'hasLink')::BOOLEAN IS TRUE",
+	store.MemoFilterFieldHasTaskList:        "(memo.payload->'property'->>'hasTaskList')::BOOLEAN IS TRUE",
+	store.MemoFilterFieldHasCode:            "(memo.payload->'property'->>'hasCode')::BOOLEAN IS TRUE",
+	store.MemoFilterFieldHasIncompleteTasks: "(memo.payload->'property'->>'hasIncompleteTasks')::BOOLEAN IS TRUE",
+	store.MemoFilterFieldHasImage:           "(memo.payload->'property'->>'hasImage')::BOOLEAN IS TRUE",
+}
@@ -9,6 +9,15 @@ import (
 )
 
 func (d *DB) UpsertMemoOrganizer(ctx context.Context, upsert *store.MemoOrganizer) (*store.MemoOrganizer, error) {
+	stmt, args := buildUpsertMemoOrganizerStmt(upsert)
+	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+		return nil, err
+	}
+
+	return upsert, nil
+}
+
+func buildUpsertMemoOrganizerStmt(upsert *store.MemoOrganizer) (string, []any) {
 	pinned := 0
 	if upsert.Pinned {
 		pinned = 1
@@ -22,11 +31,7 @@ func (d *DB) UpsertMemoOrganizer(ctx context.Context, upsert *store.MemoOrganize
 		VALUES (` + placeholders(3) + `)
 		ON CONFLICT(memo_id, user_id) DO UPDATE 
 		SET pinned = EXCLUDED.pinned`
-	if _, err := d.db.ExecContext(ctx, stmt, upsert.MemoID, upsert.UserID, pinned); err != nil {
-		return nil, err
-	}
-
-	return upsert, nil
+	return stmt, []any{upsert.MemoID, upsert.UserID, pinned}
 }
 
 func (d *DB) ListMemoOrganizer(ctx context.Context, find *store.FindMemoOrganizer) ([]*store.MemoOrganizer, error) {
@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateMemoShare(ctx context.Context, create *store.MemoShare) (*store.MemoShare, error) {
+	fields := []string{"memo_id", "creator_id", "token", "password_hash", "expires_ts"}
+	args := []any{create.MemoID, create.CreatorID, create.Token, create.PasswordHash, create.ExpiresTs}
+
+	stmt := "INSERT INTO memo_share (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts"
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+		&create.ID,
+		&create.CreatedTs,
+	); err != nil {
+		return nil, err
+	}
+
+	return create, nil
+}
+
+func (d *DB) ListMemoShares(ctx context.Context, find *store.FindMemoShare) ([]*store.MemoShare, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *find.ID)
+	}
+	if find.MemoID != nil {
+		where, args = append(where, "memo_id = "+placeholder(len(args)+1)), append(args, *find.MemoID)
+	}
+	if find.Token != nil {
+		where, args = append(where, "token = "+placeholder(len(args)+1)), append(args, *find.Token)
+	}
+
+	query := "SELECT id, memo_id, creator_id, created_ts, token, password_hash, expires_ts, access_count, last_accessed_ts FROM memo_share WHERE " + strings.Join(where, " AND ") + " ORDER BY created_ts DESC, id DESC"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoShare{}
+	for rows.Next() {
+		share := &store.MemoShare{}
+		if err := rows.Scan(
+			&share.ID,
+			&share.MemoID,
+			&share.CreatorID,
+			&share.CreatedTs,
+			&share.Token,
+			&share.PasswordHash,
+			&share.ExpiresTs,
+			&share.AccessCount,
+			&share.LastAccessedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, share)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) RecordMemoShareAccess(ctx context.Context, id int32, accessedTs int64) error {
+	stmt := "UPDATE memo_share SET access_count = access_count + 1, last_accessed_ts = " + placeholder(1) + " WHERE id = " + placeholder(2)
+	if _, err := d.db.ExecContext(ctx, stmt, accessedTs, id); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (d *DB) DeleteMemoShare(ctx context.Context, delete *store.DeleteMemoShare) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.ID != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *delete.ID)
+	}
+	if delete.MemoID != nil {
+		where, args = append(where, "memo_id = "+placeholder(len(args)+1)), append(args, *delete.MemoID)
+	}
+
+	stmt := "DELETE FROM memo_share WHERE " + strings.Join(where, " AND ")
+	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+		return err
+	}
+	return nil
+}
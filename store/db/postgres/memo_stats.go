@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) GetMemoStats(ctx context.Context, find *store.FindMemoStats) (*store.MemoStats, error) {
+	where, args := buildMemoStatsWhere(find)
+
+	stats := &store.MemoStats{
+		Buckets:   []*store.MemoStatsBucket{},
+		TagCounts: map[string]int32{},
+	}
+	query := fmt.Sprintf("SELECT memo.created_ts / %d * %d AS bucket, COUNT(*), ", store.MemoStatsBucketSeconds, store.MemoStatsBucketSeconds) +
+		"COALESCE(SUM((memo.payload->'property'->>'wordCount')::BIGINT), 0)::BIGINT, " +
+		"COUNT(CASE WHEN (memo.payload->'property'->>'hasLink')::BOOLEAN IS TRUE THEN 1 END), " +
+		"COUNT(CASE WHEN (memo.payload->'property'->>'hasCode')::BOOLEAN IS TRUE THEN 1 END), " +
+		"COUNT(CASE WHEN (memo.payload->'property'->>'hasTaskList')::BOOLEAN IS TRUE THEN 1 END), " +
+		"COUNT(CASE WHEN (memo.payload->'property'->>'hasImage')::BOOLEAN IS TRUE THEN 1 END), " +
+		"COALESCE(SUM((memo.payload->'property'->>'incompleteTaskCount')::BIGINT), 0)::BIGINT " +
+		"FROM memo WHERE " + strings.Join(where, " AND ") + " GROUP BY bucket ORDER BY bucket"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		bucket := &store.MemoStatsBucket{}
+		if err := rows.Scan(
+			&bucket.Ts,
+			&bucket.MemoCount,
+			&bucket.WordCount,
+			&bucket.LinkCount,
+			&bucket.CodeCount,
+			&bucket.TaskListCount,
+			&bucket.ImageCount,
+			&bucket.IncompleteTaskCount,
+		); err != nil {
+			return nil, err
+		}
+		stats.Buckets = append(stats.Buckets, bucket)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	query = "SELECT tag, COUNT(*) FROM memo, jsonb_array_elements_text(COALESCE(memo.payload->'tags', '[]'::jsonb)) AS tag WHERE " + strings.Join(where, " AND ") + " GROUP BY tag"
+	tagRows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer tagRows.Close()
+	for tagRows.Next() {
+		var tag string
+		var count int32
+		if err := tagRows.Scan(&tag, &count); err != nil {
+			return nil, err
+		}
+		stats.TagCounts[tag] = count
+	}
+	if err := tagRows.Err(); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+func (d *DB) ListMemoTags(ctx context.Context, find *store.FindMemoStats) ([]*store.MemoTag, error) {
+	where, args := buildMemoStatsWhere(find)
+	query := "SELECT memo.id, tag, memo.updated_ts FROM memo, jsonb_array_elements_text(COALESCE(memo.payload->'tags', '[]'::jsonb)) AS tag WHERE " + strings.Join(where, " AND ") + " ORDER BY memo.id"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoTag{}
+	for rows.Next() {
+		memoTag := &store.MemoTag{}
+		if err := rows.Scan(
+			&memoTag.MemoID,
+			&memoTag.Tag,
+			&memoTag.UpdatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, memoTag)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// buildMemoStatsWhere returns the conditions of the normal memos of the find, excluding comments and memos in trash.
+func buildMemoStatsWhere(find *store.FindMemoStats) ([]string, []any) {
+	where, args := []string{
+		"memo.creator_id = " + placeholder(1),
+		"memo.row_status = " + placeholder(2),
+		"memo.trashed_ts IS NULL",
+		"NOT EXISTS (SELECT 1 FROM memo_relation WHERE memo_relation.memo_id = memo.id AND memo_relation.type = 'COMMENT')",
+	}, []any{find.CreatorID, store.Normal}
+	if v := find.VisibilityList; len(v) != 0 {
+		holders := []string{}
+		for _, visibility := range v {
+			holders = append(holders, placeholder(len(args)+1))
+			args = append(args, visibility.String())
+		}
+		where = append(where, fmt.Sprintf("memo.visibility IN (%s)", strings.Join(holders, ", ")))
+	}
+	return where, args
+}
@@ -0,0 +1,21 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildContentSearchCondition(t *testing.T) {
+	condition, args := buildContentSearchCondition("Running notes", 2)
+	require.Equal(t, "memo.content_tsv @@ to_tsquery('english', $3)", condition)
+	require.Equal(t, []any{"running:* & notes:*"}, args)
+	require.NotContains(t, condition, "LIKE")
+
+	// The terms the index cannot match fall back to ILIKE.
+	for _, term := range []string{"旅行", "go", "the"} {
+		condition, args = buildContentSearchCondition(term, 0)
+		require.Equal(t, "memo.content ILIKE $1", condition)
+		require.Equal(t, []any{"%" + term + "%"}, args)
+	}
+}
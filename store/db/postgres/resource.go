@@ -63,6 +63,10 @@ func (d *DB) ListResources(ctx context.Context, find *store.FindResource) ([]*st
 	if v := find.StorageType; v != nil {
 		where, args = append(where, "storage_type = "+placeholder(len(args)+1)), append(args, v.String())
 	}
+	if v := find.Cursor; v != nil {
+		condition, cursorArgs := buildCursorCondition(v, "", "updated_ts", "id", false, args)
+		where, args = append(where, condition), cursorArgs
+	}
 
 	fields := []string{"id", "uid", "filename", "type", "size", "creator_id", "created_ts", "updated_ts", "memo_id", "storage_type", "reference", "payload"}
 	if find.GetBlob {
@@ -74,7 +78,7 @@ func (d *DB) ListResources(ctx context.Context, find *store.FindResource) ([]*st
 			%s
 		FROM resource
 		WHERE %s
-		ORDER BY updated_ts DESC
+		ORDER BY updated_ts DESC, id DESC
 	`, strings.Join(fields, ", "), strings.Join(where, " AND "))
 	if find.Limit != nil {
 		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
@@ -1,9 +1,34 @@
 package sqlite
 
-import "google.golang.org/protobuf/encoding/protojson"
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/usememos/memos/store"
+)
 
 var (
 	protojsonUnmarshaler = protojson.UnmarshalOptions{
 		DiscardUnknown: true,
 	}
 )
+
+// buildCursorCondition returns the condition of the rows after the cursor, for rows ordered by
+// the pinned column descending if any, then by the ts and id columns in the same direction.
+func buildCursorCondition(cursor *store.Cursor, pinnedColumn, tsColumn, idColumn string, asc bool, args []any) (string, []any) {
+	operator := "<"
+	if asc {
+		operator = ">"
+	}
+	condition := fmt.Sprintf("(%s %s ? OR (%s = ? AND %s %s ?))", tsColumn, operator, tsColumn, idColumn, operator)
+	if pinnedColumn == "" {
+		return condition, append(args, cursor.Ts, cursor.Ts, cursor.ID)
+	}
+	pinned := 0
+	if cursor.Pinned {
+		pinned = 1
+	}
+	condition = fmt.Sprintf("(%s < ? OR (%s = ? AND %s))", pinnedColumn, pinnedColumn, condition)
+	return condition, append(args, pinned, pinned, cursor.Ts, cursor.Ts, cursor.ID)
+}
@@ -2,6 +2,7 @@ package sqlite
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"strings"
 
@@ -35,6 +36,16 @@ func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, e
 		placeholder = append(placeholder, "?")
 		args = append(args, create.UpdatedTs)
 	}
+	if create.PublishTs != nil {
+		fields = append(fields, "`publish_ts`", "`publish_visibility`")
+		placeholder = append(placeholder, "?", "?")
+		args = append(args, *create.PublishTs, create.PublishVisibility)
+	}
+	if create.RemindTs != nil {
+		fields = append(fields, "`remind_ts`")
+		placeholder = append(placeholder, "?")
+		args = append(args, *create.RemindTs)
+	}
 
 	stmt := "INSERT INTO `memo` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ") RETURNING `id`, `created_ts`, `updated_ts`, `row_status`"
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
@@ -58,6 +69,14 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if v := find.UID; v != nil {
 		where, args = append(where, "`memo`.`uid` = ?"), append(args, *v)
 	}
+	if v := find.IDList; len(v) != 0 {
+		placeholder := []string{}
+		for _, id := range v {
+			placeholder = append(placeholder, "?")
+			args = append(args, id)
+		}
+		where = append(where, fmt.Sprintf("`memo`.`id` IN (%s)", strings.Join(placeholder, ",")))
+	}
 	if v := find.CreatorID; v != nil {
 		where, args = append(where, "`memo`.`creator_id` = ?"), append(args, *v)
 	}
@@ -76,10 +95,10 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if v := find.UpdatedTsAfter; v != nil {
 		where, args = append(where, "`memo`.`updated_ts` > ?"), append(args, *v)
 	}
-	if v := find.ContentSearch; len(v) != 0 {
-		for _, s := range v {
-			where, args = append(where, "`memo`.`content` LIKE ?"), append(args, fmt.Sprintf("%%%s%%", s))
-		}
+	searchWords := store.SplitContentSearchWords(find.ContentSearch)
+	for _, term := range find.ContentSearch {
+		condition, conditionArgs := buildContentSearchCondition(term)
+		where, args = append(where, condition), append(args, conditionArgs...)
 	}
 	if v := find.VisibilityList; len(v) != 0 {
 		placeholder := []string{}
@@ -87,7 +106,12 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			placeholder = append(placeholder, "?")
 			args = append(args, visibility.String())
 		}
-		where = append(where, fmt.Sprintf("`memo`.`visibility` IN (%s)", strings.Join(placeholder, ",")))
+		condition := fmt.Sprintf("`memo`.`visibility` IN (%s)", strings.Join(placeholder, ","))
+		if v := find.CollaboratorID; v != nil {
+			condition = fmt.Sprintf("(%s OR `memo`.`id` IN (SELECT `memo_id` FROM `memo_collaborator` WHERE `user_id` = ?))", condition)
+			args = append(args, *v)
+		}
+		where = append(where, condition)
 	}
 	if v := find.Pinned; v != nil {
 		where, args = append(where, "IFNULL(`memo_organizer`.`pinned`, 0) = ?"), append(args, *v)
@@ -120,11 +144,51 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if find.ExcludeComments {
 		where = append(where, "`parent_id` IS NULL")
 	}
+	if find.Trashed {
+		where = append(where, "`memo`.`trashed_ts` IS NOT NULL")
+	} else {
+		where = append(where, "`memo`.`trashed_ts` IS NULL")
+	}
+	if v := find.TrashedTsBefore; v != nil {
+		where, args = append(where, "`memo`.`trashed_ts` < ?"), append(args, *v)
+	}
+	if v := find.PublishTsBefore; v != nil {
+		where, args = append(where, "`memo`.`publish_ts` < ?"), append(args, *v)
+	}
+	if v := find.RemindTsBefore; v != nil {
+		where, args = append(where, "`memo`.`remind_ts` < ?"), append(args, *v)
+	}
+	if v := find.Filter; v != nil {
+		condition, filterArgs, err := buildMemoFilterCondition(v, args)
+		if err != nil {
+			return nil, err
+		}
+		where, args = append(where, condition), filterArgs
+	}
+	if v := find.ParentID; v != nil {
+		where, args = append(where, "`memo_relation`.`related_memo_id` = ?"), append(args, *v)
+	}
+	if v := find.Cursor; v != nil {
+		pinnedColumn := ""
+		if find.OrderByPinned {
+			pinnedColumn = "IFNULL(`memo_organizer`.`pinned`, 0)"
+		}
+		tsColumn := "`memo`.`created_ts`"
+		if find.OrderByUpdatedTs {
+			tsColumn = "`memo`.`updated_ts`"
+		}
+		condition, cursorArgs := buildCursorCondition(v, pinnedColumn, tsColumn, "`memo`.`id`", find.OrderByTimeAsc, args)
+		where, args = append(where, condition), cursorArgs
+	}
 
 	orderBy := []string{}
 	if find.OrderByPinned {
 		orderBy = append(orderBy, "`pinned` DESC")
 	}
+	if len(searchWords) != 0 {
+		// bm25 returns lower values for better matches, and memos only matched with LIKE come last.
+		orderBy, args = append(orderBy, "IFNULL((SELECT bm25(`memo_fts`) FROM `memo_fts` WHERE `memo_fts` MATCH ? AND `memo_fts`.`rowid` = `memo`.`id`), 0) ASC"), append(args, buildFullTextMatchQuery(searchWords))
+	}
 	order := "DESC"
 	if find.OrderByTimeAsc {
 		order = "ASC"
@@ -148,11 +212,20 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 		"`memo`.`row_status` AS `row_status`",
 		"`memo`.`visibility` AS `visibility`",
 		"`memo`.`payload` AS `payload`",
+		"`memo`.`trashed_ts` AS `trashed_ts`",
+		"`memo`.`publish_ts` AS `publish_ts`",
+		"`memo`.`publish_visibility` AS `publish_visibility`",
+		"`memo`.`version` AS `version`",
+		"`memo`.`remind_ts` AS `remind_ts`",
 		"IFNULL(`memo_organizer`.`pinned`, 0) AS `pinned`",
 		"`memo_relation`.`related_memo_id` AS `parent_id`",
 	}
 	if !find.ExcludeContent {
-		fields = append(fields, "`memo`.`content` AS `content`")
+		if find.ContentPrefixLength > 0 {
+			fields = append(fields, fmt.Sprintf("SUBSTR(`memo`.`content`, 1, %d) AS `content`", find.ContentPrefixLength))
+		} else {
+			fields = append(fields, "`memo`.`content` AS `content`")
+		}
 	}
 
 	query := "SELECT " + strings.Join(fields, ", ") + "FROM `memo` " +
@@ -186,6 +259,11 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			&memo.RowStatus,
 			&memo.Visibility,
 			&payloadBytes,
+			&memo.TrashedTs,
+			&memo.PublishTs,
+			&memo.PublishVisibility,
+			&memo.Version,
+			&memo.RemindTs,
 			&memo.Pinned,
 			&memo.ParentID,
 		}
@@ -200,6 +278,9 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			return nil, errors.Wrap(err, "failed to unmarshal payload")
 		}
 		memo.Payload = payload
+		if len(searchWords) != 0 && !find.ExcludeContent {
+			memo.SearchSnippet = store.BuildSearchSnippet(memo.Content, searchWords)
+		}
 		list = append(list, &memo)
 	}
 
@@ -211,6 +292,120 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 }
 
 func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
+	stmt, args, err := buildUpdateMemoStmt(update)
+	if err != nil {
+		return err
+	}
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+	if update.ExpectedVersion != nil {
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return store.ErrMemoVersionMismatch
+		}
+	}
+	return nil
+}
+
+func (d *DB) BatchUpdateMemos(ctx context.Context, updates []*store.UpdateMemo, organizers []*store.MemoOrganizer) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, update := range updates {
+		if err := updateMemoTx(ctx, tx, update); err != nil {
+			return err
+		}
+	}
+	for _, organizer := range organizers {
+		stmt, args := buildUpsertMemoOrganizerStmt(organizer)
+		if _, err := tx.ExecContext(ctx, stmt, args...); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (d *DB) MergeMemos(ctx context.Context, merge *store.MergeMemos) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	memoID := merge.Update.ID
+	placeholder, memoIDs := []string{"?"}, []any{memoID}
+	for _, merged := range merge.Merged {
+		placeholder, memoIDs = append(placeholder, "?"), append(memoIDs, merged.Update.ID)
+	}
+	memoIDList := strings.Join(placeholder, ",")
+	for _, merged := range merge.Merged {
+		mergedID := merged.Update.ID
+		if _, err := tx.ExecContext(ctx, "UPDATE `resource` SET `memo_id` = ? WHERE `memo_id` = ?", memoID, mergedID); err != nil {
+			return err
+		}
+		stmt := fmt.Sprintf("INSERT OR IGNORE INTO `memo_relation` (`memo_id`, `related_memo_id`, `type`) SELECT ?, `related_memo_id`, `type` FROM `memo_relation` WHERE `memo_id` = ? AND `type` != ? AND `related_memo_id` NOT IN (%s)", memoIDList)
+		if _, err := tx.ExecContext(ctx, stmt, append([]any{memoID, mergedID, store.MemoRelationComment}, memoIDs...)...); err != nil {
+			return err
+		}
+		stmt = fmt.Sprintf("INSERT OR IGNORE INTO `memo_relation` (`memo_id`, `related_memo_id`, `type`) SELECT `memo_id`, ?, `type` FROM `memo_relation` WHERE `related_memo_id` = ? AND `memo_id` NOT IN (%s)", memoIDList)
+		if _, err := tx.ExecContext(ctx, stmt, append([]any{memoID, mergedID}, memoIDs...)...); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, "DELETE FROM `memo_relation` WHERE `related_memo_id` = ?", mergedID); err != nil {
+			return err
+		}
+		// The reactions the memo already has are not moved, and are deleted with the other reactions to the merged memo.
+		if _, err := tx.ExecContext(ctx, "UPDATE OR IGNORE `reaction` SET `content_id` = ? WHERE `content_id` = ?", merge.ContentID, merged.ContentID); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, "DELETE FROM `reaction` WHERE `content_id` = ?", merged.ContentID); err != nil {
+			return err
+		}
+		if err := updateMemoTx(ctx, tx, merged.Update); err != nil {
+			return err
+		}
+	}
+	if err := updateMemoTx(ctx, tx, merge.Update); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// updateMemoTx applies the update of the memo in the transaction, failing with store.ErrMemoVersionMismatch
+// if the memo is not at the expected version of the update.
+func updateMemoTx(ctx context.Context, tx *sql.Tx, update *store.UpdateMemo) error {
+	stmt, args, err := buildUpdateMemoStmt(update)
+	if err != nil {
+		return err
+	}
+	result, err := tx.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+	if update.ExpectedVersion != nil {
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return store.ErrMemoVersionMismatch
+		}
+	}
+	return nil
+}
+
+// buildUpdateMemoStmt builds the statement updating the memo, which is shared by the single and the batch updates.
+func buildUpdateMemoStmt(update *store.UpdateMemo) (string, []any, error) {
 	set, args := []string{}, []any{}
 	if v := update.UID; v != nil {
 		set, args = append(set, "`uid` = ?"), append(args, *v)
@@ -230,20 +425,50 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 	if v := update.Visibility; v != nil {
 		set, args = append(set, "`visibility` = ?"), append(args, *v)
 	}
+	if v := update.TrashedTs; v != nil {
+		if *v == 0 {
+			set = append(set, "`trashed_ts` = NULL")
+		} else {
+			set, args = append(set, "`trashed_ts` = ?"), append(args, *v)
+		}
+	}
+	if v := update.PublishTs; v != nil {
+		if *v == 0 {
+			set = append(set, "`publish_ts` = NULL")
+		} else {
+			set, args = append(set, "`publish_ts` = ?"), append(args, *v)
+		}
+	}
+	if v := update.PublishVisibility; v != nil {
+		set, args = append(set, "`publish_visibility` = ?"), append(args, *v)
+	}
+	// The version is incremented on every update, so that concurrent updates can be detected.
+	// Setting only the payload or the reminder is not an edit of the memo itself, such as when
+	// the properties derived from its resources or the next reminder are refreshed.
+	if len(set) > 0 || (update.Payload == nil && update.RemindTs == nil) {
+		set = append(set, "`version` = `version` + 1")
+	}
 	if v := update.Payload; v != nil {
 		payloadBytes, err := protojson.Marshal(v)
 		if err != nil {
-			return err
+			return "", nil, err
 		}
 		set, args = append(set, "`payload` = ?"), append(args, string(payloadBytes))
 	}
+	if v := update.RemindTs; v != nil {
+		if *v == 0 {
+			set = append(set, "`remind_ts` = NULL")
+		} else {
+			set, args = append(set, "`remind_ts` = ?"), append(args, *v)
+		}
+	}
 	args = append(args, update.ID)
 
 	stmt := "UPDATE `memo` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
-	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
-		return err
+	if v := update.ExpectedVersion; v != nil {
+		stmt, args = stmt+" AND `version` = ?", append(args, *v)
 	}
-	return nil
+	return stmt, args, nil
 }
 
 func (d *DB) DeleteMemo(ctx context.Context, delete *store.DeleteMemo) error {
@@ -258,3 +483,58 @@ func (d *DB) DeleteMemo(ctx context.Context, delete *store.DeleteMemo) error {
 	}
 	return nil
 }
+
+// PurgeMemos deletes the memos along with the rows that depend on them in one transaction.
+// The blobs of their resources are left to the caller.
+func (d *DB) PurgeMemos(ctx context.Context, memoIDs []int32) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	placeholder, args := []string{}, []any{}
+	for _, id := range memoIDs {
+		placeholder, args = append(placeholder, "?"), append(args, id)
+	}
+	memoIDList := strings.Join(placeholder, ",")
+	stmt := fmt.Sprintf("DELETE FROM `memo_relation` WHERE `memo_id` IN (%s) OR `related_memo_id` IN (%s)", memoIDList, memoIDList)
+	if _, err := tx.ExecContext(ctx, stmt, append(append([]any{}, args...), args...)...); err != nil {
+		return err
+	}
+	// The memos are deleted last, after the rows that depend on them.
+	stmts := []string{
+		fmt.Sprintf("DELETE FROM `memo_revision` WHERE `memo_id` IN (%s)", memoIDList),
+		fmt.Sprintf("DELETE FROM `memo_share` WHERE `memo_id` IN (%s)", memoIDList),
+		fmt.Sprintf("DELETE FROM `memo_collaborator` WHERE `memo_id` IN (%s)", memoIDList),
+		fmt.Sprintf("DELETE FROM `memo_alias` WHERE `memo_id` IN (%s)", memoIDList),
+		fmt.Sprintf("DELETE FROM `memo_term` WHERE `memo_id` IN (%s)", memoIDList),
+		fmt.Sprintf("DELETE FROM `resource` WHERE `memo_id` IN (%s)", memoIDList),
+		fmt.Sprintf("DELETE FROM `memo` WHERE `id` IN (%s)", memoIDList),
+	}
+	for _, stmt := range stmts {
+		if _, err := tx.ExecContext(ctx, stmt, args...); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// buildContentSearchCondition returns the condition of the memos whose content matches the search term.
+// Only the terms the index cannot match are matched with LIKE, which scans the whole table.
+func buildContentSearchCondition(term string) (string, []any) {
+	if store.IsFullTextSearchable(term) {
+		return "`memo`.`id` IN (SELECT `rowid` FROM `memo_fts` WHERE `memo_fts` MATCH ?)", []any{buildFullTextMatchQuery(store.SplitContentSearchWords([]string{term}))}
+	}
+	return "`memo`.`content` LIKE ?", []any{fmt.Sprintf("%%%s%%", term)}
+}
+
+// buildFullTextMatchQuery builds a FTS5 match query that requires every word, matching words by prefix.
+func buildFullTextMatchQuery(words []string) string {
+	terms := []string{}
+	for _, word := range words {
+		terms = append(terms, fmt.Sprintf(`"%s"*`, word))
+	}
+	return strings.Join(terms, " ")
+}
@@ -0,0 +1,68 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertMemoAlias(ctx context.Context, upsert *store.MemoAlias) (*store.MemoAlias, error) {
+	stmt := "INSERT INTO `memo_alias` (`uid`, `memo_id`) VALUES (?, ?) ON CONFLICT(`uid`) DO UPDATE SET `memo_id` = EXCLUDED.`memo_id`, `created_ts` = strftime('%s', 'now') RETURNING `created_ts`"
+	if err := d.db.QueryRowContext(ctx, stmt, upsert.UID, upsert.MemoID).Scan(&upsert.CreatedTs); err != nil {
+		return nil, err
+	}
+	return upsert, nil
+}
+
+func (d *DB) ListMemoAliases(ctx context.Context, find *store.FindMemoAlias) ([]*store.MemoAlias, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.UID != nil {
+		where, args = append(where, "`uid` = ?"), append(args, *find.UID)
+	}
+	if find.MemoID != nil {
+		where, args = append(where, "`memo_id` = ?"), append(args, *find.MemoID)
+	}
+
+	query := "SELECT `uid`, `memo_id`, `created_ts` FROM `memo_alias` WHERE " + strings.Join(where, " AND ") + " ORDER BY `created_ts` DESC, `uid` ASC"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoAlias{}
+	for rows.Next() {
+		alias := &store.MemoAlias{}
+		if err := rows.Scan(
+			&alias.UID,
+			&alias.MemoID,
+			&alias.CreatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, alias)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteMemoAlias(ctx context.Context, delete *store.DeleteMemoAlias) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.UID != nil {
+		where, args = append(where, "`uid` = ?"), append(args, *delete.UID)
+	}
+	if delete.MemoID != nil {
+		where, args = append(where, "`memo_id` = ?"), append(args, *delete.MemoID)
+	}
+
+	stmt := "DELETE FROM `memo_alias` WHERE " + strings.Join(where, " AND ")
+	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package sqlite
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+// buildMemoFilterCondition renders the memo filter to a condition on the memo query, appending its arguments to args.
+func buildMemoFilterCondition(filter *store.MemoFilter, args []any) (string, []any, error) {
+	switch filter.Operator {
+	case store.MemoFilterOperatorAnd, store.MemoFilterOperatorOr:
+		conditions := []string{}
+		for _, child := range filter.Children {
+			condition, childArgs, err := buildMemoFilterCondition(child, args)
+			if err != nil {
+				return "", nil, err
+			}
+			conditions, args = append(conditions, condition), childArgs
+		}
+		return "(" + strings.Join(conditions, fmt.Sprintf(" %s ", filter.Operator)) + ")", args, nil
+	case store.MemoFilterOperatorNot:
+		condition, args, err := buildMemoFilterCondition(filter.Children[0], args)
+		if err != nil {
+			return "", nil, err
+		}
+		return "NOT (" + condition + ")", args, nil
+	}
+
+	switch filter.Field {
+	case store.MemoFilterFieldTag:
+		return buildMemoFilterTagCondition(filter, args)
+	case store.MemoFilterFieldLocation:
+		return buildMemoFilterLocationCondition(filter, args)
+	case store.MemoFilterFieldPinned, store.MemoFilterFieldHasLink, store.MemoFilterFieldHasTaskList, store.MemoFilterFieldHasCode, store.MemoFilterFieldHasIncompleteTasks, store.MemoFilterFieldHasImage:
+		condition := memoFilterFlagConditions[filter.Field]
+		if filter.Values[0].(bool) != (filter.Operator == store.MemoFilterOperatorEqual) {
+			condition = "NOT (" + condition + ")"
+		}
+		return condition, args, nil
+	}
+
+	column, ok := memoFilterColumns[filter.Field]
+	if !ok {
+		return "", nil, errors.Errorf("unsupported memo filter field %q", filter.Field)
+	}
+	if filter.Operator == store.MemoFilterOperatorIn {
+		if len(filter.Values) == 0 {
+			return "1 = 0", args, nil
+		}
+		placeholder := []string{}
+		for _, value := range filter.Values {
+			placeholder, args = append(placeholder, "?"), append(args, value)
+		}
+		return fmt.Sprintf("%s IN (%s)", column, strings.Join(placeholder, ",")), args, nil
+	}
+	return fmt.Sprintf("%s %s ?", column, filter.Operator), append(args, filter.Values[0]), nil
+}
+
+// buildMemoFilterTagCondition matches the memos with the tag or any of its sub-tags.
+// The tags are missing from the payload when the memo has none, so the condition must still hold a value under NOT.
+func buildMemoFilterTagCondition(filter *store.MemoFilter, args []any) (string, []any, error) {
+	conditions := []string{}
+	for _, value := range filter.Values {
+		tag := value.(string)
+		conditions = append(conditions, "(IFNULL(JSON_EXTRACT(`memo`.`payload`, '$.tags'), '[]') LIKE ? OR IFNULL(JSON_EXTRACT(`memo`.`payload`, '$.tags'), '[]') LIKE ?)")
+		args = append(args, fmt.Sprintf(`%%"%s"%%`, tag), fmt.Sprintf(`%%"%s/%%`, tag))
+	}
+	if len(conditions) == 0 {
+		return "1 = 0", args, nil
+	}
+	condition := "(" + strings.Join(conditions, " OR ") + ")"
+	if filter.Operator == store.MemoFilterOperatorNotEqual {
+		condition = "NOT " + condition
+	}
+	return condition, args, nil
+}
+
+// buildMemoFilterLocationCondition matches the memos by the coordinates of their location.
+// The condition is false rather than NULL for the memos without coordinates, so that it still holds a value under NOT.
+func buildMemoFilterLocationCondition(filter *store.MemoFilter, args []any) (string, []any, error) {
+	condition := memoFilterHasCoordinates
+	switch filter.Operator {
+	case store.MemoFilterOperatorWithinBox:
+		south, west, north, east := filter.Values[0].(float64), filter.Values[1].(float64), filter.Values[2].(float64), filter.Values[3].(float64)
+		condition, args = condition+fmt.Sprintf(" AND %s BETWEEN ? AND ?", memoFilterLatitude), append(args, south, north)
+		if west <= east {
+			condition, args = condition+fmt.Sprintf(" AND %s BETWEEN ? AND ?", memoFilterLongitude), append(args, west, east)
+		} else {
+			// The box crosses the antimeridian.
+			condition, args = condition+fmt.Sprintf(" AND (%s >= ? OR %s <= ?)", memoFilterLongitude, memoFilterLongitude), append(args, west, east)
+		}
+	case store.MemoFilterOperatorWithinRadius:
+		latitude, longitude, radius := filter.Values[0].(float64), filter.Values[1].(float64), filter.Values[2].(float64)
+		// The haversine formula of the distance from the center.
+		condition += fmt.Sprintf(
+			" AND POWER(SIN(RADIANS(%s - ?) / 2), 2) + COS(RADIANS(?)) * COS(RADIANS(%s)) * POWER(SIN(RADIANS(%s - ?) / 2), 2) <= ?",
+			memoFilterLatitude, memoFilterLatitude, memoFilterLongitude,
+		)
+		args = append(args, latitude, latitude, longitude, store.GetDistanceHaversine(radius))
+	default:
+		return "", nil, errors.Errorf("unsupported memo filter operator %q for %s", filter.Operator, filter.Field)
+	}
+	return "(" + condition + ")", args, nil
+}
+
+// Zero coordinates are missing from the payload, so a memo has coordinates if either is present.
+const (
+	memoFilterHasCoordinates = "(JSON_EXTRACT(`memo`.`payload`, '$.location.latitude') IS NOT NULL OR JSON_EXTRACT(`memo`.`payload`, '$.location.longitude') IS NOT NULL)"
+	memoFilterLatitude       = "IFNULL(JSON_EXTRACT(`memo`.`payload`, '$.location.latitude'), 0)"
+	memoFilterLongitude      = "IFNULL(JSON_EXTRACT(`memo`.`payload`, '$.location.longitude'), 0)"
+)
+
+var memoFilterColumns = map[store.MemoFilterField]string{
+	store.MemoFilterFieldID:         "`memo`.`id`",
+	store.MemoFilterFieldUID:        "`memo`.`uid`",
+	store.MemoFilterFieldCreatorID:  "`memo`.`creator_id`",
+	store.MemoFilterFieldCreatedTs:  "`memo`.`created_ts`",
+	store.MemoFilterFieldUpdatedTs:  "`memo`.`updated_ts`",
+	store.MemoFilterFieldRowStatus:  "`memo`.`row_status`",
+	store.MemoFilterFieldVisibility: "`memo`.`visibility`",
+}
+
+var memoFilterFlagConditions = map[store.MemoFilterField]string{
+	store.MemoFilterFieldPinned:             "IFNULL(`memo_organizer`.`pinned`, 0) = 1",
+	store.MemoFilterFieldHasLink:            "JSON_EXTRACT(`memo`.`payload`, '$.property.hasLink') IS TRUE",
+	store.MemoFilterFieldHasTaskList:        "JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') IS TRUE",
+	store.MemoFilterFieldHasCode:            "JSON_EXTRACT(`memo`.`payload`, '$.property.hasCode') IS TRUE",
+	store.MemoFilterFieldHasIncompleteTasks: "JSON_EXTRACT(`memo`.`payload`, '$.property.hasIncompleteTasks') IS TRUE",
+	store.MemoFilterFieldHasImage:           "JSON_EXTRACT(`memo`.`payload`, '$.property.hasImage') IS TRUE",
+}
@@ -9,6 +9,15 @@ import (
 )
 
 func (d *DB) UpsertMemoOrganizer(ctx context.Context, upsert *store.MemoOrganizer) (*store.MemoOrganizer, error) {
+	stmt, args := buildUpsertMemoOrganizerStmt(upsert)
+	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+		return nil, err
+	}
+
+	return upsert, nil
+}
+
+func buildUpsertMemoOrganizerStmt(upsert *store.MemoOrganizer) (string, []any) {
 	stmt := `
 		INSERT INTO memo_organizer (
 			memo_id,
@@ -20,11 +29,7 @@ func (d *DB) UpsertMemoOrganizer(ctx context.Context, upsert *store.MemoOrganize
 		SET
 			pinned = EXCLUDED.pinned
 	`
-	if _, err := d.db.ExecContext(ctx, stmt, upsert.MemoID, upsert.UserID, upsert.Pinned); err != nil {
-		return nil, err
-	}
-
-	return upsert, nil
+	return stmt, []any{upsert.MemoID, upsert.UserID, upsert.Pinned}
 }
 
 func (d *DB) ListMemoOrganizer(ctx context.Context, find *store.FindMemoOrganizer) ([]*store.MemoOrganizer, error) {
@@ -29,13 +29,21 @@ type Driver interface {
 	CreateMemo(ctx context.Context, create *Memo) (*Memo, error)
 	ListMemos(ctx context.Context, find *FindMemo) ([]*Memo, error)
 	UpdateMemo(ctx context.Context, update *UpdateMemo) error
+	BatchUpdateMemos(ctx context.Context, updates []*UpdateMemo, organizers []*MemoOrganizer) error
+	MergeMemos(ctx context.Context, merge *MergeMemos) error
 	DeleteMemo(ctx context.Context, delete *DeleteMemo) error
+	PurgeMemos(ctx context.Context, memoIDs []int32) error
 
 	// MemoRelation model related methods.
 	UpsertMemoRelation(ctx context.Context, create *MemoRelation) (*MemoRelation, error)
 	ListMemoRelations(ctx context.Context, find *FindMemoRelation) ([]*MemoRelation, error)
 	DeleteMemoRelation(ctx context.Context, delete *DeleteMemoRelation) error
 
+	// MemoRevision model related methods.
+	CreateMemoRevision(ctx context.Context, create *MemoRevision) (*MemoRevision, error)
+	ListMemoRevisions(ctx context.Context, find *FindMemoRevision) ([]*MemoRevision, error)
+	DeleteMemoRevision(ctx context.Context, delete *DeleteMemoRevision) error
+
 	// MemoOrganizer model related methods.
 	UpsertMemoOrganizer(ctx context.Context, upsert *MemoOrganizer) (*MemoOrganizer, error)
 	ListMemoOrganizer(ctx context.Context, find *FindMemoOrganizer) ([]*MemoOrganizer, error)
@@ -95,4 +103,37 @@ type Driver interface {
 	ListMemoReviews(ctx context.Context, find *FindMemoReview) ([]*MemoReview, error)
 	BatchCreateMemoReviews(ctx context.Context, reviews []*MemoReview) error
 	ListMemoReviewSummaries(ctx context.Context, find *FindMemoReviewSummary) ([]*MemoReviewSummary, error)
+
+	// Job model related methods.
+	UpsertJob(ctx context.Context, upsert *Job) (*Job, error)
+	ListJobs(ctx context.Context, find *FindJob) ([]*Job, error)
+	CreateJobRun(ctx context.Context, create *JobRun) (*JobRun, error)
+	ListJobRuns(ctx context.Context, find *FindJobRun) ([]*JobRun, error)
+	UpdateJobRun(ctx context.Context, update *UpdateJobRun) error
+	DeleteJobRun(ctx context.Context, delete *DeleteJobRun) error
+
+	// MemoShare model related methods.
+	CreateMemoShare(ctx context.Context, create *MemoShare) (*MemoShare, error)
+	ListMemoShares(ctx context.Context, find *FindMemoShare) ([]*MemoShare, error)
+	RecordMemoShareAccess(ctx context.Context, id int32, accessedTs int64) error
+	DeleteMemoShare(ctx context.Context, delete *DeleteMemoShare) error
+
+	// MemoCollaborator model related methods.
+	UpsertMemoCollaborator(ctx context.Context, upsert *MemoCollaborator) (*MemoCollaborator, error)
+	ListMemoCollaborators(ctx context.Context, find *FindMemoCollaborator) ([]*MemoCollaborator, error)
+	DeleteMemoCollaborator(ctx context.Context, delete *DeleteMemoCollaborator) error
+
+	// MemoTerm model related methods.
+	SetMemoTerms(ctx context.Context, memoID int32, terms []*MemoTerm) error
+	ListMemoTerms(ctx context.Context, find *FindMemoTerm) ([]*MemoTerm, error)
+	GetMemoTermStats(ctx context.Context, find *FindMemoTermStats) (*MemoTermStats, error)
+
+	// MemoAlias model related methods.
+	UpsertMemoAlias(ctx context.Context, upsert *MemoAlias) (*MemoAlias, error)
+	ListMemoAliases(ctx context.Context, find *FindMemoAlias) ([]*MemoAlias, error)
+	DeleteMemoAlias(ctx context.Context, delete *DeleteMemoAlias) error
+
+	// MemoStats model related methods.
+	GetMemoStats(ctx context.Context, find *FindMemoStats) (*MemoStats, error)
+	ListMemoTags(ctx context.Context, find *FindMemoStats) ([]*MemoTag, error)
 }
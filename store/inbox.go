@@ -41,6 +41,8 @@ type FindInbox struct {
 	// Pagination
 	Limit  *int
 	Offset *int
+	// Cursor is the created_ts and id of the last inbox of the previous page.
+	Cursor *Cursor
 }
 
 type DeleteInbox struct {
@@ -0,0 +1,118 @@
+package store
+
+import (
+	"context"
+	"time"
+)
+
+// Job is the persisted schedule of a background job, overriding its default schedule.
+type Job struct {
+	Name      string
+	Schedule  string
+	UpdatedTs int64
+}
+
+type FindJob struct {
+	Name *string
+}
+
+// JobRun is a single execution of a background job.
+type JobRun struct {
+	ID        int32
+	JobName   string
+	StartedTs int64
+	// EndedTs is nil while the run is in progress.
+	EndedTs *int64
+	// Error is empty if the run succeeded.
+	Error string
+}
+
+type FindJobRun struct {
+	ID      *int32
+	JobName *string
+
+	// Pagination
+	Limit  *int
+	Offset *int
+}
+
+type DeleteJobRun struct {
+	ID int32
+}
+
+type UpdateJobRun struct {
+	ID      int32
+	EndedTs *int64
+	Error   *string
+}
+
+func (s *Store) UpsertJob(ctx context.Context, upsert *Job) (*Job, error) {
+	upsert.UpdatedTs = time.Now().Unix()
+	return s.driver.UpsertJob(ctx, upsert)
+}
+
+func (s *Store) ListJobs(ctx context.Context, find *FindJob) ([]*Job, error) {
+	return s.driver.ListJobs(ctx, find)
+}
+
+func (s *Store) GetJob(ctx context.Context, find *FindJob) (*Job, error) {
+	list, err := s.ListJobs(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	job := list[0]
+	return job, nil
+}
+
+func (s *Store) CreateJobRun(ctx context.Context, create *JobRun) (*JobRun, error) {
+	return s.driver.CreateJobRun(ctx, create)
+}
+
+// ListJobRuns lists job runs ordered from newest to oldest.
+func (s *Store) ListJobRuns(ctx context.Context, find *FindJobRun) ([]*JobRun, error) {
+	return s.driver.ListJobRuns(ctx, find)
+}
+
+func (s *Store) GetJobRun(ctx context.Context, find *FindJobRun) (*JobRun, error) {
+	list, err := s.ListJobRuns(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	jobRun := list[0]
+	return jobRun, nil
+}
+
+func (s *Store) UpdateJobRun(ctx context.Context, update *UpdateJobRun) error {
+	return s.driver.UpdateJobRun(ctx, update)
+}
+
+func (s *Store) DeleteJobRun(ctx context.Context, delete *DeleteJobRun) error {
+	return s.driver.DeleteJobRun(ctx, delete)
+}
+
+// PruneJobRuns deletes the oldest runs of a job so that at most limit runs are kept.
+func (s *Store) PruneJobRuns(ctx context.Context, jobName string, limit int) error {
+	list, err := s.ListJobRuns(ctx, &FindJobRun{
+		JobName: &jobName,
+	})
+	if err != nil {
+		return err
+	}
+	if len(list) <= limit {
+		return nil
+	}
+	for _, jobRun := range list[limit:] {
+		if err := s.DeleteJobRun(ctx, &DeleteJobRun{ID: jobRun.ID}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
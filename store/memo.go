@@ -2,7 +2,14 @@ package store
 
 import (
 	"context"
-	"errors"
+	"html"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
 
 	"github.com/usememos/memos/internal/util"
 
@@ -49,15 +56,30 @@ type Memo struct {
 	Content    string
 	Visibility Visibility
 	Payload    *storepb.MemoPayload
+	// TrashedTs is the time the memo was moved to trash, nil if the memo is not trashed.
+	TrashedTs *int64
+	// PublishTs is the time a scheduled memo will be published, nil if the memo is not scheduled.
+	// A scheduled memo stays private until then.
+	PublishTs *int64
+	// PublishVisibility is the visibility a scheduled memo will have once it is published.
+	PublishVisibility Visibility
+	// Version is incremented on every update of the memo, except the ones setting only its payload or its reminder.
+	Version int32
+	// RemindTs is the time of the next reminder of the memo, nil if there is none.
+	// It is derived from the payload when the memo is created or its payload is updated.
+	RemindTs *int64
 
 	// Composed fields
 	Pinned   bool
 	ParentID *int32
+	// SearchSnippet is the highlighted snippet of the content matched by the content search, as built by BuildSearchSnippet.
+	SearchSnippet string
 }
 
 type FindMemo struct {
-	ID  *int32
-	UID *string
+	ID     *int32
+	UID    *string
+	IDList []int32
 
 	// Standard fields
 	RowStatus       *RowStatus
@@ -68,17 +90,38 @@ type FindMemo struct {
 	UpdatedTsBefore *int64
 
 	// Domain specific fields
-	ContentSearch   []string
-	VisibilityList  []Visibility
-	Pinned          *bool
-	PayloadFind     *FindMemoPayload
-	ExcludeContent  bool
-	ExcludeComments bool
-	Random          bool
+	// ContentSearch is matched against the full-text index of the memo content, or with a substring match
+	// for the terms the index cannot match, such as CJK text or short words (see IsFullTextSearchable).
+	// The words of the other terms are matched by prefix.
+	// When set, memos are ordered by relevance.
+	ContentSearch  []string
+	VisibilityList []Visibility
+	// CollaboratorID widens VisibilityList with the memos the user is a collaborator of, whatever their visibility.
+	CollaboratorID *int32
+	Pinned         *bool
+	PayloadFind    *FindMemoPayload
+	ExcludeContent bool
+	// ContentPrefixLength loads only the first runes of the content when positive.
+	ContentPrefixLength int
+	ExcludeComments     bool
+	// ParentID finds the comments of the memo.
+	ParentID *int32
+	Random   bool
+	// Trashed finds the memos in trash instead. Trashed memos are excluded by default.
+	Trashed         bool
+	TrashedTsBefore *int64
+	// PublishTsBefore finds the scheduled memos due to be published before the given time.
+	PublishTsBefore *int64
+	// RemindTsBefore finds the memos with a reminder due before the given time.
+	RemindTsBefore *int64
+	// Filter is an additional predicate on the memos.
+	Filter *MemoFilter
 
 	// Pagination
 	Limit  *int
 	Offset *int
+	// Cursor is the pinned, created_ts or updated_ts, and id of the last memo of the previous page.
+	Cursor *Cursor
 
 	// Ordering
 	OrderByUpdatedTs bool
@@ -86,6 +129,113 @@ type FindMemo struct {
 	OrderByTimeAsc   bool
 }
 
+const (
+	// SearchHighlightStart and SearchHighlightEnd wrap the matched terms in a search snippet.
+	SearchHighlightStart = "<mark>"
+	SearchHighlightEnd   = "</mark>"
+)
+
+var searchWordSeparator = regexp.MustCompile(`[^\p{L}\p{N}_]+`)
+
+// SplitContentSearchWords splits the content search terms into words without any query syntax characters.
+func SplitContentSearchWords(contentSearch []string) []string {
+	words := []string{}
+	for _, term := range contentSearch {
+		for _, word := range searchWordSeparator.Split(term, -1) {
+			if word != "" {
+				words = append(words, strings.ToLower(word))
+			}
+		}
+	}
+	return words
+}
+
+// minFullTextWordLength is the length of the shortest words the full-text indexes of all the drivers keep,
+// as MySQL skips the words shorter than its default innodb_ft_min_token_size.
+const minFullTextWordLength = 3
+
+// IsFullTextSearchable reports whether the full-text indexes can match the content search term.
+// The indexes do not split the text of the scripts written without spaces between words, and some of
+// them skip the short words and the stop words, so the drivers match the other terms with LIKE.
+func IsFullTextSearchable(term string) bool {
+	words := SplitContentSearchWords([]string{term})
+	if len(words) == 0 {
+		return false
+	}
+	for _, word := range words {
+		if utf8.RuneCountInString(word) < minFullTextWordLength || memoTermStopWords[word] {
+			return false
+		}
+		for _, r := range word {
+			if unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul, unicode.Thai) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// searchSnippetRadius is the number of runes kept around the first match in a search snippet.
+const searchSnippetRadius = 80
+
+// BuildSearchSnippet builds a snippet of the content around the first matched word, with the matched
+// words wrapped in SearchHighlightStart and SearchHighlightEnd. The content is HTML-escaped, so the
+// highlight markers are the only markup of the snippet.
+func BuildSearchSnippet(content string, words []string) string {
+	runes := []rune(content)
+	// Lowercasing rune by rune keeps the indexes of the original content.
+	lowerRunes := make([]rune, len(runes))
+	for i, r := range runes {
+		lowerRunes[i] = unicode.ToLower(r)
+	}
+	lowerContent := string(lowerRunes)
+
+	matchAt := func(i int) int {
+		for _, word := range words {
+			if strings.HasPrefix(string(lowerRunes[i:min(len(lowerRunes), i+len([]rune(word)))]), word) {
+				return len([]rune(word))
+			}
+		}
+		return 0
+	}
+
+	first := -1
+	for _, word := range words {
+		if index := strings.Index(lowerContent, word); index >= 0 {
+			if runeIndex := len([]rune(lowerContent[:index])); first < 0 || runeIndex < first {
+				first = runeIndex
+			}
+		}
+	}
+	if first < 0 {
+		first = 0
+	}
+	start, end := max(0, first-searchSnippetRadius), min(len(runes), first+searchSnippetRadius)
+
+	var builder strings.Builder
+	if start > 0 {
+		builder.WriteString("...")
+	}
+	plainStart := start
+	for i := start; i < end; {
+		if length := matchAt(i); length > 0 {
+			builder.WriteString(html.EscapeString(string(runes[plainStart:i])))
+			builder.WriteString(SearchHighlightStart)
+			builder.WriteString(html.EscapeString(string(runes[i : i+length])))
+			builder.WriteString(SearchHighlightEnd)
+			i += length
+			plainStart = i
+			continue
+		}
+		i++
+	}
+	builder.WriteString(html.EscapeString(string(runes[plainStart:max(plainStart, end)])))
+	if end < len(runes) {
+		builder.WriteString("...")
+	}
+	return builder.String()
+}
+
 type FindMemoPayload struct {
 	Raw                *string
 	TagSearch          []string
@@ -104,9 +254,23 @@ type UpdateMemo struct {
 	RowStatus  *RowStatus
 	Content    *string
 	Visibility *Visibility
-	Payload    *storepb.MemoPayload
+	// Payload does not change the version of the memo when it is the only field set.
+	Payload *storepb.MemoPayload
+	// TrashedTs moves the memo to trash at the given time, or restores it from trash when set to 0.
+	TrashedTs *int64
+	// PublishTs schedules the memo to be published at the given time, or cancels the schedule when set to 0.
+	PublishTs         *int64
+	PublishVisibility *Visibility
+	// ExpectedVersion makes the update fail with ErrMemoVersionMismatch unless the memo is at this version.
+	ExpectedVersion *int32
+	// RemindTs sets the time of the next reminder of the memo, or clears it when set to 0.
+	// It is derived from the payload when only the payload is set, and does not change the version of the memo.
+	RemindTs *int64
 }
 
+// ErrMemoVersionMismatch is returned when the memo was updated after the version an update expects.
+var ErrMemoVersionMismatch = errors.New("memo version mismatch")
+
 type DeleteMemo struct {
 	ID int32
 }
@@ -115,10 +279,27 @@ func (s *Store) CreateMemo(ctx context.Context, create *Memo) (*Memo, error) {
 	if !util.UIDMatcher.MatchString(create.UID) {
 		return nil, errors.New("invalid uid")
 	}
-	return s.driver.CreateMemo(ctx, create)
+	if create.Payload != nil && create.RemindTs == nil {
+		if remindTs := GetNextMemoRemindTs(create.Payload, time.Now().Unix()); remindTs != 0 {
+			create.RemindTs = &remindTs
+		}
+	}
+	memo, err := s.driver.CreateMemo(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.IndexMemoTerms(ctx, memo.ID, memo.Content); err != nil {
+		return nil, errors.Wrap(err, "failed to index memo terms")
+	}
+	return memo, nil
 }
 
 func (s *Store) ListMemos(ctx context.Context, find *FindMemo) ([]*Memo, error) {
+	if find.Filter != nil {
+		if err := find.Filter.Validate(); err != nil {
+			return nil, errors.Wrap(err, "invalid memo filter")
+		}
+	}
 	return s.driver.ListMemos(ctx, find)
 }
 
@@ -139,9 +320,106 @@ func (s *Store) UpdateMemo(ctx context.Context, update *UpdateMemo) error {
 	if update.UID != nil && !util.UIDMatcher.MatchString(*update.UID) {
 		return errors.New("invalid uid")
 	}
-	return s.driver.UpdateMemo(ctx, update)
+	setUpdateMemoRemindTs(update)
+	if err := s.driver.UpdateMemo(ctx, update); err != nil {
+		return err
+	}
+	if update.Content != nil {
+		if err := s.IndexMemoTerms(ctx, update.ID, *update.Content); err != nil {
+			return errors.Wrap(err, "failed to index memo terms")
+		}
+	}
+	return nil
+}
+
+// BatchUpdateMemos applies the updates of the memos and the changes of their organizers in one transaction.
+// It fails with ErrMemoVersionMismatch, changing nothing, if a memo is not at the ExpectedVersion of its update.
+func (s *Store) BatchUpdateMemos(ctx context.Context, updates []*UpdateMemo, organizers []*MemoOrganizer) error {
+	for _, update := range updates {
+		if update.UID != nil && !util.UIDMatcher.MatchString(*update.UID) {
+			return errors.New("invalid uid")
+		}
+		setUpdateMemoRemindTs(update)
+	}
+	if err := s.driver.BatchUpdateMemos(ctx, updates, organizers); err != nil {
+		return err
+	}
+	for _, update := range updates {
+		if update.Content != nil {
+			if err := s.IndexMemoTerms(ctx, update.ID, *update.Content); err != nil {
+				return errors.Wrap(err, "failed to index memo terms")
+			}
+		}
+	}
+	return nil
+}
+
+// MergeMemos is the merge of memos into a memo.
+type MergeMemos struct {
+	// Update is the update of the memo the other memos are merged into.
+	Update *UpdateMemo
+	// ContentID is the content ID of the reactions to the memo.
+	ContentID string
+	Merged    []*MergedMemo
+}
+
+// MergedMemo is a memo merged into another memo.
+type MergedMemo struct {
+	// Update is the update of the merged memo, such as archiving it.
+	Update *UpdateMemo
+	// ContentID is the content ID of the reactions to the merged memo.
+	ContentID string
+}
+
+// MergeMemos moves the resources, relations and reactions of the merged memos to the memo, and applies the updates
+// of the memos, in one transaction. It fails with ErrMemoVersionMismatch, changing nothing, if a memo is not at the
+// ExpectedVersion of its update.
+// The relations of the merged memos to other memos are copied to the memo, except their comment relations, and the
+// relations of other memos to the merged memos are moved to the memo. The relations between the memos of the merge are left out.
+// The reactions to the merged memos are moved to the memo, unless the memo has the same reaction of the same user.
+func (s *Store) MergeMemos(ctx context.Context, merge *MergeMemos) error {
+	updates := []*UpdateMemo{merge.Update}
+	for _, merged := range merge.Merged {
+		updates = append(updates, merged.Update)
+	}
+	for _, update := range updates {
+		if update.UID != nil && !util.UIDMatcher.MatchString(*update.UID) {
+			return errors.New("invalid uid")
+		}
+		setUpdateMemoRemindTs(update)
+	}
+	if err := s.driver.MergeMemos(ctx, merge); err != nil {
+		return err
+	}
+	for _, update := range updates {
+		if update.Content != nil {
+			if err := s.IndexMemoTerms(ctx, update.ID, *update.Content); err != nil {
+				return errors.Wrap(err, "failed to index memo terms")
+			}
+		}
+	}
+	return nil
 }
 
 func (s *Store) DeleteMemo(ctx context.Context, delete *DeleteMemo) error {
 	return s.driver.DeleteMemo(ctx, delete)
 }
+
+// GetNextMemoRemindTs returns the earliest time after now among the reminder of the payload
+// and the due times of its incomplete tasks, or 0 if there is none.
+func GetNextMemoRemindTs(payload *storepb.MemoPayload, now int64) int64 {
+	remindTs := int64(0)
+	for _, ts := range append([]int64{payload.GetRemindTs()}, payload.GetProperty().GetTaskDueTs()...) {
+		if ts > now && (remindTs == 0 || ts < remindTs) {
+			remindTs = ts
+		}
+	}
+	return remindTs
+}
+
+func setUpdateMemoRemindTs(update *UpdateMemo) {
+	if update.Payload != nil && update.RemindTs == nil {
+		remindTs := GetNextMemoRemindTs(update.Payload, time.Now().Unix())
+		update.RemindTs = &remindTs
+	}
+}
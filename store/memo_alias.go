@@ -0,0 +1,47 @@
+package store
+
+import (
+	"context"
+)
+
+// MemoAlias is a previous uid of a memo, which keeps leading to the memo after its uid is changed.
+type MemoAlias struct {
+	UID       string
+	MemoID    int32
+	CreatedTs int64
+}
+
+type FindMemoAlias struct {
+	UID    *string
+	MemoID *int32
+}
+
+type DeleteMemoAlias struct {
+	UID    *string
+	MemoID *int32
+}
+
+// UpsertMemoAlias records the uid as an alias of the memo, taking it over from any other memo.
+func (s *Store) UpsertMemoAlias(ctx context.Context, upsert *MemoAlias) (*MemoAlias, error) {
+	return s.driver.UpsertMemoAlias(ctx, upsert)
+}
+
+// ListMemoAliases lists memo aliases ordered from newest to oldest.
+func (s *Store) ListMemoAliases(ctx context.Context, find *FindMemoAlias) ([]*MemoAlias, error) {
+	return s.driver.ListMemoAliases(ctx, find)
+}
+
+func (s *Store) GetMemoAlias(ctx context.Context, find *FindMemoAlias) (*MemoAlias, error) {
+	list, err := s.ListMemoAliases(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) DeleteMemoAlias(ctx context.Context, delete *DeleteMemoAlias) error {
+	return s.driver.DeleteMemoAlias(ctx, delete)
+}
@@ -0,0 +1,59 @@
+package store
+
+import (
+	"context"
+)
+
+type MemoCollaboratorRole string
+
+const (
+	// MemoCollaboratorViewer is the role of a collaborator who can view the memo.
+	MemoCollaboratorViewer MemoCollaboratorRole = "VIEWER"
+	// MemoCollaboratorEditor is the role of a collaborator who can view and edit the memo.
+	MemoCollaboratorEditor MemoCollaboratorRole = "EDITOR"
+)
+
+func (r MemoCollaboratorRole) String() string {
+	return string(r)
+}
+
+// MemoCollaborator is a grant of access to a memo for a user other than its creator.
+type MemoCollaborator struct {
+	MemoID    int32
+	UserID    int32
+	Role      MemoCollaboratorRole
+	CreatedTs int64
+}
+
+type FindMemoCollaborator struct {
+	MemoID *int32
+	UserID *int32
+}
+
+type DeleteMemoCollaborator struct {
+	MemoID *int32
+	UserID *int32
+}
+
+func (s *Store) UpsertMemoCollaborator(ctx context.Context, upsert *MemoCollaborator) (*MemoCollaborator, error) {
+	return s.driver.UpsertMemoCollaborator(ctx, upsert)
+}
+
+func (s *Store) ListMemoCollaborators(ctx context.Context, find *FindMemoCollaborator) ([]*MemoCollaborator, error) {
+	return s.driver.ListMemoCollaborators(ctx, find)
+}
+
+func (s *Store) GetMemoCollaborator(ctx context.Context, find *FindMemoCollaborator) (*MemoCollaborator, error) {
+	list, err := s.ListMemoCollaborators(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) DeleteMemoCollaborator(ctx context.Context, delete *DeleteMemoCollaborator) error {
+	return s.driver.DeleteMemoCollaborator(ctx, delete)
+}
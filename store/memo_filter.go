@@ -0,0 +1,216 @@
+package store
+
+import (
+	"math"
+
+	"github.com/pkg/errors"
+)
+
+// MemoFilterField is a memo field that can be compared in a memo filter.
+type MemoFilterField string
+
+const (
+	// MemoFilterFieldID compares the memo ID, an int32 value.
+	MemoFilterFieldID MemoFilterField = "id"
+	// MemoFilterFieldUID compares the memo UID, a string value.
+	MemoFilterFieldUID MemoFilterField = "uid"
+	// MemoFilterFieldCreatorID compares the creator ID, an int32 value.
+	MemoFilterFieldCreatorID MemoFilterField = "creator_id"
+	// MemoFilterFieldCreatedTs compares the creation time in unix seconds, an int64 value.
+	MemoFilterFieldCreatedTs MemoFilterField = "created_ts"
+	// MemoFilterFieldUpdatedTs compares the update time in unix seconds, an int64 value.
+	MemoFilterFieldUpdatedTs MemoFilterField = "updated_ts"
+	// MemoFilterFieldRowStatus compares the row status, a RowStatus value.
+	MemoFilterFieldRowStatus MemoFilterField = "row_status"
+	// MemoFilterFieldVisibility compares the visibility, a Visibility value.
+	MemoFilterFieldVisibility MemoFilterField = "visibility"
+	// MemoFilterFieldTag matches the memos with a tag or any of its sub-tags, a string value.
+	// Only the equal, not equal and in operators are supported.
+	MemoFilterFieldTag MemoFilterField = "tag"
+
+	// The following fields are bool values, and only the equal and not equal operators are supported.
+	MemoFilterFieldPinned             MemoFilterField = "pinned"
+	MemoFilterFieldHasLink            MemoFilterField = "has_link"
+	MemoFilterFieldHasTaskList        MemoFilterField = "has_task_list"
+	MemoFilterFieldHasCode            MemoFilterField = "has_code"
+	MemoFilterFieldHasIncompleteTasks MemoFilterField = "has_incomplete_tasks"
+	MemoFilterFieldHasImage           MemoFilterField = "has_image"
+
+	// MemoFilterFieldLocation matches the memos by the coordinates of their location, float64 values in degrees.
+	// Only the geo operators are supported, and the memos without coordinates never match.
+	MemoFilterFieldLocation MemoFilterField = "location"
+)
+
+// MemoFilterOperator is the operator of a memo filter node.
+type MemoFilterOperator string
+
+const (
+	// Logical operators combine the children of the node.
+	MemoFilterOperatorAnd MemoFilterOperator = "AND"
+	MemoFilterOperatorOr  MemoFilterOperator = "OR"
+	MemoFilterOperatorNot MemoFilterOperator = "NOT"
+
+	// Comparison operators compare the field of the node with its values.
+	MemoFilterOperatorEqual          MemoFilterOperator = "="
+	MemoFilterOperatorNotEqual       MemoFilterOperator = "!="
+	MemoFilterOperatorLess           MemoFilterOperator = "<"
+	MemoFilterOperatorLessOrEqual    MemoFilterOperator = "<="
+	MemoFilterOperatorGreater        MemoFilterOperator = ">"
+	MemoFilterOperatorGreaterOrEqual MemoFilterOperator = ">="
+	MemoFilterOperatorIn             MemoFilterOperator = "IN"
+
+	// Geo operators match the location of the memos.
+	// WITHIN_BOX takes the south, west, north and east bounds, where west is greater than east for a box across the antimeridian.
+	MemoFilterOperatorWithinBox MemoFilterOperator = "WITHIN_BOX"
+	// WITHIN_RADIUS takes the latitude and longitude of the center and the radius in meters.
+	MemoFilterOperatorWithinRadius MemoFilterOperator = "WITHIN_RADIUS"
+)
+
+// EarthRadiusMeters is the mean radius of the earth, which the distances between locations are computed with.
+const EarthRadiusMeters = 6371008.8
+
+// GetDistanceHaversine returns the haversine of the central angle of the distance in meters on the earth.
+// The drivers compare it with the haversine formula of the locations, which needs no inverse trigonometric functions.
+func GetDistanceHaversine(meters float64) float64 {
+	angle := math.Min(meters/EarthRadiusMeters, math.Pi)
+	return math.Pow(math.Sin(angle/2), 2)
+}
+
+// MemoFilter is a driver independent predicate tree on memos, which each driver renders to SQL.
+type MemoFilter struct {
+	Operator MemoFilterOperator
+	// Children are the operands of the logical operators. NOT takes exactly one child.
+	Children []*MemoFilter
+	// Field and Values are the operands of the comparison operators.
+	// IN takes any number of values and matches nothing if there are none, the others take exactly one value.
+	Field  MemoFilterField
+	Values []any
+}
+
+func NewMemoFilterAnd(children ...*MemoFilter) *MemoFilter {
+	return &MemoFilter{Operator: MemoFilterOperatorAnd, Children: children}
+}
+
+func NewMemoFilterOr(children ...*MemoFilter) *MemoFilter {
+	return &MemoFilter{Operator: MemoFilterOperatorOr, Children: children}
+}
+
+func NewMemoFilterNot(child *MemoFilter) *MemoFilter {
+	return &MemoFilter{Operator: MemoFilterOperatorNot, Children: []*MemoFilter{child}}
+}
+
+func NewMemoFilterCondition(field MemoFilterField, operator MemoFilterOperator, values ...any) *MemoFilter {
+	return &MemoFilter{Operator: operator, Field: field, Values: values}
+}
+
+// IsLogical returns whether the node combines its children rather than comparing a field.
+func (f *MemoFilter) IsLogical() bool {
+	return f.Operator == MemoFilterOperatorAnd || f.Operator == MemoFilterOperatorOr || f.Operator == MemoFilterOperatorNot
+}
+
+// Validate checks that the operators, fields and values of the tree are consistent.
+func (f *MemoFilter) Validate() error {
+	if f.IsLogical() {
+		if f.Operator == MemoFilterOperatorNot && len(f.Children) != 1 {
+			return errors.New("NOT takes exactly one operand")
+		}
+		if len(f.Children) == 0 {
+			return errors.Errorf("%s takes at least one operand", f.Operator)
+		}
+		for _, child := range f.Children {
+			if err := child.Validate(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if f.Field == MemoFilterFieldLocation {
+		return f.validateLocation()
+	}
+	switch f.Operator {
+	case MemoFilterOperatorIn:
+	case MemoFilterOperatorWithinBox, MemoFilterOperatorWithinRadius:
+		return errors.Errorf("%s only supports %s", f.Operator, MemoFilterFieldLocation)
+	case MemoFilterOperatorEqual, MemoFilterOperatorNotEqual, MemoFilterOperatorLess, MemoFilterOperatorLessOrEqual, MemoFilterOperatorGreater, MemoFilterOperatorGreaterOrEqual:
+		if len(f.Values) != 1 {
+			return errors.Errorf("%s takes exactly one value", f.Operator)
+		}
+	default:
+		return errors.Errorf("unsupported operator %q", f.Operator)
+	}
+	ordered := f.Operator != MemoFilterOperatorEqual && f.Operator != MemoFilterOperatorNotEqual && f.Operator != MemoFilterOperatorIn
+	for _, value := range f.Values {
+		var ok bool
+		switch f.Field {
+		case MemoFilterFieldID, MemoFilterFieldCreatorID:
+			_, ok = value.(int32)
+		case MemoFilterFieldCreatedTs, MemoFilterFieldUpdatedTs:
+			_, ok = value.(int64)
+		case MemoFilterFieldUID:
+			_, ok = value.(string)
+		case MemoFilterFieldRowStatus:
+			_, ok = value.(RowStatus)
+		case MemoFilterFieldVisibility:
+			_, ok = value.(Visibility)
+		case MemoFilterFieldTag:
+			_, ok = value.(string)
+			ok = ok && !ordered
+		case MemoFilterFieldPinned, MemoFilterFieldHasLink, MemoFilterFieldHasTaskList, MemoFilterFieldHasCode, MemoFilterFieldHasIncompleteTasks, MemoFilterFieldHasImage:
+			_, ok = value.(bool)
+			ok = ok && !ordered && f.Operator != MemoFilterOperatorIn
+		default:
+			return errors.Errorf("unsupported field %q", f.Field)
+		}
+		if !ok {
+			return errors.Errorf("invalid value %v for %s %s", value, f.Field, f.Operator)
+		}
+	}
+	return nil
+}
+
+// validateLocation checks the bounds or the circle of the location condition.
+func (f *MemoFilter) validateLocation() error {
+	coordinates := []float64{}
+	for _, value := range f.Values {
+		coordinate, ok := value.(float64)
+		if !ok {
+			return errors.Errorf("invalid value %v for %s %s", value, f.Field, f.Operator)
+		}
+		coordinates = append(coordinates, coordinate)
+	}
+	switch f.Operator {
+	case MemoFilterOperatorWithinBox:
+		if len(coordinates) != 4 {
+			return errors.Errorf("%s takes the south, west, north and east bounds", f.Operator)
+		}
+		south, west, north, east := coordinates[0], coordinates[1], coordinates[2], coordinates[3]
+		if !isValidLatitude(south) || !isValidLatitude(north) || !isValidLongitude(west) || !isValidLongitude(east) {
+			return errors.Errorf("invalid bounds for %s", f.Operator)
+		}
+		if south > north {
+			return errors.New("the south bound must not be greater than the north bound")
+		}
+	case MemoFilterOperatorWithinRadius:
+		if len(coordinates) != 3 {
+			return errors.Errorf("%s takes the latitude, longitude and radius", f.Operator)
+		}
+		if !isValidLatitude(coordinates[0]) || !isValidLongitude(coordinates[1]) {
+			return errors.Errorf("invalid center for %s", f.Operator)
+		}
+		if coordinates[2] <= 0 {
+			return errors.New("the radius must be positive")
+		}
+	default:
+		return errors.Errorf("unsupported operator %q for %s", f.Operator, f.Field)
+	}
+	return nil
+}
+
+func isValidLatitude(latitude float64) bool {
+	return latitude >= -90 && latitude <= 90
+}
+
+func isValidLongitude(longitude float64) bool {
+	return longitude >= -180 && longitude <= 180
+}
@@ -0,0 +1,75 @@
+package store
+
+import (
+	"context"
+)
+
+// MemoRevision is a snapshot of a memo's content and visibility before a change.
+type MemoRevision struct {
+	ID        int32
+	MemoID    int32
+	CreatorID int32
+	CreatedTs int64
+
+	Content    string
+	Visibility Visibility
+}
+
+type FindMemoRevision struct {
+	ID     *int32
+	MemoID *int32
+
+	// Pagination
+	Limit  *int
+	Offset *int
+}
+
+type DeleteMemoRevision struct {
+	ID     *int32
+	MemoID *int32
+}
+
+func (s *Store) CreateMemoRevision(ctx context.Context, create *MemoRevision) (*MemoRevision, error) {
+	return s.driver.CreateMemoRevision(ctx, create)
+}
+
+// ListMemoRevisions lists memo revisions ordered from newest to oldest.
+func (s *Store) ListMemoRevisions(ctx context.Context, find *FindMemoRevision) ([]*MemoRevision, error) {
+	return s.driver.ListMemoRevisions(ctx, find)
+}
+
+func (s *Store) GetMemoRevision(ctx context.Context, find *FindMemoRevision) (*MemoRevision, error) {
+	list, err := s.ListMemoRevisions(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	revision := list[0]
+	return revision, nil
+}
+
+func (s *Store) DeleteMemoRevision(ctx context.Context, delete *DeleteMemoRevision) error {
+	return s.driver.DeleteMemoRevision(ctx, delete)
+}
+
+// PruneMemoRevisions deletes the oldest revisions of a memo so that at most limit revisions are kept.
+func (s *Store) PruneMemoRevisions(ctx context.Context, memoID int32, limit int) error {
+	list, err := s.ListMemoRevisions(ctx, &FindMemoRevision{
+		MemoID: &memoID,
+	})
+	if err != nil {
+		return err
+	}
+	if len(list) <= limit {
+		return nil
+	}
+	for _, revision := range list[limit:] {
+		if err := s.DeleteMemoRevision(ctx, &DeleteMemoRevision{ID: &revision.ID}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package store
+
+import (
+	"context"
+)
+
+// MemoShare is a link granting read-only access to a memo to anyone holding its token.
+type MemoShare struct {
+	ID        int32
+	MemoID    int32
+	CreatorID int32
+	CreatedTs int64
+
+	Token string
+	// PasswordHash is the bcrypt hash of the password of the link, empty if the link has no password.
+	PasswordHash string
+	// ExpiresTs is the time the link expires at, nil if it never expires.
+	ExpiresTs      *int64
+	AccessCount    int32
+	LastAccessedTs *int64
+}
+
+type FindMemoShare struct {
+	ID     *int32
+	MemoID *int32
+	Token  *string
+}
+
+type DeleteMemoShare struct {
+	ID     *int32
+	MemoID *int32
+}
+
+func (s *Store) CreateMemoShare(ctx context.Context, create *MemoShare) (*MemoShare, error) {
+	return s.driver.CreateMemoShare(ctx, create)
+}
+
+// ListMemoShares lists memo shares ordered from newest to oldest.
+func (s *Store) ListMemoShares(ctx context.Context, find *FindMemoShare) ([]*MemoShare, error) {
+	return s.driver.ListMemoShares(ctx, find)
+}
+
+func (s *Store) GetMemoShare(ctx context.Context, find *FindMemoShare) (*MemoShare, error) {
+	list, err := s.ListMemoShares(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+// RecordMemoShareAccess counts an access through the memo share at the given time.
+func (s *Store) RecordMemoShareAccess(ctx context.Context, id int32, accessedTs int64) error {
+	return s.driver.RecordMemoShareAccess(ctx, id, accessedTs)
+}
+
+func (s *Store) DeleteMemoShare(ctx context.Context, delete *DeleteMemoShare) error {
+	return s.driver.DeleteMemoShare(ctx, delete)
+}
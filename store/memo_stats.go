@@ -0,0 +1,52 @@
+package store
+
+import (
+	"context"
+)
+
+// MemoStatsBucketSeconds is the width of the time buckets the memo stats are aggregated in.
+// Every UTC offset in use is a multiple of it, so the buckets fall into whole days in any timezone.
+const MemoStatsBucketSeconds = 15 * 60
+
+// MemoStatsBucket is the aggregate of the memos created in a time bucket.
+type MemoStatsBucket struct {
+	// Ts is the start of the bucket.
+	Ts                  int64
+	MemoCount           int32
+	WordCount           int64
+	LinkCount           int32
+	CodeCount           int32
+	TaskListCount       int32
+	ImageCount          int32
+	IncompleteTaskCount int32
+}
+
+type MemoStats struct {
+	// Buckets are the buckets with memos, ordered by time.
+	Buckets []*MemoStatsBucket
+	// TagCounts is the number of memos with each tag.
+	TagCounts map[string]int32
+}
+
+// FindMemoStats finds the normal memos of the creator, excluding comments and memos in trash.
+type FindMemoStats struct {
+	CreatorID      int32
+	VisibilityList []Visibility
+}
+
+// MemoTag is a tag of a memo.
+type MemoTag struct {
+	MemoID int32
+	Tag    string
+	// UpdatedTs is the last update time of the memo.
+	UpdatedTs int64
+}
+
+func (s *Store) GetMemoStats(ctx context.Context, find *FindMemoStats) (*MemoStats, error) {
+	return s.driver.GetMemoStats(ctx, find)
+}
+
+// ListMemoTags lists the tags of the memos of the find, ordered by memo.
+func (s *Store) ListMemoTags(ctx context.Context, find *FindMemoStats) ([]*MemoTag, error) {
+	return s.driver.ListMemoTags(ctx, find)
+}
@@ -0,0 +1,118 @@
+package store
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// MemoTerm is the number of times a term occurs in the content of a memo, an entry of the term index of the memos.
+type MemoTerm struct {
+	MemoID    int32
+	Term      string
+	Frequency int32
+}
+
+type FindMemoTerm struct {
+	MemoIDList []int32
+	TermList   []string
+	// VisibilityList restricts the terms to the memos with any of the visibilities, when set.
+	VisibilityList []Visibility
+	// UserID widens VisibilityList with the memos of the user and the memos the user is a collaborator of.
+	UserID *int32
+}
+
+// MemoTermStats are the sizes of the term index, which weigh the terms and the lengths of the memos.
+type MemoTermStats struct {
+	// MemoCount is the number of memos with terms in the index.
+	MemoCount int32
+	// TermCount is the number of occurrences of the terms of all the memos.
+	TermCount int64
+	// MemoTermCounts is the number of occurrences of the terms of each memo of the find.
+	MemoTermCounts map[int32]int64
+}
+
+type FindMemoTermStats struct {
+	MemoIDList []int32
+	// VisibilityList and UserID restrict the memos counted in MemoCount and TermCount, as in FindMemoTerm.
+	VisibilityList []Visibility
+	UserID         *int32
+}
+
+// maxMemoTermLength is the maximum length in bytes of an indexed term, longer words are left out.
+const maxMemoTermLength = 64
+
+// memoTermStopWords are the common English words left out of the index, along with the parts of links.
+var memoTermStopWords = map[string]bool{
+	"a": true, "about": true, "after": true, "all": true, "also": true, "an": true, "and": true, "any": true,
+	"are": true, "as": true, "at": true, "be": true, "been": true, "but": true, "by": true, "can": true,
+	"com": true, "could": true, "did": true, "do": true, "does": true, "for": true, "from": true, "had": true,
+	"has": true, "have": true, "he": true, "her": true, "his": true, "how": true, "http": true, "https": true,
+	"if": true, "in": true, "into": true, "is": true, "it": true, "its": true, "just": true, "me": true,
+	"my": true, "no": true, "not": true, "of": true, "on": true, "or": true, "our": true, "out": true,
+	"she": true, "so": true, "some": true, "than": true, "that": true, "the": true, "their": true, "them": true,
+	"then": true, "there": true, "these": true, "they": true, "this": true, "to": true, "up": true, "us": true,
+	"was": true, "we": true, "were": true, "what": true, "when": true, "which": true, "who": true, "will": true,
+	"with": true, "would": true, "www": true, "you": true, "your": true,
+}
+
+// GetMemoTerms returns the number of times each term occurs in the content.
+// The terms are the lowercased words, without stop words and numbers, and every character
+// of the scripts written without spaces between words is a term of its own.
+func GetMemoTerms(content string) map[string]int32 {
+	terms := map[string]int32{}
+	for _, word := range searchWordSeparator.Split(strings.ToLower(content), -1) {
+		start := 0
+		for i, r := range word {
+			if unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Thai) {
+				appendMemoTerm(terms, word[start:i])
+				terms[string(r)]++
+				start = i + utf8.RuneLen(r)
+			}
+		}
+		appendMemoTerm(terms, word[start:])
+	}
+	return terms
+}
+
+func appendMemoTerm(terms map[string]int32, word string) {
+	word = strings.Trim(word, "_")
+	if utf8.RuneCountInString(word) < 2 || len(word) > maxMemoTermLength || memoTermStopWords[word] {
+		return
+	}
+	if strings.IndexFunc(word, func(r rune) bool { return !unicode.IsNumber(r) }) == -1 {
+		return
+	}
+	terms[word]++
+}
+
+// IndexMemoTerms replaces the terms of the memo in the index with the terms of its content.
+func (s *Store) IndexMemoTerms(ctx context.Context, memoID int32, content string) error {
+	terms := []*MemoTerm{}
+	for term, frequency := range GetMemoTerms(content) {
+		terms = append(terms, &MemoTerm{
+			MemoID:    memoID,
+			Term:      term,
+			Frequency: frequency,
+		})
+	}
+	sort.Slice(terms, func(i, j int) bool {
+		return terms[i].Term < terms[j].Term
+	})
+	if err := s.driver.SetMemoTerms(ctx, memoID, terms); err != nil {
+		return errors.Wrap(err, "failed to set memo terms")
+	}
+	return nil
+}
+
+func (s *Store) ListMemoTerms(ctx context.Context, find *FindMemoTerm) ([]*MemoTerm, error) {
+	return s.driver.ListMemoTerms(ctx, find)
+}
+
+func (s *Store) GetMemoTermStats(ctx context.Context, find *FindMemoTermStats) (*MemoTermStats, error) {
+	return s.driver.GetMemoTermStats(ctx, find)
+}
@@ -0,0 +1,49 @@
+package store
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+
+	"github.com/pkg/errors"
+)
+
+// PurgeMemo permanently deletes a memo and its comments along with their relations, resources, revisions, shares,
+// collaborators, terms and aliases. The rows are deleted in one transaction, and the blobs of the resources in local
+// or S3 storage once it is committed, so that a failure does not leave rows of a deleted memo behind.
+func (s *Store) PurgeMemo(ctx context.Context, id int32) error {
+	// The comments of the memo, and the comments of its comments, are purged with it.
+	memoIDs := []int32{id}
+	commentType := MemoRelationComment
+	for i := 0; i < len(memoIDs); i++ {
+		relations, err := s.ListMemoRelations(ctx, &FindMemoRelation{RelatedMemoID: &memoIDs[i], Type: &commentType})
+		if err != nil {
+			return errors.Wrap(err, "failed to list memo comments")
+		}
+		for _, relation := range relations {
+			if !slices.Contains(memoIDs, relation.MemoID) {
+				memoIDs = append(memoIDs, relation.MemoID)
+			}
+		}
+	}
+
+	resources := []*Resource{}
+	for _, memoID := range memoIDs {
+		list, err := s.ListResources(ctx, &FindResource{MemoID: &memoID})
+		if err != nil {
+			return errors.Wrap(err, "failed to list resources")
+		}
+		resources = append(resources, list...)
+	}
+
+	if err := s.driver.PurgeMemos(ctx, memoIDs); err != nil {
+		return errors.Wrap(err, "failed to purge memos")
+	}
+
+	for _, resource := range resources {
+		if err := s.deleteResourceBlob(ctx, resource); err != nil {
+			slog.Warn("Failed to delete resource blob", slog.String("resource", resource.UID), slog.Any("err", err))
+		}
+	}
+	return nil
+}
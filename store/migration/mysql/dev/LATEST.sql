@@ -44,7 +44,16 @@ CREATE TABLE `memo` (
   `row_status` VARCHAR(256) NOT NULL DEFAULT 'NORMAL',
   `content` TEXT NOT NULL,
   `visibility` VARCHAR(256) NOT NULL DEFAULT 'PRIVATE',
-  `payload` JSON NOT NULL
+  `payload` JSON NOT NULL,
+  `trashed_ts` BIGINT,
+  `publish_ts` BIGINT,
+  `publish_visibility` VARCHAR(256) NOT NULL DEFAULT 'PRIVATE',
+  `version` INT NOT NULL DEFAULT 0,
+  `remind_ts` BIGINT,
+  FULLTEXT INDEX idx_memo_content_fulltext (`content`),
+  INDEX idx_memo_trashed_ts (`trashed_ts`),
+  INDEX idx_memo_publish_ts (`publish_ts`),
+  INDEX idx_memo_remind_ts (`remind_ts`)
 );
 
 -- memo_organizer
@@ -166,3 +175,78 @@ CREATE TABLE `memo_review` (
 CREATE INDEX idx_memo_review_user ON `memo_review`(`user_id`);
 CREATE INDEX idx_memo_review_user_time ON `memo_review`(`user_id`, `reviewed_at`);
 CREATE INDEX idx_memo_review_user_memo ON `memo_review`(`user_id`, `memo_id`);
+
+-- memo_revision
+CREATE TABLE `memo_revision` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `memo_id` INT NOT NULL,
+  `creator_id` INT NOT NULL,
+  `created_ts` BIGINT NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  `content` TEXT NOT NULL,
+  `visibility` VARCHAR(256) NOT NULL DEFAULT 'PRIVATE'
+);
+
+CREATE INDEX idx_memo_revision_memo_id ON `memo_revision`(`memo_id`, `created_ts`);
+
+-- job
+CREATE TABLE `job` (
+  `name` VARCHAR(256) NOT NULL PRIMARY KEY,
+  `schedule` VARCHAR(256) NOT NULL,
+  `updated_ts` BIGINT NOT NULL DEFAULT (UNIX_TIMESTAMP())
+);
+
+-- job_run
+CREATE TABLE `job_run` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `job_name` VARCHAR(256) NOT NULL,
+  `started_ts` BIGINT NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  `ended_ts` BIGINT,
+  `error` TEXT NOT NULL
+);
+
+CREATE INDEX idx_job_run_job_name ON `job_run`(`job_name`, `started_ts`);
+
+-- memo_share
+CREATE TABLE `memo_share` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `memo_id` INT NOT NULL,
+  `creator_id` INT NOT NULL,
+  `created_ts` BIGINT NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  `token` VARCHAR(256) NOT NULL UNIQUE,
+  `password_hash` VARCHAR(256) NOT NULL DEFAULT '',
+  `expires_ts` BIGINT,
+  `access_count` INT NOT NULL DEFAULT 0,
+  `last_accessed_ts` BIGINT
+);
+
+CREATE INDEX idx_memo_share_memo_id ON `memo_share`(`memo_id`);
+
+-- memo_collaborator
+CREATE TABLE `memo_collaborator` (
+  `memo_id` INT NOT NULL,
+  `user_id` INT NOT NULL,
+  `role` VARCHAR(256) NOT NULL DEFAULT 'VIEWER',
+  `created_ts` BIGINT NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  UNIQUE(`memo_id`,`user_id`)
+);
+
+CREATE INDEX idx_memo_collaborator_user_id ON `memo_collaborator`(`user_id`);
+
+-- memo_term
+CREATE TABLE `memo_term` (
+  `memo_id` INT NOT NULL,
+  `term` VARCHAR(256) NOT NULL,
+  `frequency` INT NOT NULL,
+  UNIQUE(`memo_id`,`term`)
+);
+
+CREATE INDEX idx_memo_term_term ON `memo_term`(`term`);
+
+-- memo_alias
+CREATE TABLE `memo_alias` (
+  `uid` VARCHAR(256) NOT NULL UNIQUE,
+  `memo_id` INT NOT NULL,
+  `created_ts` BIGINT NOT NULL DEFAULT (UNIX_TIMESTAMP())
+);
+
+CREATE INDEX idx_memo_alias_memo_id ON `memo_alias`(`memo_id`);
@@ -0,0 +1 @@
+CREATE FULLTEXT INDEX idx_memo_content_fulltext ON `memo` (`content`);
@@ -0,0 +1,17 @@
+-- job
+CREATE TABLE `job` (
+  `name` VARCHAR(256) NOT NULL PRIMARY KEY,
+  `schedule` VARCHAR(256) NOT NULL,
+  `updated_ts` BIGINT NOT NULL DEFAULT (UNIX_TIMESTAMP())
+);
+
+-- job_run
+CREATE TABLE `job_run` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `job_name` VARCHAR(256) NOT NULL,
+  `started_ts` BIGINT NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  `ended_ts` BIGINT,
+  `error` TEXT NOT NULL
+);
+
+CREATE INDEX idx_job_run_job_name ON `job_run`(`job_name`, `started_ts`);
@@ -0,0 +1,14 @@
+-- memo_share
+CREATE TABLE `memo_share` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `memo_id` INT NOT NULL,
+  `creator_id` INT NOT NULL,
+  `created_ts` BIGINT NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  `token` VARCHAR(256) NOT NULL UNIQUE,
+  `password_hash` VARCHAR(256) NOT NULL DEFAULT '',
+  `expires_ts` BIGINT,
+  `access_count` INT NOT NULL DEFAULT 0,
+  `last_accessed_ts` BIGINT
+);
+
+CREATE INDEX idx_memo_share_memo_id ON `memo_share`(`memo_id`);
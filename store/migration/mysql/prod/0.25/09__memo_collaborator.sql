@@ -0,0 +1,10 @@
+-- memo_collaborator
+CREATE TABLE `memo_collaborator` (
+  `memo_id` INT NOT NULL,
+  `user_id` INT NOT NULL,
+  `role` VARCHAR(256) NOT NULL DEFAULT 'VIEWER',
+  `created_ts` BIGINT NOT NULL DEFAULT (UNIX_TIMESTAMP()),
+  UNIQUE(`memo_id`,`user_id`)
+);
+
+CREATE INDEX idx_memo_collaborator_user_id ON `memo_collaborator`(`user_id`);
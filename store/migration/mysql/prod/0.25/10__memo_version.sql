@@ -0,0 +1 @@
+ALTER TABLE `memo` ADD COLUMN `version` INT NOT NULL DEFAULT 0;
@@ -0,0 +1,9 @@
+-- memo_term
+CREATE TABLE `memo_term` (
+  `memo_id` INT NOT NULL,
+  `term` VARCHAR(256) NOT NULL,
+  `frequency` INT NOT NULL,
+  UNIQUE(`memo_id`,`term`)
+);
+
+CREATE INDEX idx_memo_term_term ON `memo_term`(`term`);
@@ -0,0 +1,8 @@
+-- memo_alias
+CREATE TABLE `memo_alias` (
+  `uid` VARCHAR(256) NOT NULL UNIQUE,
+  `memo_id` INT NOT NULL,
+  `created_ts` BIGINT NOT NULL DEFAULT (UNIX_TIMESTAMP())
+);
+
+CREATE INDEX idx_memo_alias_memo_id ON `memo_alias`(`memo_id`);
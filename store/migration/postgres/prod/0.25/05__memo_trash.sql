@@ -0,0 +1,3 @@
+ALTER TABLE memo ADD COLUMN trashed_ts BIGINT;
+
+CREATE INDEX idx_memo_trashed_ts ON memo (trashed_ts);
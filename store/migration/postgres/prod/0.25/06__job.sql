@@ -0,0 +1,17 @@
+-- job
+CREATE TABLE job (
+  name TEXT NOT NULL PRIMARY KEY,
+  schedule TEXT NOT NULL,
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW())
+);
+
+-- job_run
+CREATE TABLE job_run (
+  id SERIAL PRIMARY KEY,
+  job_name TEXT NOT NULL,
+  started_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  ended_ts BIGINT,
+  error TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_job_run_job_name ON job_run(job_name, started_ts);
@@ -0,0 +1,5 @@
+ALTER TABLE memo ADD COLUMN publish_ts BIGINT;
+
+ALTER TABLE memo ADD COLUMN publish_visibility TEXT NOT NULL DEFAULT 'PRIVATE';
+
+CREATE INDEX idx_memo_publish_ts ON memo (publish_ts);
@@ -0,0 +1,14 @@
+-- memo_share
+CREATE TABLE memo_share (
+  id SERIAL PRIMARY KEY,
+  memo_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  token TEXT NOT NULL UNIQUE,
+  password_hash TEXT NOT NULL DEFAULT '',
+  expires_ts BIGINT,
+  access_count INTEGER NOT NULL DEFAULT 0,
+  last_accessed_ts BIGINT
+);
+
+CREATE INDEX idx_memo_share_memo_id ON memo_share(memo_id);
@@ -0,0 +1,3 @@
+ALTER TABLE memo ADD COLUMN remind_ts BIGINT;
+
+CREATE INDEX idx_memo_remind_ts ON memo (remind_ts);
@@ -0,0 +1,8 @@
+-- memo_alias
+CREATE TABLE memo_alias (
+  uid TEXT NOT NULL UNIQUE,
+  memo_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW())
+);
+
+CREATE INDEX idx_memo_alias_memo_id ON memo_alias(memo_id);
@@ -45,9 +45,20 @@ CREATE TABLE memo (
   content TEXT NOT NULL,
   visibility TEXT NOT NULL DEFAULT 'PRIVATE',
   tags JSONB NOT NULL DEFAULT '[]',
-  payload JSONB NOT NULL DEFAULT '{}'
+  payload JSONB NOT NULL DEFAULT '{}',
+  content_tsv TSVECTOR GENERATED ALWAYS AS (to_tsvector('english', content)) STORED,
+  trashed_ts BIGINT,
+  publish_ts BIGINT,
+  publish_visibility TEXT NOT NULL DEFAULT 'PRIVATE',
+  version INTEGER NOT NULL DEFAULT 0,
+  remind_ts BIGINT
 );
 
+CREATE INDEX idx_memo_content_tsv ON memo USING GIN (content_tsv);
+CREATE INDEX idx_memo_trashed_ts ON memo (trashed_ts);
+CREATE INDEX idx_memo_publish_ts ON memo (publish_ts);
+CREATE INDEX idx_memo_remind_ts ON memo (remind_ts);
+
 -- memo_organizer
 CREATE TABLE memo_organizer (
   memo_id INTEGER NOT NULL,
@@ -176,3 +187,78 @@ CREATE TABLE memo_review_session_cache (
   memo_ids TEXT NOT NULL DEFAULT '[]',
   total_count INTEGER NOT NULL DEFAULT 0
 );
+
+-- memo_revision
+CREATE TABLE memo_revision (
+  id SERIAL PRIMARY KEY,
+  memo_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  content TEXT NOT NULL DEFAULT '',
+  visibility TEXT NOT NULL DEFAULT 'PRIVATE'
+);
+
+CREATE INDEX idx_memo_revision_memo_id ON memo_revision(memo_id, created_ts);
+
+-- job
+CREATE TABLE job (
+  name TEXT NOT NULL PRIMARY KEY,
+  schedule TEXT NOT NULL,
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW())
+);
+
+-- job_run
+CREATE TABLE job_run (
+  id SERIAL PRIMARY KEY,
+  job_name TEXT NOT NULL,
+  started_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  ended_ts BIGINT,
+  error TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_job_run_job_name ON job_run(job_name, started_ts);
+
+-- memo_share
+CREATE TABLE memo_share (
+  id SERIAL PRIMARY KEY,
+  memo_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  token TEXT NOT NULL UNIQUE,
+  password_hash TEXT NOT NULL DEFAULT '',
+  expires_ts BIGINT,
+  access_count INTEGER NOT NULL DEFAULT 0,
+  last_accessed_ts BIGINT
+);
+
+CREATE INDEX idx_memo_share_memo_id ON memo_share(memo_id);
+
+-- memo_collaborator
+CREATE TABLE memo_collaborator (
+  memo_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  role TEXT NOT NULL DEFAULT 'VIEWER',
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  UNIQUE(memo_id, user_id)
+);
+
+CREATE INDEX idx_memo_collaborator_user_id ON memo_collaborator(user_id);
+
+-- memo_term
+CREATE TABLE memo_term (
+  memo_id INTEGER NOT NULL,
+  term TEXT NOT NULL,
+  frequency INTEGER NOT NULL,
+  UNIQUE(memo_id, term)
+);
+
+CREATE INDEX idx_memo_term_term ON memo_term(term);
+
+-- memo_alias
+CREATE TABLE memo_alias (
+  uid TEXT NOT NULL UNIQUE,
+  memo_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW())
+);
+
+CREATE INDEX idx_memo_alias_memo_id ON memo_alias(memo_id);
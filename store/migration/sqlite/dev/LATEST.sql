@@ -47,12 +47,41 @@ CREATE TABLE memo (
   row_status TEXT NOT NULL CHECK (row_status IN ('NORMAL', 'ARCHIVED')) DEFAULT 'NORMAL',
   content TEXT NOT NULL DEFAULT '',
   visibility TEXT NOT NULL CHECK (visibility IN ('PUBLIC', 'PROTECTED', 'PRIVATE')) DEFAULT 'PRIVATE',
-  payload TEXT NOT NULL DEFAULT '{}'
+  payload TEXT NOT NULL DEFAULT '{}',
+  trashed_ts BIGINT,
+  publish_ts BIGINT,
+  publish_visibility TEXT NOT NULL CHECK (publish_visibility IN ('PUBLIC', 'PROTECTED', 'PRIVATE')) DEFAULT 'PRIVATE',
+  version INTEGER NOT NULL DEFAULT 0,
+  remind_ts BIGINT
 );
 
 CREATE INDEX idx_memo_creator_id ON memo (creator_id);
 CREATE INDEX idx_memo_content ON memo (content);
 CREATE INDEX idx_memo_visibility ON memo (visibility);
+CREATE INDEX idx_memo_trashed_ts ON memo (trashed_ts);
+CREATE INDEX idx_memo_publish_ts ON memo (publish_ts);
+CREATE INDEX idx_memo_remind_ts ON memo (remind_ts);
+
+-- memo_fts
+CREATE VIRTUAL TABLE memo_fts USING fts5(
+  content,
+  content = 'memo',
+  content_rowid = 'id',
+  tokenize = 'porter unicode61 remove_diacritics 2'
+);
+
+CREATE TRIGGER memo_fts_after_insert AFTER INSERT ON memo BEGIN
+  INSERT INTO memo_fts (rowid, content) VALUES (new.id, new.content);
+END;
+
+CREATE TRIGGER memo_fts_after_delete AFTER DELETE ON memo BEGIN
+  INSERT INTO memo_fts (memo_fts, rowid, content) VALUES ('delete', old.id, old.content);
+END;
+
+CREATE TRIGGER memo_fts_after_update AFTER UPDATE OF content ON memo BEGIN
+  INSERT INTO memo_fts (memo_fts, rowid, content) VALUES ('delete', old.id, old.content);
+  INSERT INTO memo_fts (rowid, content) VALUES (new.id, new.content);
+END;
 
 -- memo_organizer
 CREATE TABLE memo_organizer (
@@ -181,3 +210,78 @@ CREATE TABLE memo_review (
 CREATE INDEX idx_memo_review_user ON memo_review(user_id);
 CREATE INDEX idx_memo_review_user_time ON memo_review(user_id, reviewed_at);
 CREATE INDEX idx_memo_review_user_memo ON memo_review(user_id, memo_id);
+
+-- memo_revision
+CREATE TABLE memo_revision (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  memo_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  content TEXT NOT NULL DEFAULT '',
+  visibility TEXT NOT NULL CHECK (visibility IN ('PUBLIC', 'PROTECTED', 'PRIVATE')) DEFAULT 'PRIVATE'
+);
+
+CREATE INDEX idx_memo_revision_memo_id ON memo_revision(memo_id, created_ts);
+
+-- job
+CREATE TABLE job (
+  name TEXT NOT NULL PRIMARY KEY,
+  schedule TEXT NOT NULL,
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now'))
+);
+
+-- job_run
+CREATE TABLE job_run (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  job_name TEXT NOT NULL,
+  started_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  ended_ts BIGINT,
+  error TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_job_run_job_name ON job_run(job_name, started_ts);
+
+-- memo_share
+CREATE TABLE memo_share (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  memo_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  token TEXT NOT NULL UNIQUE,
+  password_hash TEXT NOT NULL DEFAULT '',
+  expires_ts BIGINT,
+  access_count INTEGER NOT NULL DEFAULT 0,
+  last_accessed_ts BIGINT
+);
+
+CREATE INDEX idx_memo_share_memo_id ON memo_share(memo_id);
+
+-- memo_collaborator
+CREATE TABLE memo_collaborator (
+  memo_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  role TEXT NOT NULL CHECK (role IN ('VIEWER', 'EDITOR')) DEFAULT 'VIEWER',
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  UNIQUE(memo_id, user_id)
+);
+
+CREATE INDEX idx_memo_collaborator_user_id ON memo_collaborator(user_id);
+
+-- memo_term
+CREATE TABLE memo_term (
+  memo_id INTEGER NOT NULL,
+  term TEXT NOT NULL,
+  frequency INTEGER NOT NULL,
+  UNIQUE(memo_id, term)
+);
+
+CREATE INDEX idx_memo_term_term ON memo_term(term);
+
+-- memo_alias
+CREATE TABLE memo_alias (
+  uid TEXT NOT NULL UNIQUE,
+  memo_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now'))
+);
+
+CREATE INDEX idx_memo_alias_memo_id ON memo_alias(memo_id);
@@ -0,0 +1,5 @@
+ALTER TABLE memo ADD COLUMN publish_ts BIGINT;
+
+ALTER TABLE memo ADD COLUMN publish_visibility TEXT NOT NULL CHECK (publish_visibility IN ('PUBLIC', 'PROTECTED', 'PRIVATE')) DEFAULT 'PRIVATE';
+
+CREATE INDEX idx_memo_publish_ts ON memo (publish_ts);
@@ -0,0 +1,9 @@
+-- memo_term
+CREATE TABLE memo_term (
+  memo_id INTEGER NOT NULL,
+  term TEXT NOT NULL,
+  frequency INTEGER NOT NULL,
+  UNIQUE(memo_id, term)
+);
+
+CREATE INDEX idx_memo_term_term ON memo_term(term);
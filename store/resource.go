@@ -49,6 +49,8 @@ type FindResource struct {
 	StorageType    *storepb.ResourceStorageType
 	Limit          *int
 	Offset         *int
+	// Cursor is the updated_ts and id of the last resource of the previous page.
+	Cursor *Cursor
 }
 
 type UpdateResource struct {
@@ -106,6 +108,16 @@ func (s *Store) DeleteResource(ctx context.Context, delete *DeleteResource) erro
 		return errors.Wrap(nil, "resource not found")
 	}
 
+	if err := s.deleteResourceBlob(ctx, resource); err != nil {
+		return err
+	}
+
+	return s.driver.DeleteResource(ctx, delete)
+}
+
+// deleteResourceBlob deletes the blob of the resource in local or S3 storage.
+// The blobs stored in the database are deleted with the resource.
+func (s *Store) deleteResourceBlob(ctx context.Context, resource *Resource) error {
 	if resource.StorageType == storepb.ResourceStorageType_LOCAL {
 		if err := func() error {
 			p := filepath.FromSlash(resource.Reference)
@@ -150,6 +162,5 @@ func (s *Store) DeleteResource(ctx context.Context, delete *DeleteResource) erro
 			slog.Warn("Failed to delete s3 object", slog.Any("err", err))
 		}
 	}
-
-	return s.driver.DeleteResource(ctx, delete)
+	return nil
 }
@@ -47,6 +47,11 @@ func (s *Store) UpdateTag(ctx context.Context, update *UpdateTag) (*Tag, error)
 	return s.driver.UpdateTag(ctx, update)
 }
 
+// ListTags returns the tags with metadata of the find.
+func (s *Store) ListTags(ctx context.Context, find *FindTag) ([]*Tag, error) {
+	return s.driver.ListTags(ctx, find)
+}
+
 // ListPinnedTags returns all pinned tags for a user, ordered by pinned time (newest first).
 func (s *Store) ListPinnedTags(ctx context.Context, creatorID int32) ([]*Tag, error) {
 	onlyPinned := true
@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -132,6 +133,36 @@ func (s *Store) RemoveUserAccessToken(ctx context.Context, userID int32, token s
 	return err
 }
 
+// GetUserShortcuts returns the shortcuts of the user.
+func (s *Store) GetUserShortcuts(ctx context.Context, userID int32) ([]*storepb.ShortcutsUserSetting_Shortcut, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSettingKey_SHORTCUTS,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return []*storepb.ShortcutsUserSetting_Shortcut{}, nil
+	}
+	return userSetting.GetShortcuts().Shortcuts, nil
+}
+
+// GetUserMemoRules returns the memo rules of the user.
+func (s *Store) GetUserMemoRules(ctx context.Context, userID int32) ([]*storepb.MemoRulesUserSetting_Rule, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSettingKey_MEMO_RULES,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return []*storepb.MemoRulesUserSetting_Rule{}, nil
+	}
+	return userSetting.GetMemoRules().Rules, nil
+}
+
 func convertUserSettingFromRaw(raw *UserSetting) (*storepb.UserSetting, error) {
 	userSetting := &storepb.UserSetting{
 		UserId: raw.UserID,
@@ -157,6 +188,20 @@ func convertUserSettingFromRaw(raw *UserSetting) (*storepb.UserSetting, error) {
 			return nil, err
 		}
 		userSetting.Value = &storepb.UserSetting_ReviewSetting{ReviewSetting: reviewSetting}
+	case storepb.UserSettingKey_SHORTCUTS:
+		shortcutsUserSetting := &storepb.ShortcutsUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), shortcutsUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_Shortcuts{Shortcuts: shortcutsUserSetting}
+	case storepb.UserSettingKey_MEMO_RULES:
+		memoRulesUserSetting := &storepb.MemoRulesUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), memoRulesUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_MemoRules{MemoRules: memoRulesUserSetting}
+	case storepb.UserSettingKey_MEMO_SLUG:
+		userSetting.Value = &storepb.UserSetting_MemoSlug{MemoSlug: raw.Value == "true"}
 	default:
 		return nil, nil
 	}
@@ -190,6 +235,22 @@ func convertUserSettingToRaw(userSetting *storepb.UserSetting) (*UserSetting, er
 			return nil, err
 		}
 		raw.Value = string(value)
+	case storepb.UserSettingKey_SHORTCUTS:
+		shortcutsUserSetting := userSetting.GetShortcuts()
+		value, err := protojson.Marshal(shortcutsUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSettingKey_MEMO_RULES:
+		memoRulesUserSetting := userSetting.GetMemoRules()
+		value, err := protojson.Marshal(memoRulesUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSettingKey_MEMO_SLUG:
+		raw.Value = strconv.FormatBool(userSetting.GetMemoSlug())
 	default:
 		return nil, errors.Errorf("unsupported user setting key: %v", userSetting.Key)
 	}
@@ -142,6 +142,12 @@ func (s *Store) GetWorkspaceGeneralSetting(ctx context.Context) (*storepb.Worksp
 // DefaultContentLengthLimit is the default limit of content length in bytes. 8KB.
 const DefaultContentLengthLimit = 8 * 1024
 
+// DefaultMemoRevisionLimit is the default max number of revisions kept for each memo.
+const DefaultMemoRevisionLimit = 50
+
+// DefaultTrashRetentionDays is the default number of days trashed memos are kept.
+const DefaultTrashRetentionDays = 30
+
 // DefaultReactions is the default reactions for memo related setting.
 var DefaultReactions = []string{"👍", "👎", "❤️", "🎉", "😄", "😕", "😢", "😡"}
 
@@ -160,6 +166,12 @@ func (s *Store) GetWorkspaceMemoRelatedSetting(ctx context.Context) (*storepb.Wo
 	if workspaceMemoRelatedSetting.ContentLengthLimit < DefaultContentLengthLimit {
 		workspaceMemoRelatedSetting.ContentLengthLimit = DefaultContentLengthLimit
 	}
+	if workspaceMemoRelatedSetting.MemoRevisionLimit <= 0 {
+		workspaceMemoRelatedSetting.MemoRevisionLimit = DefaultMemoRevisionLimit
+	}
+	if workspaceMemoRelatedSetting.TrashRetentionDays <= 0 {
+		workspaceMemoRelatedSetting.TrashRetentionDays = DefaultTrashRetentionDays
+	}
 	if len(workspaceMemoRelatedSetting.Reactions) == 0 {
 		workspaceMemoRelatedSetting.Reactions = append(workspaceMemoRelatedSetting.Reactions, DefaultReactions...)
 	}
@@ -52,3 +52,41 @@ func TestInboxStore(t *testing.T) {
 	require.Equal(t, 0, len(inboxes))
 	ts.Close()
 }
+
+func TestInboxListByCursor(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	// The inboxes are created within the same second, so they are ordered by id.
+	inboxIDs := []int32{}
+	for i := 0; i < 3; i++ {
+		inbox, err := ts.CreateInbox(ctx, &store.Inbox{
+			ReceiverID: user.ID,
+			Status:     store.UNREAD,
+			Message: &storepb.InboxMessage{
+				Type: storepb.InboxMessage_MEMO_COMMENT,
+			},
+		})
+		require.NoError(t, err)
+		inboxIDs = append(inboxIDs, inbox.ID)
+	}
+	limit := 2
+	inboxes, err := ts.ListInboxes(ctx, &store.FindInbox{
+		ReceiverID: &user.ID,
+		Limit:      &limit,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(inboxes))
+	require.Equal(t, inboxIDs[2], inboxes[0].ID)
+	require.Equal(t, inboxIDs[1], inboxes[1].ID)
+	inboxes, err = ts.ListInboxes(ctx, &store.FindInbox{
+		ReceiverID: &user.ID,
+		Limit:      &limit,
+		Cursor:     &store.Cursor{Ts: inboxes[1].CreatedTs, ID: inboxes[1].ID},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(inboxes))
+	require.Equal(t, inboxIDs[0], inboxes[0].ID)
+	ts.Close()
+}
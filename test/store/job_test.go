@@ -0,0 +1,124 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestJobStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+
+	jobName := "test_job"
+	job, err := ts.GetJob(ctx, &store.FindJob{Name: &jobName})
+	require.NoError(t, err)
+	require.Nil(t, job)
+
+	_, err = ts.UpsertJob(ctx, &store.Job{
+		Name:     jobName,
+		Schedule: "@every 1h",
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertJob(ctx, &store.Job{
+		Name:     jobName,
+		Schedule: "0 3 * * *",
+	})
+	require.NoError(t, err)
+	jobs, err := ts.ListJobs(ctx, &store.FindJob{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(jobs))
+	require.Equal(t, "0 3 * * *", jobs[0].Schedule)
+	require.NotZero(t, jobs[0].UpdatedTs)
+	ts.Close()
+}
+
+func TestJobRunStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+
+	jobName := "test_job"
+	firstRun, err := ts.CreateJobRun(ctx, &store.JobRun{
+		JobName:   jobName,
+		StartedTs: 100,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, firstRun.ID)
+	require.Nil(t, firstRun.EndedTs)
+	require.Empty(t, firstRun.Error)
+	secondRun, err := ts.CreateJobRun(ctx, &store.JobRun{
+		JobName: jobName,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, secondRun.StartedTs)
+
+	endedTs, errMessage := int64(200), "failed to run"
+	err = ts.UpdateJobRun(ctx, &store.UpdateJobRun{
+		ID:      firstRun.ID,
+		EndedTs: &endedTs,
+		Error:   &errMessage,
+	})
+	require.NoError(t, err)
+
+	jobRuns, err := ts.ListJobRuns(ctx, &store.FindJobRun{JobName: &jobName})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(jobRuns))
+	require.Equal(t, secondRun.ID, jobRuns[0].ID)
+	require.Equal(t, firstRun.ID, jobRuns[1].ID)
+	require.Equal(t, endedTs, *jobRuns[1].EndedTs)
+	require.Equal(t, errMessage, jobRuns[1].Error)
+
+	limit := 1
+	lastRun, err := ts.GetJobRun(ctx, &store.FindJobRun{
+		JobName: &jobName,
+		Limit:   &limit,
+	})
+	require.NoError(t, err)
+	require.Equal(t, secondRun.ID, lastRun.ID)
+
+	otherJobName := "other_job"
+	jobRuns, err = ts.ListJobRuns(ctx, &store.FindJobRun{JobName: &otherJobName})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(jobRuns))
+	ts.Close()
+}
+
+func TestPruneJobRuns(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+
+	jobName, otherJobName := "test_job", "other_job"
+	for i := 0; i < 5; i++ {
+		_, err := ts.CreateJobRun(ctx, &store.JobRun{
+			JobName:   jobName,
+			StartedTs: int64(100 + i),
+		})
+		require.NoError(t, err)
+	}
+	_, err := ts.CreateJobRun(ctx, &store.JobRun{
+		JobName:   otherJobName,
+		StartedTs: 50,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, ts.PruneJobRuns(ctx, jobName, 2))
+	jobRuns, err := ts.ListJobRuns(ctx, &store.FindJobRun{JobName: &jobName})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(jobRuns))
+	require.Equal(t, int64(104), jobRuns[0].StartedTs)
+	require.Equal(t, int64(103), jobRuns[1].StartedTs)
+	// The runs of other jobs are kept.
+	jobRuns, err = ts.ListJobRuns(ctx, &store.FindJobRun{JobName: &otherJobName})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(jobRuns))
+
+	// Pruning within the limit keeps every run.
+	require.NoError(t, ts.PruneJobRuns(ctx, jobName, 2))
+	jobRuns, err = ts.ListJobRuns(ctx, &store.FindJobRun{JobName: &jobName})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(jobRuns))
+	ts.Close()
+}
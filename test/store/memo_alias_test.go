@@ -0,0 +1,64 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoAliasStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "trip-to-paris",
+		CreatorID:  user.ID,
+		Content:    "# Trip to Paris",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	otherMemo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "other",
+		CreatorID:  user.ID,
+		Content:    "other",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+
+	alias, err := ts.UpsertMemoAlias(ctx, &store.MemoAlias{UID: "first-uid", MemoID: memo.ID})
+	require.NoError(t, err)
+	require.NotZero(t, alias.CreatedTs)
+	_, err = ts.UpsertMemoAlias(ctx, &store.MemoAlias{UID: "second-uid", MemoID: memo.ID})
+	require.NoError(t, err)
+	aliases, err := ts.ListMemoAliases(ctx, &store.FindMemoAlias{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(aliases))
+
+	// An alias taken over by another memo leads to it.
+	_, err = ts.UpsertMemoAlias(ctx, &store.MemoAlias{UID: "second-uid", MemoID: otherMemo.ID})
+	require.NoError(t, err)
+	uid := "second-uid"
+	alias, err = ts.GetMemoAlias(ctx, &store.FindMemoAlias{UID: &uid})
+	require.NoError(t, err)
+	require.Equal(t, otherMemo.ID, alias.MemoID)
+
+	// References by a previous uid resolve to the memo.
+	referencedMemo, err := memopayload.GetMemoByReference(ctx, ts, "first-uid")
+	require.NoError(t, err)
+	require.Equal(t, memo.ID, referencedMemo.ID)
+	referencedMemo, err = memopayload.GetMemoByReference(ctx, ts, "unknown-uid")
+	require.NoError(t, err)
+	require.Nil(t, referencedMemo)
+
+	require.NoError(t, ts.PurgeMemo(ctx, memo.ID))
+	aliases, err = ts.ListMemoAliases(ctx, &store.FindMemoAlias{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(aliases))
+	ts.Close()
+}
@@ -0,0 +1,79 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoCollaboratorStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	collaborator, err := ts.CreateUser(ctx, &store.User{
+		Username: "test2",
+		Role:     store.RoleUser,
+		Email:    "test2@test.com",
+		Nickname: "test_nickname_2",
+	})
+	require.NoError(t, err)
+	sharedMemo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "shared",
+		CreatorID:  user.ID,
+		Content:    "meeting notes",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateMemo(ctx, &store.Memo{
+		UID:        "private",
+		CreatorID:  user.ID,
+		Content:    "private notes",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+
+	upserted, err := ts.UpsertMemoCollaborator(ctx, &store.MemoCollaborator{
+		MemoID: sharedMemo.ID,
+		UserID: collaborator.ID,
+		Role:   store.MemoCollaboratorViewer,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, upserted.CreatedTs)
+	_, err = ts.UpsertMemoCollaborator(ctx, &store.MemoCollaborator{
+		MemoID: sharedMemo.ID,
+		UserID: collaborator.ID,
+		Role:   store.MemoCollaboratorEditor,
+	})
+	require.NoError(t, err)
+	collaborators, err := ts.ListMemoCollaborators(ctx, &store.FindMemoCollaborator{MemoID: &sharedMemo.ID})
+	require.NoError(t, err)
+	require.Len(t, collaborators, 1)
+	require.Equal(t, store.MemoCollaboratorEditor, collaborators[0].Role)
+
+	// The collaborator sees the shared memo among the memos they can view.
+	memos, err := ts.ListMemos(ctx, &store.FindMemo{
+		VisibilityList: []store.Visibility{store.Public, store.Protected},
+		CollaboratorID: &collaborator.ID,
+	})
+	require.NoError(t, err)
+	require.Len(t, memos, 1)
+	require.Equal(t, sharedMemo.ID, memos[0].ID)
+
+	err = ts.DeleteMemoCollaborator(ctx, &store.DeleteMemoCollaborator{MemoID: &sharedMemo.ID, UserID: &collaborator.ID})
+	require.NoError(t, err)
+	found, err := ts.GetMemoCollaborator(ctx, &store.FindMemoCollaborator{MemoID: &sharedMemo.ID, UserID: &collaborator.ID})
+	require.NoError(t, err)
+	require.Nil(t, found)
+	memos, err = ts.ListMemos(ctx, &store.FindMemo{
+		VisibilityList: []store.Visibility{store.Public, store.Protected},
+		CollaboratorID: &collaborator.ID,
+	})
+	require.NoError(t, err)
+	require.Empty(t, memos)
+	ts.Close()
+}
@@ -0,0 +1,111 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestMemoFilterStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	memos := []*store.Memo{}
+	for _, create := range []*store.Memo{
+		{UID: "memo-1", Content: "#work", Visibility: store.Public, CreatedTs: 1000, Payload: &storepb.MemoPayload{Tags: []string{"work"}}},
+		{UID: "memo-2", Content: "#work/meeting", Visibility: store.Private, CreatedTs: 2000, Payload: &storepb.MemoPayload{Tags: []string{"work/meeting"}, Property: &storepb.MemoPayload_Property{HasLink: true}}},
+		{UID: "memo-3", Content: "no tags", Visibility: store.Protected, CreatedTs: 3000, Payload: &storepb.MemoPayload{}},
+	} {
+		create.CreatorID = user.ID
+		memo, err := ts.CreateMemo(ctx, create)
+		require.NoError(t, err)
+		memos = append(memos, memo)
+	}
+
+	listUIDs := func(filter *store.MemoFilter) []string {
+		list, err := ts.ListMemos(ctx, &store.FindMemo{Filter: filter, OrderByTimeAsc: true})
+		require.NoError(t, err)
+		uids := []string{}
+		for _, memo := range list {
+			uids = append(uids, memo.UID)
+		}
+		return uids
+	}
+
+	// Tags match their sub-tags, and the exclusion keeps the memos without tags.
+	require.Equal(t, []string{"memo-1", "memo-2"}, listUIDs(store.NewMemoFilterCondition(store.MemoFilterFieldTag, store.MemoFilterOperatorEqual, "work")))
+	require.Equal(t, []string{"memo-3"}, listUIDs(store.NewMemoFilterNot(store.NewMemoFilterCondition(store.MemoFilterFieldTag, store.MemoFilterOperatorEqual, "work"))))
+	require.Equal(t, []string{"memo-3"}, listUIDs(store.NewMemoFilterCondition(store.MemoFilterFieldTag, store.MemoFilterOperatorNotEqual, "work")))
+
+	// Comparisons, OR and IN.
+	require.Equal(t, []string{"memo-2", "memo-3"}, listUIDs(store.NewMemoFilterCondition(store.MemoFilterFieldCreatedTs, store.MemoFilterOperatorGreaterOrEqual, int64(2000))))
+	require.Equal(t, []string{"memo-1", "memo-3"}, listUIDs(store.NewMemoFilterOr(
+		store.NewMemoFilterCondition(store.MemoFilterFieldID, store.MemoFilterOperatorEqual, memos[0].ID),
+		store.NewMemoFilterCondition(store.MemoFilterFieldVisibility, store.MemoFilterOperatorEqual, store.Protected),
+	)))
+	require.Equal(t, []string{"memo-1", "memo-2"}, listUIDs(store.NewMemoFilterCondition(store.MemoFilterFieldVisibility, store.MemoFilterOperatorIn, store.Public, store.Private)))
+	require.Equal(t, []string{}, listUIDs(store.NewMemoFilterCondition(store.MemoFilterFieldID, store.MemoFilterOperatorIn)))
+
+	// Property flags.
+	require.Equal(t, []string{"memo-2"}, listUIDs(store.NewMemoFilterCondition(store.MemoFilterFieldHasLink, store.MemoFilterOperatorEqual, true)))
+	require.Equal(t, []string{"memo-1", "memo-3"}, listUIDs(store.NewMemoFilterCondition(store.MemoFilterFieldHasLink, store.MemoFilterOperatorEqual, false)))
+	require.Equal(t, []string{"memo-1", "memo-2", "memo-3"}, listUIDs(store.NewMemoFilterCondition(store.MemoFilterFieldPinned, store.MemoFilterOperatorNotEqual, true)))
+
+	// Invalid trees are rejected before reaching the driver.
+	_, err = ts.ListMemos(ctx, &store.FindMemo{Filter: store.NewMemoFilterCondition(store.MemoFilterFieldTag, store.MemoFilterOperatorLess, "work")})
+	require.Error(t, err)
+	_, err = ts.ListMemos(ctx, &store.FindMemo{Filter: store.NewMemoFilterCondition(store.MemoFilterFieldID, store.MemoFilterOperatorEqual, "1")})
+	require.Error(t, err)
+	ts.Close()
+}
+
+func TestMemoFilterLocationStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	for _, create := range []*store.Memo{
+		{UID: "paris", Content: "paris", CreatedTs: 1000, Payload: &storepb.MemoPayload{Location: &storepb.MemoPayload_Location{Latitude: 48.8566, Longitude: 2.3522}}},
+		{UID: "versailles", Content: "versailles", CreatedTs: 2000, Payload: &storepb.MemoPayload{Location: &storepb.MemoPayload_Location{Latitude: 48.8049, Longitude: 2.1204}}},
+		{UID: "fiji", Content: "fiji", CreatedTs: 3000, Payload: &storepb.MemoPayload{Location: &storepb.MemoPayload_Location{Latitude: -17.7134, Longitude: 178.065}}},
+		{UID: "nowhere", Content: "nowhere", CreatedTs: 4000, Payload: &storepb.MemoPayload{}},
+	} {
+		create.CreatorID = user.ID
+		create.Visibility = store.Public
+		_, err := ts.CreateMemo(ctx, create)
+		require.NoError(t, err)
+	}
+
+	listUIDs := func(filter *store.MemoFilter) []string {
+		list, err := ts.ListMemos(ctx, &store.FindMemo{Filter: filter, OrderByTimeAsc: true})
+		require.NoError(t, err)
+		uids := []string{}
+		for _, memo := range list {
+			uids = append(uids, memo.UID)
+		}
+		return uids
+	}
+
+	require.Equal(t, []string{"paris", "versailles"}, listUIDs(store.NewMemoFilterCondition(store.MemoFilterFieldLocation, store.MemoFilterOperatorWithinBox, 48.0, 2.0, 49.0, 3.0)))
+	// The box crosses the antimeridian when west is greater than east.
+	require.Equal(t, []string{"fiji"}, listUIDs(store.NewMemoFilterCondition(store.MemoFilterFieldLocation, store.MemoFilterOperatorWithinBox, -20.0, 170.0, -10.0, -170.0)))
+	// Versailles is about 18km away from Paris.
+	require.Equal(t, []string{"paris"}, listUIDs(store.NewMemoFilterCondition(store.MemoFilterFieldLocation, store.MemoFilterOperatorWithinRadius, 48.8566, 2.3522, 10000.0)))
+	require.Equal(t, []string{"paris", "versailles"}, listUIDs(store.NewMemoFilterCondition(store.MemoFilterFieldLocation, store.MemoFilterOperatorWithinRadius, 48.8566, 2.3522, 20000.0)))
+	// The memos without a location are never within an area.
+	require.Equal(t, []string{"versailles", "fiji", "nowhere"}, listUIDs(store.NewMemoFilterNot(store.NewMemoFilterCondition(store.MemoFilterFieldLocation, store.MemoFilterOperatorWithinRadius, 48.8566, 2.3522, 10000.0))))
+
+	_, err = ts.ListMemos(ctx, &store.FindMemo{Filter: store.NewMemoFilterCondition(store.MemoFilterFieldLocation, store.MemoFilterOperatorWithinBox, 50.0, 2.0, 49.0, 3.0)})
+	require.Error(t, err)
+	_, err = ts.ListMemos(ctx, &store.FindMemo{Filter: store.NewMemoFilterCondition(store.MemoFilterFieldLocation, store.MemoFilterOperatorEqual, 48.0)})
+	require.Error(t, err)
+	ts.Close()
+}
@@ -0,0 +1,67 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoPublishStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	publishTs := time.Now().Add(time.Hour).Unix()
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:               "test-resource-name",
+		CreatorID:         user.ID,
+		Content:           "test_content",
+		Visibility:        store.Private,
+		PublishTs:         &publishTs,
+		PublishVisibility: store.Public,
+	})
+	require.NoError(t, err)
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, store.Private, memo.Visibility)
+	require.Equal(t, publishTs, *memo.PublishTs)
+	require.Equal(t, store.Public, memo.PublishVisibility)
+
+	// Scheduled memos are not visible to the public until they are published.
+	memoList, err := ts.ListMemos(ctx, &store.FindMemo{VisibilityList: []store.Visibility{store.Public}})
+	require.NoError(t, err)
+	require.Len(t, memoList, 0)
+
+	// Only memos due before the given time are found.
+	now := time.Now().Unix()
+	memoList, err = ts.ListMemos(ctx, &store.FindMemo{PublishTsBefore: &now})
+	require.NoError(t, err)
+	require.Len(t, memoList, 0)
+	later := publishTs + 1
+	memoList, err = ts.ListMemos(ctx, &store.FindMemo{PublishTsBefore: &later})
+	require.NoError(t, err)
+	require.Len(t, memoList, 1)
+
+	// Publish the memo.
+	unscheduled := int64(0)
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:         memo.ID,
+		Visibility: &memo.PublishVisibility,
+		PublishTs:  &unscheduled,
+	})
+	require.NoError(t, err)
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, store.Public, memo.Visibility)
+	require.Nil(t, memo.PublishTs)
+	memoList, err = ts.ListMemos(ctx, &store.FindMemo{PublishTsBefore: &later})
+	require.NoError(t, err)
+	require.Len(t, memoList, 0)
+
+	ts.Close()
+}
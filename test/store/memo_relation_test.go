@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/usememos/memos/server/runner/memopayload"
 	"github.com/usememos/memos/store"
 )
 
@@ -60,3 +61,57 @@ func TestMemoRelationStore(t *testing.T) {
 	require.NoError(t, err)
 	ts.Close()
 }
+
+func TestSyncMemoReferences(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	target, err := ts.CreateMemo(ctx, &store.Memo{UID: "target", CreatorID: user.ID, Content: "target", Visibility: store.Public})
+	require.NoError(t, err)
+	explicit, err := ts.CreateMemo(ctx, &store.Memo{UID: "explicit", CreatorID: user.ID, Content: "explicit", Visibility: store.Public})
+	require.NoError(t, err)
+	other, err := ts.CreateUser(ctx, &store.User{
+		Username: "test2",
+		Role:     store.RoleUser,
+		Email:    "test2@test.com",
+		Nickname: "test_nickname_2",
+	})
+	require.NoError(t, err)
+	private, err := ts.CreateMemo(ctx, &store.Memo{UID: "private", CreatorID: other.ID, Content: "private", Visibility: store.Private})
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{UID: "source", CreatorID: user.ID, Content: "See [[target]], [[private]] and [[missing]].", Visibility: store.Public})
+	require.NoError(t, err)
+	require.NoError(t, memopayload.RebuildMemoPayload(memo))
+	// A relation set explicitly, without a reference in the content.
+	_, err = ts.UpsertMemoRelation(ctx, &store.MemoRelation{MemoID: memo.ID, RelatedMemoID: explicit.ID, Type: store.MemoRelationReference})
+	require.NoError(t, err)
+
+	listRelatedMemoIDs := func() []int32 {
+		relations, err := ts.ListMemoRelations(ctx, &store.FindMemoRelation{MemoID: &memo.ID})
+		require.NoError(t, err)
+		ids := []int32{}
+		for _, relation := range relations {
+			ids = append(ids, relation.RelatedMemoID)
+		}
+		return ids
+	}
+
+	// The private memo of another user is not related.
+	require.NoError(t, memopayload.SyncMemoReferences(ctx, ts, memo, nil))
+	require.ElementsMatch(t, []int32{target.ID, explicit.ID}, listRelatedMemoIDs())
+
+	// It is once the creator is a collaborator of the memo.
+	_, err = ts.UpsertMemoCollaborator(ctx, &store.MemoCollaborator{MemoID: private.ID, UserID: user.ID, Role: store.MemoCollaboratorViewer})
+	require.NoError(t, err)
+	require.NoError(t, memopayload.SyncMemoReferences(ctx, ts, memo, nil))
+	require.ElementsMatch(t, []int32{target.ID, private.ID, explicit.ID}, listRelatedMemoIDs())
+
+	// Removing the reference from the content removes its relation only.
+	previousReferences := memo.Payload.GetReferences()
+	memo.Content = "No more references."
+	require.NoError(t, memopayload.RebuildMemoPayload(memo))
+	require.NoError(t, memopayload.SyncMemoReferences(ctx, ts, memo, previousReferences))
+	require.Equal(t, []int32{explicit.ID}, listRelatedMemoIDs())
+	ts.Close()
+}
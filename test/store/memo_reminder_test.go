@@ -0,0 +1,79 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoReminderStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	now := time.Now().Unix()
+	remindTs := now + 3600
+	dueTs := now + 7200
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-resource-name",
+		CreatorID:  user.ID,
+		Content:    "test_content",
+		Visibility: store.Private,
+		Payload: &storepb.MemoPayload{
+			RemindTs: remindTs,
+			Property: &storepb.MemoPayload_Property{
+				// Due times in the past are not reminded of.
+				TaskDueTs: []int64{now - 3600, dueTs},
+			},
+		},
+	})
+	require.NoError(t, err)
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, remindTs, *memo.RemindTs)
+
+	// Only memos with reminders due before the given time are found.
+	memoList, err := ts.ListMemos(ctx, &store.FindMemo{RemindTsBefore: &now})
+	require.NoError(t, err)
+	require.Len(t, memoList, 0)
+	later := remindTs + 1
+	memoList, err = ts.ListMemos(ctx, &store.FindMemo{RemindTsBefore: &later})
+	require.NoError(t, err)
+	require.Len(t, memoList, 1)
+
+	// The next reminder after the first one is the due time of the task.
+	require.Equal(t, dueTs, store.GetNextMemoRemindTs(memo.Payload, remindTs))
+	require.Equal(t, int64(0), store.GetNextMemoRemindTs(memo.Payload, dueTs))
+
+	// Setting only the reminder does not change the version of the memo.
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:       memo.ID,
+		RemindTs: &dueTs,
+	})
+	require.NoError(t, err)
+	updated, err := ts.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, dueTs, *updated.RemindTs)
+	require.Equal(t, memo.Version, updated.Version)
+
+	// Updating the payload derives the reminder from it, and does not change the version of the memo either.
+	memo.Payload.RemindTs = 0
+	memo.Payload.Property.TaskDueTs = nil
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:      memo.ID,
+		Payload: memo.Payload,
+	})
+	require.NoError(t, err)
+	updated, err = ts.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	require.Nil(t, updated.RemindTs)
+	require.Equal(t, memo.Version, updated.Version)
+
+	ts.Close()
+}
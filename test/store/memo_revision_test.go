@@ -0,0 +1,64 @@
+package teststore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoRevisionStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-resource-name",
+		CreatorID:  user.ID,
+		Content:    "test_content",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		revision, err := ts.CreateMemoRevision(ctx, &store.MemoRevision{
+			MemoID:     memo.ID,
+			CreatorID:  user.ID,
+			Content:    fmt.Sprintf("content_%d", i),
+			Visibility: store.Private,
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, revision.ID)
+		require.NotZero(t, revision.CreatedTs)
+	}
+
+	revisions, err := ts.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Len(t, revisions, 3)
+	// Revisions are listed from newest to oldest.
+	require.Equal(t, "content_2", revisions[0].Content)
+	require.Equal(t, store.Private, revisions[0].Visibility)
+
+	revision, err := ts.GetMemoRevision(ctx, &store.FindMemoRevision{ID: &revisions[1].ID, MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, revisions[1], revision)
+
+	err = ts.PruneMemoRevisions(ctx, memo.ID, 2)
+	require.NoError(t, err)
+	revisions, err = ts.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	require.Equal(t, "content_1", revisions[1].Content)
+
+	err = ts.DeleteMemoRevision(ctx, &store.DeleteMemoRevision{MemoID: &memo.ID})
+	require.NoError(t, err)
+	revisions, err = ts.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Len(t, revisions, 0)
+
+	ts.Close()
+}
@@ -0,0 +1,74 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoShareStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-resource-name",
+		CreatorID:  user.ID,
+		Content:    "test_content",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+
+	expiresTs := int64(2000000000)
+	share, err := ts.CreateMemoShare(ctx, &store.MemoShare{
+		MemoID:    memo.ID,
+		CreatorID: user.ID,
+		Token:     "token",
+		ExpiresTs: &expiresTs,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, share.ID)
+	require.NotZero(t, share.CreatedTs)
+	_, err = ts.CreateMemoShare(ctx, &store.MemoShare{
+		MemoID:       memo.ID,
+		CreatorID:    user.ID,
+		Token:        "another_token",
+		PasswordHash: "hash",
+	})
+	require.NoError(t, err)
+
+	token := "token"
+	found, err := ts.GetMemoShare(ctx, &store.FindMemoShare{Token: &token})
+	require.NoError(t, err)
+	require.Equal(t, share.ID, found.ID)
+	require.Equal(t, expiresTs, *found.ExpiresTs)
+	require.Zero(t, found.AccessCount)
+	require.Nil(t, found.LastAccessedTs)
+
+	err = ts.RecordMemoShareAccess(ctx, share.ID, 1700000000)
+	require.NoError(t, err)
+	err = ts.RecordMemoShareAccess(ctx, share.ID, 1700000001)
+	require.NoError(t, err)
+	found, err = ts.GetMemoShare(ctx, &store.FindMemoShare{ID: &share.ID})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), found.AccessCount)
+	require.Equal(t, int64(1700000001), *found.LastAccessedTs)
+
+	err = ts.DeleteMemoShare(ctx, &store.DeleteMemoShare{ID: &share.ID})
+	require.NoError(t, err)
+	shares, err := ts.ListMemoShares(ctx, &store.FindMemoShare{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Len(t, shares, 1)
+	require.Equal(t, "another_token", shares[0].Token)
+
+	err = ts.PurgeMemo(ctx, memo.ID)
+	require.NoError(t, err)
+	shares, err = ts.ListMemoShares(ctx, &store.FindMemoShare{})
+	require.NoError(t, err)
+	require.Empty(t, shares)
+	ts.Close()
+}
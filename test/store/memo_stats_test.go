@@ -0,0 +1,102 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoStats(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memos := []*store.Memo{
+		{
+			UID:        "memo-1",
+			Content:    "#work #go",
+			Visibility: store.Public,
+			CreatedTs:  1000,
+			Payload: &storepb.MemoPayload{
+				Tags:     []string{"work", "go"},
+				Property: &storepb.MemoPayload_Property{HasLink: true, WordCount: 2},
+			},
+		},
+		{
+			UID:        "memo-2",
+			Content:    "- [ ] a #work",
+			Visibility: store.Private,
+			CreatedTs:  1100,
+			Payload: &storepb.MemoPayload{
+				Tags:     []string{"work"},
+				Property: &storepb.MemoPayload_Property{HasTaskList: true, HasIncompleteTasks: true, IncompleteTaskCount: 1, WordCount: 2},
+			},
+		},
+		{
+			UID:        "memo-3",
+			Content:    "later",
+			Visibility: store.Public,
+			CreatedTs:  100000,
+			Payload: &storepb.MemoPayload{
+				Property: &storepb.MemoPayload_Property{HasCode: true, WordCount: 1},
+			},
+		},
+	}
+	for _, memo := range memos {
+		memo.CreatorID = user.ID
+		_, err := ts.CreateMemo(ctx, memo)
+		require.NoError(t, err)
+	}
+
+	stats, err := ts.GetMemoStats(ctx, &store.FindMemoStats{CreatorID: user.ID})
+	require.NoError(t, err)
+	require.Equal(t, []*store.MemoStatsBucket{
+		{Ts: 900, MemoCount: 2, WordCount: 4, LinkCount: 1, TaskListCount: 1, IncompleteTaskCount: 1},
+		{Ts: 99900, MemoCount: 1, WordCount: 1, CodeCount: 1},
+	}, stats.Buckets)
+	require.Equal(t, map[string]int32{"work": 2, "go": 1}, stats.TagCounts)
+
+	stats, err = ts.GetMemoStats(ctx, &store.FindMemoStats{CreatorID: user.ID, VisibilityList: []store.Visibility{store.Public}})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(stats.Buckets))
+	require.Equal(t, int32(1), stats.Buckets[0].MemoCount)
+	require.Equal(t, map[string]int32{"work": 1, "go": 1}, stats.TagCounts)
+	ts.Close()
+}
+
+func TestListMemoTags(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memos := []*store.Memo{
+		{UID: "memo-1", Content: "#work/projects/alpha #go", Visibility: store.Public, Payload: &storepb.MemoPayload{Tags: []string{"work/projects/alpha", "go"}}},
+		{UID: "memo-2", Content: "#work", Visibility: store.Private, Payload: &storepb.MemoPayload{Tags: []string{"work"}}},
+		{UID: "memo-3", Content: "no tags", Visibility: store.Public, Payload: &storepb.MemoPayload{}},
+	}
+	for _, memo := range memos {
+		memo.CreatorID = user.ID
+		_, err := ts.CreateMemo(ctx, memo)
+		require.NoError(t, err)
+	}
+
+	memoTags, err := ts.ListMemoTags(ctx, &store.FindMemoStats{CreatorID: user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(memoTags))
+	tags := []string{}
+	for _, memoTag := range memoTags {
+		require.NotZero(t, memoTag.UpdatedTs)
+		tags = append(tags, memoTag.Tag)
+	}
+	require.ElementsMatch(t, []string{"work/projects/alpha", "go", "work"}, tags)
+	require.Equal(t, memoTags[0].MemoID, memoTags[1].MemoID)
+
+	memoTags, err = ts.ListMemoTags(ctx, &store.FindMemoStats{CreatorID: user.ID, VisibilityList: []store.Visibility{store.Public}})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(memoTags))
+	ts.Close()
+}
@@ -0,0 +1,82 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestGetMemoTerms(t *testing.T) {
+	require.Equal(t, map[string]int32{"golang": 2, "channels": 1, "go_routines": 1}, store.GetMemoTerms("The #golang channels, and golang go_routines in 2024!"))
+	require.Equal(t, map[string]int32{"中": 1, "文": 1, "notes": 1}, store.GetMemoTerms("中文notes"))
+	require.Empty(t, store.GetMemoTerms("a an the 42 https://www.x.com"))
+}
+
+func TestMemoTermStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "memo-1",
+		CreatorID:  user.ID,
+		Content:    "golang channels golang",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	other, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "memo-2",
+		CreatorID:  user.ID,
+		Content:    "golang generics",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+
+	terms, err := ts.ListMemoTerms(ctx, &store.FindMemoTerm{MemoIDList: []int32{memo.ID}})
+	require.NoError(t, err)
+	require.Equal(t, []*store.MemoTerm{
+		{MemoID: memo.ID, Term: "channels", Frequency: 1},
+		{MemoID: memo.ID, Term: "golang", Frequency: 2},
+	}, terms)
+	terms, err = ts.ListMemoTerms(ctx, &store.FindMemoTerm{TermList: []string{"golang"}})
+	require.NoError(t, err)
+	require.Len(t, terms, 2)
+
+	stats, err := ts.GetMemoTermStats(ctx, &store.FindMemoTermStats{MemoIDList: []int32{other.ID}})
+	require.NoError(t, err)
+	require.Equal(t, &store.MemoTermStats{MemoCount: 2, TermCount: 5, MemoTermCounts: map[int32]int64{other.ID: 2}}, stats)
+
+	// The terms and the stats are restricted to the visible memos.
+	visibility := store.Private
+	require.NoError(t, ts.UpdateMemo(ctx, &store.UpdateMemo{ID: other.ID, Visibility: &visibility}))
+	terms, err = ts.ListMemoTerms(ctx, &store.FindMemoTerm{TermList: []string{"golang"}, VisibilityList: []store.Visibility{store.Public}})
+	require.NoError(t, err)
+	require.Len(t, terms, 1)
+	terms, err = ts.ListMemoTerms(ctx, &store.FindMemoTerm{TermList: []string{"golang"}, VisibilityList: []store.Visibility{store.Public}, UserID: &user.ID})
+	require.NoError(t, err)
+	require.Len(t, terms, 2)
+	stats, err = ts.GetMemoTermStats(ctx, &store.FindMemoTermStats{VisibilityList: []store.Visibility{store.Public}})
+	require.NoError(t, err)
+	require.Equal(t, &store.MemoTermStats{MemoCount: 1, TermCount: 3, MemoTermCounts: map[int32]int64{}}, stats)
+
+	// Updating the content replaces the terms of the memo.
+	content := "rust ownership"
+	require.NoError(t, ts.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, Content: &content}))
+	terms, err = ts.ListMemoTerms(ctx, &store.FindMemoTerm{MemoIDList: []int32{memo.ID}})
+	require.NoError(t, err)
+	require.Equal(t, []*store.MemoTerm{
+		{MemoID: memo.ID, Term: "ownership", Frequency: 1},
+		{MemoID: memo.ID, Term: "rust", Frequency: 1},
+	}, terms)
+
+	// Purging the memo removes its terms from the index.
+	require.NoError(t, ts.PurgeMemo(ctx, memo.ID))
+	stats, err = ts.GetMemoTermStats(ctx, &store.FindMemoTermStats{})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), stats.MemoCount)
+	ts.Close()
+}
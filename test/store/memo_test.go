@@ -2,6 +2,7 @@ package teststore
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -118,3 +119,358 @@ func TestDeleteMemoStore(t *testing.T) {
 	require.NoError(t, err)
 	ts.Close()
 }
+
+func TestMemoContentSearch(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	for uid, content := range map[string]string{
+		"search-once":  "I was running to the station.",
+		"search-twice": "Running notes: running every morning.",
+		"search-none":  "Nothing to see here.",
+	} {
+		_, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        uid,
+			CreatorID:  user.ID,
+			Content:    content,
+			Visibility: store.Public,
+		})
+		require.NoError(t, err)
+	}
+
+	// Word variants are matched and the most relevant memo comes first.
+	memoList, err := ts.ListMemos(ctx, &store.FindMemo{
+		ContentSearch: []string{"runs"},
+	})
+	require.NoError(t, err)
+	require.Len(t, memoList, 2)
+	require.Equal(t, "search-twice", memoList[0].UID)
+
+	// Short words are matched without the index.
+	memoList, err = ts.ListMemos(ctx, &store.FindMemo{
+		ContentSearch: []string{"ru"},
+	})
+	require.NoError(t, err)
+	require.Len(t, memoList, 2)
+	for _, memo := range memoList {
+		if memo.UID == "search-twice" {
+			require.Contains(t, memo.SearchSnippet, store.SearchHighlightStart+"Ru"+store.SearchHighlightEnd+"nning")
+		}
+	}
+
+	// CJK text is matched without the index, and the snippet is escaped.
+	_, err = ts.CreateMemo(ctx, &store.Memo{
+		UID:        "search-cjk",
+		CreatorID:  user.ID,
+		Content:    "我的旅行计划 <script>alert(1)</script>",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	memoList, err = ts.ListMemos(ctx, &store.FindMemo{
+		ContentSearch: []string{"旅行"},
+	})
+	require.NoError(t, err)
+	require.Len(t, memoList, 1)
+	require.Equal(t, "我的"+store.SearchHighlightStart+"旅行"+store.SearchHighlightEnd+"计划 &lt;script&gt;alert(1)&lt;/script&gt;", memoList[0].SearchSnippet)
+
+	// The index follows content updates.
+	uid := "search-once"
+	memo, err := ts.GetMemo(ctx, &store.FindMemo{UID: &uid})
+	require.NoError(t, err)
+	content := "Walking to the station."
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, Content: &content})
+	require.NoError(t, err)
+	memoList, err = ts.ListMemos(ctx, &store.FindMemo{
+		ContentSearch: []string{"running"},
+	})
+	require.NoError(t, err)
+	require.Len(t, memoList, 1)
+	memoList, err = ts.ListMemos(ctx, &store.FindMemo{
+		ContentSearch: []string{"walk"},
+	})
+	require.NoError(t, err)
+	require.Len(t, memoList, 1)
+
+	// The index follows deletions.
+	err = ts.DeleteMemo(ctx, &store.DeleteMemo{ID: memo.ID})
+	require.NoError(t, err)
+	memoList, err = ts.ListMemos(ctx, &store.FindMemo{
+		ContentSearch: []string{"station"},
+	})
+	require.NoError(t, err)
+	require.Len(t, memoList, 0)
+
+	ts.Close()
+}
+
+func TestBatchUpdateMemos(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memos := []*store.Memo{}
+	for _, uid := range []string{"batch-1", "batch-2"} {
+		memo, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        uid,
+			CreatorID:  user.ID,
+			Content:    "test_content",
+			Visibility: store.Private,
+		})
+		require.NoError(t, err)
+		memos = append(memos, memo)
+	}
+
+	public, archived := store.Public, store.Archived
+	err = ts.BatchUpdateMemos(ctx, []*store.UpdateMemo{
+		{ID: memos[0].ID, Visibility: &public},
+		{ID: memos[1].ID, RowStatus: &archived},
+	}, []*store.MemoOrganizer{
+		{MemoID: memos[0].ID, UserID: user.ID, Pinned: true},
+	})
+	require.NoError(t, err)
+	memo, err := ts.GetMemo(ctx, &store.FindMemo{ID: &memos[0].ID})
+	require.NoError(t, err)
+	require.Equal(t, store.Public, memo.Visibility)
+	require.True(t, memo.Pinned)
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &memos[1].ID})
+	require.NoError(t, err)
+	require.Equal(t, store.Archived, memo.RowStatus)
+	require.Equal(t, store.Private, memo.Visibility)
+
+	// A failing update rolls back the whole batch.
+	private, invalidUID := store.Private, "invalid uid"
+	err = ts.BatchUpdateMemos(ctx, []*store.UpdateMemo{
+		{ID: memos[0].ID, Visibility: &private},
+		{ID: memos[1].ID, UID: &memos[0].UID},
+	}, nil)
+	require.Error(t, err)
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &memos[0].ID})
+	require.NoError(t, err)
+	require.Equal(t, store.Public, memo.Visibility)
+	err = ts.BatchUpdateMemos(ctx, []*store.UpdateMemo{{ID: memos[0].ID, UID: &invalidUID}}, nil)
+	require.Error(t, err)
+
+	ts.Close()
+}
+
+func TestMemoVersion(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-resource-name",
+		CreatorID:  user.ID,
+		Content:    "test_content",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(0), memo.Version)
+
+	content := "test_content_2"
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:              memo.ID,
+		Content:         &content,
+		ExpectedVersion: &memo.Version,
+	})
+	require.NoError(t, err)
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), memo.Version)
+
+	// An update with a stale version is rejected and does not change the memo.
+	staleVersion := int32(0)
+	staleContent := "test_content_3"
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:              memo.ID,
+		Content:         &staleContent,
+		ExpectedVersion: &staleVersion,
+	})
+	require.ErrorIs(t, err, store.ErrMemoVersionMismatch)
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, content, memo.Content)
+	require.Equal(t, int32(1), memo.Version)
+
+	// An update without an expected version always applies.
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:      memo.ID,
+		Content: &staleContent,
+	})
+	require.NoError(t, err)
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, staleContent, memo.Content)
+	require.Equal(t, int32(2), memo.Version)
+	ts.Close()
+}
+
+func TestMemoListByCursor(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	// Memos with the same created_ts are ordered by id.
+	createdTsList := []int64{100, 200, 200, 200, 300}
+	memoIDs := []int32{}
+	for i, createdTs := range createdTsList {
+		memo, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        fmt.Sprintf("memo-%d", i),
+			CreatorID:  user.ID,
+			Content:    "test_content",
+			Visibility: store.Public,
+			CreatedTs:  createdTs,
+			UpdatedTs:  createdTs,
+		})
+		require.NoError(t, err)
+		memoIDs = append(memoIDs, memo.ID)
+	}
+	_, err = ts.UpsertMemoOrganizer(ctx, &store.MemoOrganizer{
+		MemoID: memoIDs[1],
+		UserID: user.ID,
+		Pinned: true,
+	})
+	require.NoError(t, err)
+
+	listAll := func(find *store.FindMemo) []int32 {
+		ids := []int32{}
+		limit := 2
+		find.Limit = &limit
+		for {
+			memos, err := ts.ListMemos(ctx, find)
+			require.NoError(t, err)
+			for _, memo := range memos {
+				ids = append(ids, memo.ID)
+			}
+			if len(memos) < limit {
+				return ids
+			}
+			last := memos[len(memos)-1]
+			find.Cursor = &store.Cursor{Pinned: last.Pinned, Ts: last.CreatedTs, ID: last.ID}
+		}
+	}
+	require.Equal(t, []int32{memoIDs[4], memoIDs[3], memoIDs[2], memoIDs[1], memoIDs[0]}, listAll(&store.FindMemo{}))
+	require.Equal(t, []int32{memoIDs[0], memoIDs[1], memoIDs[2], memoIDs[3], memoIDs[4]}, listAll(&store.FindMemo{OrderByTimeAsc: true}))
+	require.Equal(t, []int32{memoIDs[1], memoIDs[4], memoIDs[3], memoIDs[2], memoIDs[0]}, listAll(&store.FindMemo{OrderByPinned: true}))
+
+	// A memo created while paging does not shift the following pages.
+	limit := 2
+	memos, err := ts.ListMemos(ctx, &store.FindMemo{Limit: &limit})
+	require.NoError(t, err)
+	_, err = ts.CreateMemo(ctx, &store.Memo{
+		UID:        "memo-new",
+		CreatorID:  user.ID,
+		Content:    "test_content",
+		Visibility: store.Public,
+		CreatedTs:  400,
+	})
+	require.NoError(t, err)
+	last := memos[len(memos)-1]
+	memos, err = ts.ListMemos(ctx, &store.FindMemo{
+		Limit:  &limit,
+		Cursor: &store.Cursor{Ts: last.CreatedTs, ID: last.ID},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(memos))
+	require.Equal(t, memoIDs[2], memos[0].ID)
+	require.Equal(t, memoIDs[1], memos[1].ID)
+	ts.Close()
+}
+
+func TestMergeMemos(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memos := []*store.Memo{}
+	for _, uid := range []string{"merge-1", "merge-2", "merge-3"} {
+		memo, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        uid,
+			CreatorID:  user.ID,
+			Content:    uid,
+			Visibility: store.Private,
+		})
+		require.NoError(t, err)
+		memos = append(memos, memo)
+	}
+	target, merged, other := memos[0], memos[1], memos[2]
+	resource, err := ts.CreateResource(ctx, &store.Resource{
+		UID:       "merge-resource",
+		CreatorID: user.ID,
+		Filename:  "test.txt",
+		Type:      "text/plain",
+		MemoID:    &merged.ID,
+	})
+	require.NoError(t, err)
+	for _, relation := range []*store.MemoRelation{
+		{MemoID: merged.ID, RelatedMemoID: other.ID, Type: store.MemoRelationReference},
+		{MemoID: other.ID, RelatedMemoID: merged.ID, Type: store.MemoRelationReference},
+		// The relations between the memos of the merge are not copied to the memo.
+		{MemoID: merged.ID, RelatedMemoID: target.ID, Type: store.MemoRelationReference},
+	} {
+		_, err := ts.UpsertMemoRelation(ctx, relation)
+		require.NoError(t, err)
+	}
+	for _, contentID := range []string{fmt.Sprintf("memos/%d", target.ID), fmt.Sprintf("memos/%d", merged.ID)} {
+		_, err := ts.UpsertReaction(ctx, &store.Reaction{CreatorID: user.ID, ContentID: contentID, ReactionType: "👍"})
+		require.NoError(t, err)
+	}
+	_, err = ts.UpsertReaction(ctx, &store.Reaction{CreatorID: user.ID, ContentID: fmt.Sprintf("memos/%d", merged.ID), ReactionType: "🎉"})
+	require.NoError(t, err)
+
+	content, archived := "merge-1\n\nmerge-2", store.Archived
+	newMerge := func(version int32) *store.MergeMemos {
+		return &store.MergeMemos{
+			Update:    &store.UpdateMemo{ID: target.ID, Content: &content, ExpectedVersion: &target.Version},
+			ContentID: fmt.Sprintf("memos/%d", target.ID),
+			Merged: []*store.MergedMemo{{
+				Update:    &store.UpdateMemo{ID: merged.ID, RowStatus: &archived, ExpectedVersion: &version},
+				ContentID: fmt.Sprintf("memos/%d", merged.ID),
+			}},
+		}
+	}
+	// A merge with a stale version is rejected and changes nothing.
+	err = ts.MergeMemos(ctx, newMerge(merged.Version+1))
+	require.ErrorIs(t, err, store.ErrMemoVersionMismatch)
+	memo, err := ts.GetMemo(ctx, &store.FindMemo{ID: &target.ID})
+	require.NoError(t, err)
+	require.Equal(t, "merge-1", memo.Content)
+	resource, err = ts.GetResource(ctx, &store.FindResource{ID: &resource.ID})
+	require.NoError(t, err)
+	require.Equal(t, merged.ID, *resource.MemoID)
+	relations, err := ts.ListMemoRelations(ctx, &store.FindMemoRelation{MemoID: &merged.ID})
+	require.NoError(t, err)
+	require.Len(t, relations, 2)
+
+	require.NoError(t, ts.MergeMemos(ctx, newMerge(merged.Version)))
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &target.ID})
+	require.NoError(t, err)
+	require.Equal(t, content, memo.Content)
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &merged.ID})
+	require.NoError(t, err)
+	require.Equal(t, store.Archived, memo.RowStatus)
+	resource, err = ts.GetResource(ctx, &store.FindResource{ID: &resource.ID})
+	require.NoError(t, err)
+	require.Equal(t, target.ID, *resource.MemoID)
+	relations, err = ts.ListMemoRelations(ctx, &store.FindMemoRelation{MemoID: &target.ID})
+	require.NoError(t, err)
+	require.Equal(t, []*store.MemoRelation{{MemoID: target.ID, RelatedMemoID: other.ID, Type: store.MemoRelationReference}}, relations)
+	relations, err = ts.ListMemoRelations(ctx, &store.FindMemoRelation{MemoID: &other.ID})
+	require.NoError(t, err)
+	require.Equal(t, []*store.MemoRelation{{MemoID: other.ID, RelatedMemoID: target.ID, Type: store.MemoRelationReference}}, relations)
+	relations, err = ts.ListMemoRelations(ctx, &store.FindMemoRelation{RelatedMemoID: &merged.ID})
+	require.NoError(t, err)
+	require.Empty(t, relations)
+	// The reactions of the merged memo are moved without duplicating the ones already on the target.
+	targetContentID, mergedContentID := fmt.Sprintf("memos/%d", target.ID), fmt.Sprintf("memos/%d", merged.ID)
+	reactions, err := ts.ListReactions(ctx, &store.FindReaction{ContentID: &targetContentID})
+	require.NoError(t, err)
+	require.Len(t, reactions, 2)
+	reactions, err = ts.ListReactions(ctx, &store.FindReaction{ContentID: &mergedContentID})
+	require.NoError(t, err)
+	require.Empty(t, reactions)
+
+	ts.Close()
+}
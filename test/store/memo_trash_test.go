@@ -0,0 +1,130 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoTrashStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-resource-name",
+		CreatorID:  user.ID,
+		Content:    "test_content",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	require.Nil(t, memo.TrashedTs)
+
+	// Trashed memos are excluded by default.
+	trashedTs := time.Now().Add(-48 * time.Hour).Unix()
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, TrashedTs: &trashedTs})
+	require.NoError(t, err)
+	memoList, err := ts.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Len(t, memoList, 0)
+	memoList, err = ts.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID, Trashed: true})
+	require.NoError(t, err)
+	require.Len(t, memoList, 1)
+	require.Equal(t, trashedTs, *memoList[0].TrashedTs)
+
+	// Only memos trashed before the given time are found.
+	before := time.Now().Add(-72 * time.Hour).Unix()
+	memoList, err = ts.ListMemos(ctx, &store.FindMemo{Trashed: true, TrashedTsBefore: &before})
+	require.NoError(t, err)
+	require.Len(t, memoList, 0)
+
+	// Restore the memo from trash.
+	restored := int64(0)
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, TrashedTs: &restored})
+	require.NoError(t, err)
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	require.NotNil(t, memo)
+	require.Nil(t, memo.TrashedTs)
+
+	ts.Close()
+}
+
+func TestPurgeMemo(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-resource-name",
+		CreatorID:  user.ID,
+		Content:    "test_content",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	comment, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-comment-name",
+		CreatorID:  user.ID,
+		Content:    "test_comment",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertMemoRelation(ctx, &store.MemoRelation{
+		MemoID:        comment.ID,
+		RelatedMemoID: memo.ID,
+		Type:          store.MemoRelationComment,
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateMemoRevision(ctx, &store.MemoRevision{
+		MemoID:     memo.ID,
+		CreatorID:  user.ID,
+		Content:    "old_content",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateResource(ctx, &store.Resource{
+		UID:       "test-comment-resource",
+		CreatorID: user.ID,
+		Filename:  "test.txt",
+		Blob:      []byte("test"),
+		Type:      "text/plain",
+		MemoID:    &comment.ID,
+	})
+	require.NoError(t, err)
+	other, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-other-name",
+		CreatorID:  user.ID,
+		Content:    "test_other",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertMemoRelation(ctx, &store.MemoRelation{
+		MemoID:        other.ID,
+		RelatedMemoID: memo.ID,
+		Type:          store.MemoRelationReference,
+	})
+	require.NoError(t, err)
+
+	err = ts.PurgeMemo(ctx, memo.ID)
+	require.NoError(t, err)
+	memoList, err := ts.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Len(t, memoList, 1)
+	require.Equal(t, other.ID, memoList[0].ID)
+	// The resources of the comments are purged with them.
+	resources, err := ts.ListResources(ctx, &store.FindResource{MemoID: &comment.ID})
+	require.NoError(t, err)
+	require.Len(t, resources, 0)
+	relations, err := ts.ListMemoRelations(ctx, &store.FindMemoRelation{RelatedMemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Len(t, relations, 0)
+	revisions, err := ts.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Len(t, revisions, 0)
+
+	ts.Close()
+}
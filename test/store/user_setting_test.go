@@ -26,3 +26,83 @@ func TestUserSettingStore(t *testing.T) {
 	require.Equal(t, 1, len(list))
 	ts.Close()
 }
+
+func TestUserShortcuts(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	shortcuts, err := ts.GetUserShortcuts(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(shortcuts))
+
+	_, err = ts.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSettingKey_SHORTCUTS,
+		Value: &storepb.UserSetting_Shortcuts{
+			Shortcuts: &storepb.ShortcutsUserSetting{
+				Shortcuts: []*storepb.ShortcutsUserSetting_Shortcut{
+					{Id: "work", Title: "Work", Filter: `tag_search == ["work"] && has_incomplete_tasks`},
+					{Id: "links", Title: "Links", Filter: `has_link`},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	shortcuts, err = ts.GetUserShortcuts(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(shortcuts))
+	require.Equal(t, "work", shortcuts[0].Id)
+	require.Equal(t, "links", shortcuts[1].Id)
+	require.Equal(t, `has_link`, shortcuts[1].Filter)
+	ts.Close()
+}
+
+func TestUserMemoRules(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	rules, err := ts.GetUserMemoRules(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(rules))
+
+	_, err = ts.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSettingKey_MEMO_RULES,
+		Value: &storepb.UserSetting_MemoRules{
+			MemoRules: &storepb.MemoRulesUserSetting{
+				Rules: []*storepb.MemoRulesUserSetting_Rule{
+					{
+						Id:        "links",
+						Title:     "Links",
+						Condition: &storepb.MemoRulesUserSetting_Condition{HasLink: true, Source: "telegram"},
+						Actions: []*storepb.MemoRulesUserSetting_Action{
+							{Action: &storepb.MemoRulesUserSetting_Action_AddTag{AddTag: "reading"}},
+							{Action: &storepb.MemoRulesUserSetting_Action_SetVisibility{SetVisibility: "PRIVATE"}},
+						},
+					},
+					{
+						Id:        "todo",
+						Title:     "Todo",
+						Condition: &storepb.MemoRulesUserSetting_Condition{ContentPattern: "(?i)^todo"},
+						Actions: []*storepb.MemoRulesUserSetting_Action{
+							{Action: &storepb.MemoRulesUserSetting_Action_Pin{Pin: true}},
+							{Action: &storepb.MemoRulesUserSetting_Action_DispatchWebhook{DispatchWebhook: 1}},
+						},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	rules, err = ts.GetUserMemoRules(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(rules))
+	require.Equal(t, "links", rules[0].Id)
+	require.Equal(t, "reading", rules[0].Actions[0].GetAddTag())
+	require.Equal(t, "PRIVATE", rules[0].Actions[1].GetSetVisibility())
+	require.Equal(t, "(?i)^todo", rules[1].Condition.ContentPattern)
+	require.Equal(t, int32(1), rules[1].Actions[1].GetDispatchWebhook())
+	ts.Close()
+}
@@ -70,10 +70,18 @@ const MemoDetail = () => {
   // Prepare memo.
   useEffect(() => {
     if (uid) {
-      memoStore.fetchMemoByUid(uid).catch((error: ClientError) => {
-        toast.error(error.details);
-        navigateTo("/403");
-      });
+      memoStore
+        .fetchMemoByUid(uid)
+        .then((memo: Memo) => {
+          // Links by a previous uid of the memo lead to its current uid.
+          if (memo.uid !== uid) {
+            navigateTo(`/m/${memo.uid}`, { replace: true });
+          }
+        })
+        .catch((error: ClientError) => {
+          toast.error(error.details);
+          navigateTo("/403");
+        });
     } else {
       navigateTo("/404");
     }